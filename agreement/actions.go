@@ -19,6 +19,7 @@ package agreement
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/algorand/go-algorand/logging/logspec"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
@@ -236,7 +237,9 @@ func (a ensureAction) do(ctx context.Context, s *Service) {
 			Hash:    a.Certificate.Proposal.BlockDigest.String(),
 			Round:   uint64(a.Certificate.Round),
 		})
+		start := time.Now()
 		s.Ledger.EnsureValidatedBlock(a.Payload.ve, a.Certificate)
+		s.timelines.ledgerWritten(a.Certificate.Round, time.Since(start))
 	} else {
 		block := a.Payload.Block
 		logEvent.Type = logspec.RoundConcluded
@@ -246,7 +249,9 @@ func (a ensureAction) do(ctx context.Context, s *Service) {
 			Hash:    a.Certificate.Proposal.BlockDigest.String(),
 			Round:   uint64(a.Certificate.Round),
 		})
+		start := time.Now()
 		s.Ledger.EnsureBlock(block, a.Certificate)
+		s.timelines.ledgerWritten(a.Certificate.Round, time.Since(start))
 	}
 	logEventStart := logEvent
 	logEventStart.Type = logspec.RoundStart
@@ -35,6 +35,10 @@ func (m SimpleKeyManager) VotingKeys(votingRound, _ basics.Round) []account.Part
 	return km
 }
 
+// Record implements KeyManager.Record.
+func (m SimpleKeyManager) Record(basics.Address, basics.Round, account.ParticipationAction) {
+}
+
 // DeleteOldKeys implements KeyManager.DeleteOldKeys.
 func (m SimpleKeyManager) DeleteOldKeys(r basics.Round) {
 	// for _, acc := range m {
@@ -0,0 +1,304 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// maxEquivocationEvidence is the number of equivocations whose evidence is kept; the oldest ones are dropped first.
+const maxEquivocationEvidence = 1000
+
+// EquivocationEvidence is a pair of votes signed by the same participation key for two different proposals in the
+// same round, period and step. No honest node ever signs such a pair, so it proves that the participation key is
+// misused.
+type EquivocationEvidence struct {
+	Sender basics.Address
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// Weight is the weight of the votes of the sender in the step.
+	Weight uint64
+
+	// Proposals holds the digests of the blocks the sender voted for.
+	Proposals [2]crypto.Digest
+
+	// Detected is the time at which this node detected the equivocation.
+	Detected time.Time
+
+	// Votes is the msgpack encoding of the pair of votes, with the credential and the signatures of the sender,
+	// which VerifyEquivocationEvidence checks against the ledger of any node.
+	Votes []byte
+}
+
+// makeEquivocationEvidence returns the evidence of an equivocation detected at the given time.
+func makeEquivocationEvidence(ev equivocationVote, detected time.Time) EquivocationEvidence {
+	pair := unauthenticatedEquivocationVote{
+		Sender:    ev.Sender,
+		Round:     ev.Round,
+		Period:    ev.Period,
+		Step:      ev.Step,
+		Cred:      ev.Cred.UnauthenticatedCredential,
+		Proposals: ev.Proposals,
+		Sigs:      ev.Sigs,
+	}
+	return EquivocationEvidence{
+		Sender:    ev.Sender,
+		Round:     ev.Round,
+		Period:    uint64(ev.Period),
+		Step:      uint64(ev.Step),
+		Weight:    ev.Cred.Weight,
+		Proposals: [2]crypto.Digest{ev.Proposals[0].BlockDigest, ev.Proposals[1].BlockDigest},
+		Detected:  detected,
+		Votes:     protocol.Encode(&pair),
+	}
+}
+
+// VerifyEquivocationEvidence checks that the given encoding of a pair of votes proves an equivocation: both votes
+// are valid, and are signed by the same sender for different proposals in the same round, period and step.
+func VerifyEquivocationEvidence(l LedgerReader, votes []byte) (EquivocationEvidence, error) {
+	var pair unauthenticatedEquivocationVote
+	err := protocol.Decode(votes, &pair)
+	if err != nil {
+		return EquivocationEvidence{}, fmt.Errorf("VerifyEquivocationEvidence: could not decode the votes: %w", err)
+	}
+	ev, err := pair.verify(l)
+	if err != nil {
+		return EquivocationEvidence{}, err
+	}
+	evidence := makeEquivocationEvidence(ev, time.Time{})
+	evidence.Votes = votes
+	return evidence, nil
+}
+
+// equivocationKey identifies an equivocation; a sender equivocates at most once per step.
+type equivocationKey struct {
+	sender basics.Address
+	round  basics.Round
+	period period
+	step   step
+}
+
+// An equivocationRecorder keeps the evidence of the equivocations detected by the state machine, and persists it
+// in the crash database. The state machine hands the evidence over through its tracer, and never waits for the
+// database.
+type equivocationRecorder struct {
+	log     serviceLogger
+	crashDb db.Accessor
+
+	mu       sync.Mutex
+	evidence []EquivocationEvidence // oldest first
+	known    map[equivocationKey]bool
+
+	wg      sync.WaitGroup
+	ctxExit context.CancelFunc
+	pending chan EquivocationEvidence
+}
+
+func makeEquivocationRecorder(log serviceLogger, crash db.Accessor) *equivocationRecorder {
+	return &equivocationRecorder{
+		log:     log,
+		crashDb: crash,
+		known:   make(map[equivocationKey]bool),
+		pending: make(chan EquivocationEvidence, 64),
+	}
+}
+
+// Start loads the persisted evidence, and starts persisting the evidence of the new equivocations.
+func (r *equivocationRecorder) Start() {
+	r.load()
+	r.wg.Add(1)
+	ctx, ctxExit := context.WithCancel(context.Background())
+	r.ctxExit = ctxExit
+	go r.loop(ctx)
+}
+
+// Quit stops persisting the evidence. The evidence which wasn't persisted yet is persisted first.
+func (r *equivocationRecorder) Quit() {
+	r.ctxExit()
+	r.wg.Wait()
+}
+
+// Record keeps the evidence of an equivocation, unless it was already recorded.
+func (r *equivocationRecorder) Record(ev equivocationVote) {
+	key := equivocationKey{sender: ev.Sender, round: ev.Round, period: ev.Period, step: ev.Step}
+	r.mu.Lock()
+	if r.known[key] {
+		r.mu.Unlock()
+		return
+	}
+	evidence := makeEquivocationEvidence(ev, time.Now())
+	r.known[key] = true
+	r.evidence = append(r.evidence, evidence)
+	if len(r.evidence) > maxEquivocationEvidence {
+		dropped := r.evidence[0]
+		delete(r.known, equivocationKey{sender: dropped.Sender, round: dropped.Round, period: period(dropped.Period), step: step(dropped.Step)})
+		r.evidence = r.evidence[1:]
+	}
+	r.mu.Unlock()
+
+	details := telemetryspec.EquivocationEvidenceEventDetails{
+		VoterAddress:  evidence.Sender.String(),
+		Round:         uint64(evidence.Round),
+		Period:        evidence.Period,
+		Step:          evidence.Step,
+		Weight:        evidence.Weight,
+		ProposalHash1: evidence.Proposals[0].String(),
+		ProposalHash2: evidence.Proposals[1].String(),
+		Votes:         base64.StdEncoding.EncodeToString(evidence.Votes),
+	}
+	r.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.EquivocationEvidenceEvent, details)
+
+	select {
+	case r.pending <- evidence:
+	default:
+		r.log.Warnf("equivocationRecorder: too many pending equivocations; the evidence against %v at (%d, %d, %d) is not persisted",
+			evidence.Sender, evidence.Round, evidence.Period, evidence.Step)
+	}
+}
+
+// List returns the recorded evidence, most recent first.
+func (r *equivocationRecorder) List() []EquivocationEvidence {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := make([]EquivocationEvidence, len(r.evidence))
+	for i, evidence := range r.evidence {
+		list[len(list)-1-i] = evidence
+	}
+	return list
+}
+
+func (r *equivocationRecorder) loop(ctx context.Context) {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case evidence := <-r.pending:
+					r.persist(evidence)
+				default:
+					return
+				}
+			}
+		case evidence := <-r.pending:
+			r.persist(evidence)
+		}
+	}
+}
+
+// installEquivocationTable creates the table holding the evidence, which the crash databases created before it
+// was introduced lack.
+func installEquivocationTable(tx *sql.Tx) error {
+	_, err := tx.Exec(`create table if not exists Equivocations (
+		sender blob,
+		round integer,
+		period integer,
+		step integer,
+		weight integer,
+		detected integer,
+		votes blob,
+		primary key (sender, round, period, step))`)
+	return err
+}
+
+// load creates the table holding the evidence unless it exists, and reads the persisted evidence.
+func (r *equivocationRecorder) load() {
+	err := r.crashDb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return installEquivocationTable(tx)
+	})
+	if err != nil {
+		r.log.Warnf("equivocationRecorder: could not create the table of the evidence: %v", err)
+		return
+	}
+
+	var loaded []EquivocationEvidence
+	err = r.crashDb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		loaded = nil
+		rows, err := tx.Query("select weight, detected, votes from Equivocations order by detected, rowid")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var weight, detected int64
+			var votes []byte
+			err = rows.Scan(&weight, &detected, &votes)
+			if err != nil {
+				return err
+			}
+			var pair unauthenticatedEquivocationVote
+			err = protocol.Decode(votes, &pair)
+			if err != nil {
+				return err
+			}
+			loaded = append(loaded, EquivocationEvidence{
+				Sender:    pair.Sender,
+				Round:     pair.Round,
+				Period:    uint64(pair.Period),
+				Step:      uint64(pair.Step),
+				Weight:    uint64(weight),
+				Proposals: [2]crypto.Digest{pair.Proposals[0].BlockDigest, pair.Proposals[1].BlockDigest},
+				Detected:  time.Unix(0, detected),
+				Votes:     votes,
+			})
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		r.log.Warnf("equivocationRecorder: could not load the evidence of past equivocations: %v", err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.evidence = loaded
+	r.known = make(map[equivocationKey]bool)
+	for _, evidence := range loaded {
+		r.known[equivocationKey{sender: evidence.Sender, round: evidence.Round, period: period(evidence.Period), step: step(evidence.Step)}] = true
+	}
+}
+
+// persist writes the evidence of an equivocation to the crash database, and drops the oldest evidence beyond
+// maxEquivocationEvidence. The table is created by load.
+func (r *equivocationRecorder) persist(evidence EquivocationEvidence) {
+	err := r.crashDb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("insert or ignore into Equivocations (sender, round, period, step, weight, detected, votes) values (?, ?, ?, ?, ?, ?, ?)",
+			evidence.Sender[:], evidence.Round, evidence.Period, evidence.Step, evidence.Weight, evidence.Detected.UnixNano(), evidence.Votes)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("delete from Equivocations where rowid not in (select rowid from Equivocations order by detected desc, rowid desc limit ?)", maxEquivocationEvidence)
+		return err
+	})
+	if err != nil {
+		r.log.Warnf("equivocationRecorder: could not persist the evidence against %v at (%d, %d, %d): %v",
+			evidence.Sender, evidence.Round, evidence.Period, evidence.Step, err)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestEquivocationRecorder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accessor, err := db.MakeAccessor(t.Name()+"_crash.db", false, true)
+	require.NoError(t, err)
+	defer accessor.Close()
+
+	recorder := makeEquivocationRecorder(serviceLogger{logging.Base()}, accessor)
+	recorder.Start()
+
+	helper := voteMakerHelper{}
+	helper.Setup()
+	equivocation := *helper.MakeRandomProposalValue()
+	voteTrackerAutomata := &ioAutomataConcrete{
+		listener: makeVoteTrackerZero(),
+		rHandle:  &routerHandle{t: &tracer{log: serviceLogger{logging.Base()}, equivocations: recorder}},
+	}
+	voteTrackerAutomata.rHandle.r = voteTrackerAutomata
+	inputs := []event{
+		helper.MakeValidVoteAccepted(t, 0, soft),
+		helper.MakeValidVoteAccepted(t, 1, soft),
+		helper.MakeValidVoteAcceptedVal(t, 0, soft, equivocation),
+		helper.MakeValidVoteAcceptedVal(t, 0, soft, *helper.MakeRandomProposalValue()),
+	}
+	err, panicErr := voteTrackerAutomata.transitionAll(inputs)
+	require.NoError(t, err)
+	require.NoError(t, panicErr)
+
+	list := recorder.List()
+	require.Len(t, list, 1)
+	require.Equal(t, helper.addresses[0], list[0].Sender)
+	require.Equal(t, uint64(soft), list[0].Step)
+	require.Equal(t, uint64(8), list[0].Period)
+	require.Equal(t, uint64(1), list[0].Weight)
+	require.Equal(t, [2]crypto.Digest{helper.proposal.BlockDigest, equivocation.BlockDigest}, list[0].Proposals)
+	require.NotEmpty(t, list[0].Votes)
+	recorder.Quit()
+
+	// the evidence survives restarts
+	restarted := makeEquivocationRecorder(serviceLogger{logging.Base()}, accessor)
+	restarted.Start()
+	defer restarted.Quit()
+	reloaded := restarted.List()
+	require.Len(t, reloaded, 1)
+	require.Equal(t, list[0].Votes, reloaded[0].Votes)
+	require.Equal(t, list[0].Weight, reloaded[0].Weight)
+	require.True(t, list[0].Detected.Equal(reloaded[0].Detected))
+
+	// and isn't recorded twice
+	restarted.Record(equivocationVote{
+		Sender:    list[0].Sender,
+		Round:     list[0].Round,
+		Period:    period(list[0].Period),
+		Step:      step(list[0].Step),
+		Proposals: [2]proposalValue{*helper.proposal, equivocation},
+	})
+	require.Len(t, restarted.List(), 1)
+}
+
+func TestVerifyEquivocationEvidence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+
+	verified := false
+	for i, address := range addresses {
+		var proposals [2]proposalValue
+		var votes [2]unauthenticatedVote
+		for j := range proposals {
+			proposals[j].BlockDigest = randomBlockHash()
+			rv := rawVote{Sender: address, Round: round, Period: 0, Step: soft, Proposal: proposals[j]}
+			var err error
+			votes[j], err = makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+			require.NoError(t, err)
+		}
+		pair := unauthenticatedEquivocationVote{
+			Sender:    address,
+			Round:     round,
+			Step:      soft,
+			Cred:      votes[0].Cred,
+			Proposals: proposals,
+			Sigs:      [2]crypto.OneTimeSignature{votes[0].Sig, votes[1].Sig},
+		}
+		ev, err := pair.verify(ledger)
+		if err != nil {
+			// not selected in the committee
+			continue
+		}
+		verified = true
+
+		evidence := makeEquivocationEvidence(ev, time.Now())
+		checked, err := VerifyEquivocationEvidence(ledger, evidence.Votes)
+		require.NoError(t, err)
+		require.Equal(t, address, checked.Sender)
+		require.Equal(t, round, checked.Round)
+		require.Equal(t, ev.Cred.Weight, checked.Weight)
+		require.Equal(t, [2]crypto.Digest{proposals[0].BlockDigest, proposals[1].BlockDigest}, checked.Proposals)
+
+		forged := pair
+		forged.Proposals[1].BlockDigest = randomBlockHash()
+		_, err = VerifyEquivocationEvidence(ledger, makeEquivocationEvidence(equivocationVote{
+			Sender:    forged.Sender,
+			Round:     forged.Round,
+			Step:      forged.Step,
+			Cred:      ev.Cred,
+			Proposals: forged.Proposals,
+			Sigs:      forged.Sigs,
+		}, time.Now()).Votes)
+		require.Error(t, err)
+
+		_, err = VerifyEquivocationEvidence(ledger, []byte("not an equivocation"))
+		require.Error(t, err)
+		break
+	}
+	require.True(t, verified, "no vote was selected")
+}
@@ -32,3 +32,7 @@ func (m simpleKeyManager) VotingKeys(votingRound, _ basics.Round) []account.Part
 	}
 	return km
 }
+
+func (m simpleKeyManager) Record(basics.Address, basics.Round, account.ParticipationAction) {
+	// noop
+}
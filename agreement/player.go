@@ -261,6 +261,7 @@ func (p *player) handleCheckpointEvent(r routerHandle, e checkpointEvent) []acti
 
 func (p *player) handleThresholdEvent(r routerHandle, e thresholdEvent) []action {
 	r.t.timeR().RecThreshold(e)
+	r.t.timelines.threshold(e, time.Now())
 
 	var actions []action
 	switch e.t() {
@@ -330,6 +331,7 @@ func (p *player) enterPeriod(r routerHandle, source thresholdEvent, target perio
 
 	// update tracer state to match player
 	r.t.setMetadata(tracerMetadata{p.Round, p.Period, p.Step})
+	r.t.timelines.startPeriod(p.Round, p.Period, time.Now())
 
 	actions = append(actions, rezeroAction{Round: p.Round})
 
@@ -384,6 +386,7 @@ func (p *player) enterRound(r routerHandle, source event, target round) []action
 	// update tracer state to match player
 	r.t.setMetadata(tracerMetadata{p.Round, p.Period, p.Step})
 	r.t.resetTimingWithPipeline(target)
+	r.t.timelines.startRound(target, time.Now())
 
 	// do proposal-related actions
 	as := pseudonodeAction{T: assemble, Round: p.Round, Period: 0}
@@ -18,6 +18,7 @@ package agreement
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/algorand/go-algorand/logging"
 )
@@ -180,6 +181,7 @@ func (m *proposalManager) handleMessageEvent(r routerHandle, p player, e filtera
 			// we log timing info on payloadPresent because we delay verification
 			// (this is in contrast to logging timing on voteVerified...)
 			r.t.timeR().RecPayload(ep.Proposal.OriginalPeriod, propose, ep.Proposal)
+			r.t.timelines.proposalReceived(p.Round, p.Period, time.Now())
 			return ep
 		}
 
@@ -195,6 +197,7 @@ func (m *proposalManager) handleMessageEvent(r routerHandle, p player, e filtera
 		pipelinedPeriod = 0
 
 		r.t.timeRPlus1().RecPayload(ep.Proposal.OriginalPeriod, propose, ep.Proposal)
+		r.t.timelines.proposalReceived(p.Round+1, 0, time.Now())
 
 		return ep
 
@@ -209,6 +212,8 @@ func (m *proposalManager) handleMessageEvent(r routerHandle, p player, e filtera
 
 		up := e.Input.UnauthenticatedProposal
 		r.t.timeR().RecPayloadValidation(up.OriginalPeriod, propose, up.value())
+		// the payloads this node proposes are verified without being present first
+		r.t.timelines.proposalReceived(up.Round(), p.Period, time.Now())
 
 		return r.dispatch(p, e.messageEvent, proposalMachineRound, p.Round, p.Period, 0)
 	}
@@ -75,6 +75,7 @@ type asyncPseudonode struct {
 	quit                   chan struct{}   // a quit signal for the verifier goroutines
 	closeWg                *sync.WaitGroup // frontend waitgroup to get notified when all the verifier goroutines are done.
 	monitor                *coserviceMonitor
+	timelines              *timelineRecorder
 	participationKeysRound basics.Round            // the round to which the participationKeys matches
 	participationKeys      []account.Participation // the list of the participation keys for round participationKeysRound
 
@@ -127,6 +128,7 @@ type pseudonodeParams struct {
 	voteVerifier *AsyncVoteVerifier
 	log          serviceLogger
 	monitor      *coserviceMonitor
+	timelines    *timelineRecorder
 }
 
 func makePseudonode(params pseudonodeParams) pseudonode {
@@ -139,6 +141,7 @@ func makePseudonode(params pseudonodeParams) pseudonode {
 		quit:      make(chan struct{}),
 		closeWg:   &sync.WaitGroup{},
 		monitor:   params.monitor,
+		timelines: params.timelines,
 	}
 
 	pn.proposalsVerifier = pn.makePseudonodeVerifier(params.voteVerifier)
@@ -267,7 +270,8 @@ func (n asyncPseudonode) makePseudonodeVerifier(voteVerifier *AsyncVoteVerifier)
 
 // makeProposals creates a slice of block proposals for the given round and period.
 func (n asyncPseudonode) makeProposals(round basics.Round, period period, accounts []account.Participation) ([]proposal, []unauthenticatedVote) {
-	deadline := time.Now().Add(config.ProposalAssemblyTime)
+	start := time.Now()
+	deadline := start.Add(config.ProposalAssemblyTime)
 	ve, err := n.factory.AssembleBlock(round, deadline)
 	if err != nil {
 		if err != ErrAssembleBlockRoundStale {
@@ -275,6 +279,7 @@ func (n asyncPseudonode) makeProposals(round basics.Round, period period, accoun
 		}
 		return nil, nil
 	}
+	n.timelines.blockAssembled(round, time.Since(start))
 
 	votes := make([]unauthenticatedVote, 0, len(accounts))
 	proposals := make([]proposal, 0, len(accounts))
@@ -435,6 +440,10 @@ func (t pseudonodeVotesTask) execute(verifier *AsyncVoteVerifier, quit chan stru
 		}
 	}
 
+	for _, r := range verifiedResults {
+		t.node.keys.Record(r.v.R.Sender, r.v.R.Round, account.Vote)
+	}
+
 	for range verifiedResults {
 		t.node.monitor.inc(pseudonodeCoserviceType)
 	}
@@ -519,6 +528,10 @@ func (t pseudonodeProposalsTask) execute(verifier *AsyncVoteVerifier, quit chan
 	}
 	t.node.log.Infof("pseudonode.makeProposals: %d proposals created for round %d, period %d", len(verifiedVotes), t.round, t.period)
 
+	for _, r := range verifiedVotes {
+		t.node.keys.Record(r.v.R.Sender, r.v.R.Round, account.BlockProposal)
+	}
+
 	for range verifiedVotes {
 		t.node.monitor.inc(pseudonodeCoserviceType)
 	}
@@ -0,0 +1,227 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A replay feeds the events recorded in a cadaver back into the state machine, and checks that the state machine
+// emits the recorded actions. The cadaver holds the player state at the start of every period, but not the state
+// of the router, which the replay reconstructs from the events it feeds to the state machine since the start of
+// each run. A run which started from a crash recovery state might thus diverge during its first rounds, until the
+// state restored by the node at startup is no longer relevant.
+
+// replayWarmupRounds is the number of rounds at the start of a run during which the state of the router restored
+// by the node at startup may still be relevant.
+const replayWarmupRounds = 2
+
+// ReplayBreakpoint identifies the point of a replay at which the replay stops, and dumps the state of the player
+// and of the router. The replay stops before handling the first event received once the player reaches the round,
+// period and step of the breakpoint.
+type ReplayBreakpoint struct {
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+}
+
+// ReplayOptions configure a replay.
+type ReplayOptions struct {
+	// Breakpoint, if not nil, stops the replay once reached.
+	Breakpoint *ReplayBreakpoint
+}
+
+// ReplayDivergence describes an output of the replayed state machine which differs from the recorded one.
+type ReplayDivergence struct {
+	// Run is the sequence number of the run of the cadaver-generating process.
+	Run int
+
+	// Round, Period and Step are those of the player before it handled the event.
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	// Event is the event handled by the state machine, or empty when the player state the state machine reached
+	// by the end of a period differs from the one recorded at the start of the next.
+	Event string
+
+	// Recorded and Replayed are the recorded and the replayed actions or player states.
+	Recorded string
+	Replayed string
+
+	// Warmup is set when the divergence occurred within the first rounds of the run, in which it might come from
+	// the state the node restored at startup rather than from the state machine.
+	Warmup bool
+}
+
+// ReplayReport summarizes a replay.
+type ReplayReport struct {
+	// Version is the commit hash of the build which wrote the cadaver.
+	Version string
+
+	// Events is the number of events fed to the state machine.
+	Events int
+
+	// Divergences lists the outputs of the state machine which differ from the recorded ones.
+	Divergences []ReplayDivergence
+
+	// Stopped is set when the replay stopped at its breakpoint.
+	Stopped bool
+}
+
+// reached returns whether the given player has reached the breakpoint.
+func (b *ReplayBreakpoint) reached(p player) bool {
+	if b == nil {
+		return false
+	}
+	if p.Round != b.Round {
+		return p.Round > b.Round
+	}
+	if uint64(p.Period) != b.Period {
+		return uint64(p.Period) > b.Period
+	}
+	return uint64(p.Step) >= b.Step
+}
+
+// Replay re-drives the recorded events through the state machine, and reports the outputs which differ from the
+// recorded ones. The divergences, and the state dumped at the breakpoint, are written to w.
+func (a *Autopsy) Replay(opts ReplayOptions, w io.Writer) (report ReplayReport) {
+	var playerTracer tracer
+	playerTracer.log = serviceLogger{logging.Base()}
+	playerTracer.w = ioutil.Discard
+	defer a.drain()
+
+	run := 0
+	for cdv := range a.cdvs {
+		// every run starts from scratch, as the process which recorded it did.
+		var router rootRouter
+		var predicted *player
+		var startRound round
+		first := true
+
+		for tr := range cdv {
+			if first {
+				first = false
+				report.Version = tr.m.VersionCommitHash
+				startRound = tr.x.Round
+			}
+
+			p := tr.x
+			warmup := p.Round < startRound+replayWarmupRounds
+			if predicted != nil && !bytes.Equal(protocol.EncodeReflect(predicted), protocol.EncodeReflect(&p)) {
+				report.divergence(w, ReplayDivergence{
+					Run:      run,
+					Round:    predicted.Round,
+					Period:   uint64(predicted.Period),
+					Step:     uint64(predicted.Step),
+					Recorded: fmt.Sprintf("%+v", p),
+					Replayed: fmt.Sprintf("%+v", *predicted),
+					Warmup:   warmup,
+				})
+			}
+			router.root = checkedActor{actor: &p, actorContract: playerContract{}}
+
+			for pair := range tr.p {
+				if opts.Breakpoint.reached(p) {
+					fmt.Fprintf(w, "replay: breakpoint reached at (%d, %d, %d) in run %d, before event %v\n", p.Round, p.Period, p.Step, run, pair.e)
+					dumpPlayerStr(w, p, router, "breakpoint")
+					report.Stopped = true
+					// the rest of the current run is drained here, and the following runs once this returns.
+					drainTraces(tr, cdv)
+					return
+				}
+
+				warmup = p.Round < startRound+replayWarmupRounds
+				next, actions := router.submitTop(&playerTracer, p, pair.e)
+				report.Events++
+				if !sameActions(pair.a, actions) {
+					report.divergence(w, ReplayDivergence{
+						Run:      run,
+						Round:    p.Round,
+						Period:   uint64(p.Period),
+						Step:     uint64(p.Step),
+						Event:    pair.e.String(),
+						Recorded: fmt.Sprintf("%v", pair.a),
+						Replayed: fmt.Sprintf("%v", actions),
+						Warmup:   warmup,
+					})
+				}
+				p = next
+			}
+			predicted = &p
+		}
+		run++
+	}
+	return
+}
+
+// divergence records a divergence, and writes it to w.
+func (report *ReplayReport) divergence(w io.Writer, d ReplayDivergence) {
+	report.Divergences = append(report.Divergences, d)
+	what := "on event " + d.Event
+	if d.Event == "" {
+		what = "in the player state at the end of the period"
+	}
+	warmup := ""
+	if d.Warmup {
+		warmup = " (warm-up)"
+	}
+	fmt.Fprintf(w, "replay: divergence%s at (%d, %d, %d) in run %d %s:\nreplay:   recorded %s\nreplay:   replayed %s\n",
+		warmup, d.Round, d.Period, d.Step, d.Run, what, d.Recorded, d.Replayed)
+}
+
+// sameActions compares the actions emitted by the state machine by their encoding, as the cadaver recorded it.
+func sameActions(recorded []action, replayed []action) bool {
+	if len(recorded) != len(replayed) {
+		return false
+	}
+	for i := range recorded {
+		if recorded[i].t() != replayed[i].t() {
+			return false
+		}
+		if !bytes.Equal(protocol.EncodeReflect(recorded[i]), protocol.EncodeReflect(replayed[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// drain reads the remaining runs, so that the goroutine reading the cadaver isn't left blocked.
+func (a *Autopsy) drain() {
+	for cdv := range a.cdvs {
+		drainTraces(autopsyTrace{}, cdv)
+	}
+}
+
+// drainTraces reads the remaining pairs of the current trace, and the remaining traces of its run.
+func drainTraces(current autopsyTrace, cdv cdvInstance) {
+	if current.p != nil {
+		for range current.p {
+		}
+	}
+	for tr := range cdv {
+		for range tr.p {
+		}
+	}
+}
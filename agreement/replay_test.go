@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func prepareReplay(t *testing.T, node int) *Autopsy {
+	cadaverFilename := fmt.Sprintf("%v-%v.cdv", t.Name(), node)
+	autopsy, err := PrepareAutopsy(cadaverFilename, func(int, AutopsyBounds) {}, func(n int, err error) {
+		require.NoError(t, err)
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { autopsy.Close() })
+	return autopsy
+}
+
+func removeCadavers(t *testing.T, numNodes int) {
+	for i := 0; i < numNodes; i++ {
+		cadaverFilename := fmt.Sprintf("%v-%v.cdv", t.Name(), i)
+		os.Remove(cadaverFilename)
+		os.Remove(cadaverFilename + ".archive")
+	}
+}
+
+func TestReplayCadaver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const numNodes = 3
+	defer removeCadavers(t, numNodes)
+	simulateAgreement(t, numNodes, 5, disabled)
+
+	for i := 0; i < numNodes; i++ {
+		var out bytes.Buffer
+		report := prepareReplay(t, i).Replay(ReplayOptions{}, &out)
+		require.NotZero(t, report.Events)
+		require.Empty(t, report.Divergences, out.String())
+		require.False(t, report.Stopped)
+	}
+}
+
+func TestReplayBreakpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const numNodes = 2
+	defer removeCadavers(t, numNodes)
+	simulateAgreement(t, numNodes, 5, disabled)
+
+	full := prepareReplay(t, 0).Replay(ReplayOptions{}, &bytes.Buffer{})
+
+	var out bytes.Buffer
+	breakpoint := ReplayBreakpoint{Round: 3}
+	report := prepareReplay(t, 0).Replay(ReplayOptions{Breakpoint: &breakpoint}, &out)
+	require.True(t, report.Stopped)
+	require.Empty(t, report.Divergences, out.String())
+	require.NotZero(t, report.Events)
+	require.Less(t, report.Events, full.Events)
+	require.True(t, strings.HasPrefix(out.String(), "replay: breakpoint reached at (3, 0, "), out.String())
+	require.Contains(t, out.String(), "autopsy: (breakpoint) player state is {Round:3 ")
+}
@@ -51,6 +51,8 @@ type Service struct {
 
 	voteVerifier    *AsyncVoteVerifier
 	persistenceLoop *asyncPersistenceLoop
+	equivocations   *equivocationRecorder
+	timelines       *timelineRecorder
 
 	monitor *coserviceMonitor
 
@@ -101,6 +103,10 @@ func MakeService(p Parameters) *Service {
 		s.Local.EnableAgreementReporting, s.Local.EnableAgreementTimeMetrics)
 
 	s.persistenceLoop = makeAsyncPersistenceLoop(s.log, s.Accessor, s.Ledger)
+	s.equivocations = makeEquivocationRecorder(s.log, s.Accessor)
+	s.tracer.equivocations = s.equivocations
+	s.timelines = makeTimelineRecorder()
+	s.tracer.timelines = s.timelines
 
 	return s
 }
@@ -137,9 +143,11 @@ func (s *Service) Start() {
 		voteVerifier: s.voteVerifier,
 		log:          s.log,
 		monitor:      s.monitor,
+		timelines:    s.timelines,
 	})
 
 	s.persistenceLoop.Start()
+	s.equivocations.Start()
 	input := make(chan externalEvent)
 	output := make(chan []action)
 	ready := make(chan externalDemuxSignals)
@@ -155,6 +163,18 @@ func (s *Service) Shutdown() {
 	s.quitFn()
 	<-s.done
 	s.persistenceLoop.Quit()
+	s.equivocations.Quit()
+}
+
+// Equivocations returns the evidence of the equivocations detected by this node, most recent first.
+func (s *Service) Equivocations() []EquivocationEvidence {
+	return s.equivocations.List()
+}
+
+// RoundTimelines returns the timelines of at most max of the latest rounds, the current one first. All of the kept
+// timelines are returned when max is zero.
+func (s *Service) RoundTimelines(max int) []RoundTimeline {
+	return s.timelines.List(max)
 }
 
 // demuxLoop repeatedly executes pending actions and then requests the next event from the Service.demux.
@@ -219,6 +239,8 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 	} else {
 		s.Clock = clock
 	}
+	// the player is set up in its round without entering it
+	s.timelines.startRound(status.Round, time.Now())
 
 	for {
 		output <- a
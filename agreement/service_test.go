@@ -121,6 +121,10 @@ func (m simpleKeyManager) DeleteOldKeys(basics.Round) {
 	// noop
 }
 
+func (m simpleKeyManager) Record(basics.Address, basics.Round, account.ParticipationAction) {
+	// noop
+}
+
 type testingNetwork struct {
 	validator BlockValidator
 
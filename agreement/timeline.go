@@ -0,0 +1,250 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// roundTimelineHistory is the number of rounds whose timeline is kept, including the current round.
+const roundTimelineHistory = 256
+
+// agreementStageBuckets are the upper bounds, in seconds, of the buckets of the agreement stage histogram. The stages
+// of a round take from a few milliseconds up to the filter timeouts of the later periods.
+var agreementStageBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 3, 4, 5, 7.5, 10, 20, 60}
+
+var agreementStageSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_agreement_stage_seconds", Description: "seconds from the start of a round to reaching each of its milestones, and seconds spent assembling and writing blocks, by stage"}, agreementStageBuckets)
+
+// PeriodTimeline records when the node reached the milestones of a period, as offsets from the start of the round.
+// The milestones which weren't reached are nil.
+type PeriodTimeline struct {
+	Period uint64
+
+	// Start is the time the node entered the period.
+	Start time.Duration
+
+	// ProposalReceived is the time the node received the first proposal payload in the period. It is negative when
+	// the payload arrived before the node entered the round.
+	ProposalReceived *time.Duration
+
+	// SoftThreshold and CertThreshold are the times the node saw a quorum of soft and cert votes for the period.
+	SoftThreshold *time.Duration
+	CertThreshold *time.Duration
+}
+
+// RoundTimeline records where the time of a round went.
+type RoundTimeline struct {
+	Round basics.Round
+
+	// Start is the time the node entered the round.
+	Start time.Time
+
+	// Duration is the time from entering the round to entering the next one, zero while the round is in progress.
+	Duration time.Duration
+
+	// BlockAssembly is the time the node spent assembling its proposal, zero when it didn't assemble any.
+	BlockAssembly time.Duration
+
+	// LedgerWrite is the time the node spent handing the agreed block over to the ledger.
+	LedgerWrite time.Duration
+
+	Periods []PeriodTimeline
+}
+
+// A timelineRecorder keeps the timelines of the latest rounds, and feeds the agreement stage histogram. The state
+// machine records the milestones it reaches through its tracer, while the pseudonode and the actions record the time
+// they spend on the blocks. A nil timelineRecorder records nothing.
+type timelineRecorder struct {
+	mu     sync.Mutex
+	rounds []RoundTimeline // oldest first; the last one is the current round.
+
+	// pipelinedProposal is the time the first proposal payload for the next round was received.
+	pipelinedProposal time.Time
+}
+
+func makeTimelineRecorder() *timelineRecorder {
+	return &timelineRecorder{}
+}
+
+// current returns the timeline of the given round if it is the current one, or nil. The caller holds the lock.
+func (tr *timelineRecorder) current(r round) *RoundTimeline {
+	if len(tr.rounds) == 0 || tr.rounds[len(tr.rounds)-1].Round != r {
+		return nil
+	}
+	return &tr.rounds[len(tr.rounds)-1]
+}
+
+// find returns the timeline of the given round, or nil if it isn't kept. The caller holds the lock.
+func (tr *timelineRecorder) find(r round) *RoundTimeline {
+	for i := len(tr.rounds) - 1; i >= 0; i-- {
+		if tr.rounds[i].Round == r {
+			return &tr.rounds[i]
+		}
+	}
+	return nil
+}
+
+// period returns the timeline of the given period of a round, starting it if the node skipped to a later period
+// before entering it. The caller holds the lock.
+func (rt *RoundTimeline) period(p period, now time.Time) *PeriodTimeline {
+	for i := range rt.Periods {
+		if rt.Periods[i].Period == uint64(p) {
+			return &rt.Periods[i]
+		}
+	}
+	rt.Periods = append(rt.Periods, PeriodTimeline{Period: uint64(p), Start: now.Sub(rt.Start)})
+	return &rt.Periods[len(rt.Periods)-1]
+}
+
+// startRound concludes the current round, and starts the timeline of the given round.
+func (tr *timelineRecorder) startRound(r round, now time.Time) {
+	if tr == nil {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	if len(tr.rounds) > 0 {
+		previous := &tr.rounds[len(tr.rounds)-1]
+		if previous.Round == r {
+			return
+		}
+		if previous.Duration == 0 {
+			previous.Duration = now.Sub(previous.Start)
+			agreementStageSeconds.Observe(previous.Duration.Seconds(), map[string]string{"stage": "round"})
+		}
+	}
+
+	rt := RoundTimeline{Round: r, Start: now, Periods: []PeriodTimeline{{Period: 0}}}
+	if !tr.pipelinedProposal.IsZero() && len(tr.rounds) > 0 && tr.rounds[len(tr.rounds)-1].Round+1 == r {
+		received := tr.pipelinedProposal.Sub(now)
+		rt.Periods[0].ProposalReceived = &received
+		// the payload was there as soon as the round started
+		agreementStageSeconds.Observe(0, map[string]string{"stage": "proposal"})
+	}
+	tr.pipelinedProposal = time.Time{}
+
+	tr.rounds = append(tr.rounds, rt)
+	if len(tr.rounds) > roundTimelineHistory {
+		tr.rounds = append([]RoundTimeline(nil), tr.rounds[len(tr.rounds)-roundTimelineHistory:]...)
+	}
+}
+
+// startPeriod records the time the node entered a period of the current round.
+func (tr *timelineRecorder) startPeriod(r round, p period, now time.Time) {
+	if tr == nil {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if rt := tr.current(r); rt != nil {
+		rt.period(p, now)
+	}
+}
+
+// proposalReceived records the time the node received a proposal payload for the given round and period. Only the
+// first payload of each period counts.
+func (tr *timelineRecorder) proposalReceived(r round, p period, now time.Time) {
+	if tr == nil {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	rt := tr.current(r)
+	if rt == nil {
+		if rt = tr.current(r - 1); rt != nil && tr.pipelinedProposal.IsZero() {
+			tr.pipelinedProposal = now
+		}
+		return
+	}
+	pt := rt.period(p, now)
+	if pt.ProposalReceived == nil {
+		received := now.Sub(rt.Start)
+		pt.ProposalReceived = &received
+		agreementStageSeconds.Observe(received.Seconds(), map[string]string{"stage": "proposal"})
+	}
+}
+
+// threshold records the time the node saw a soft or cert quorum in a period of the current round.
+func (tr *timelineRecorder) threshold(e thresholdEvent, now time.Time) {
+	if tr == nil || (e.T != softThreshold && e.T != certThreshold) {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	rt := tr.current(e.Round)
+	if rt == nil {
+		return
+	}
+	pt := rt.period(e.Period, now)
+	reached := now.Sub(rt.Start)
+	switch {
+	case e.T == softThreshold && pt.SoftThreshold == nil:
+		pt.SoftThreshold = &reached
+		agreementStageSeconds.Observe(reached.Seconds(), map[string]string{"stage": "soft"})
+	case e.T == certThreshold && pt.CertThreshold == nil:
+		pt.CertThreshold = &reached
+		agreementStageSeconds.Observe(reached.Seconds(), map[string]string{"stage": "cert"})
+	}
+}
+
+// blockAssembled records the time the node spent assembling its proposal for the given round.
+func (tr *timelineRecorder) blockAssembled(r round, d time.Duration) {
+	if tr == nil {
+		return
+	}
+	agreementStageSeconds.Observe(d.Seconds(), map[string]string{"stage": "assembly"})
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if rt := tr.find(r); rt != nil {
+		rt.BlockAssembly += d
+	}
+}
+
+// ledgerWritten records the time the node spent handing the block of the given round over to the ledger.
+func (tr *timelineRecorder) ledgerWritten(r round, d time.Duration) {
+	if tr == nil {
+		return
+	}
+	agreementStageSeconds.Observe(d.Seconds(), map[string]string{"stage": "ledger"})
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if rt := tr.find(r); rt != nil {
+		rt.LedgerWrite += d
+	}
+}
+
+// List returns the timelines of at most max of the latest rounds, most recent first. All of the kept timelines are
+// returned when max is zero.
+func (tr *timelineRecorder) List(max int) []RoundTimeline {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	n := len(tr.rounds)
+	if max > 0 && max < n {
+		n = max
+	}
+	list := make([]RoundTimeline, n)
+	for i := range list {
+		list[i] = tr.rounds[len(tr.rounds)-1-i]
+		list[i].Periods = append([]PeriodTimeline(nil), list[i].Periods...)
+	}
+	return list
+}
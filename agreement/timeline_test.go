@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTimelineRecorder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	at := func(d time.Duration) time.Time {
+		return time.Unix(1600000000, 0).Add(d)
+	}
+	offset := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	tr := makeTimelineRecorder()
+	tr.startRound(10, at(0))
+	tr.startPeriod(10, 0, at(0))
+	tr.blockAssembled(10, 200*time.Millisecond)
+	tr.proposalReceived(10, 0, at(500*time.Millisecond))
+	tr.proposalReceived(10, 0, at(600*time.Millisecond))
+	tr.threshold(thresholdEvent{T: softThreshold, Round: 10, Period: 0}, at(time.Second))
+	tr.threshold(thresholdEvent{T: nextThreshold, Round: 10, Period: 0, Step: next}, at(5*time.Second))
+	tr.startPeriod(10, 1, at(5*time.Second))
+	tr.threshold(thresholdEvent{T: softThreshold, Round: 10, Period: 1}, at(6*time.Second))
+	tr.threshold(thresholdEvent{T: certThreshold, Round: 10, Period: 1}, at(6500*time.Millisecond))
+	// the payload for the next round arrives before the node enters it
+	tr.proposalReceived(11, 0, at(6900*time.Millisecond))
+	tr.startRound(11, at(7*time.Second))
+	tr.ledgerWritten(10, 50*time.Millisecond)
+	// a stale threshold doesn't count
+	tr.threshold(thresholdEvent{T: certThreshold, Round: 10, Period: 0}, at(7500*time.Millisecond))
+
+	list := tr.List(0)
+	require.Equal(t, []RoundTimeline{{
+		Round:   11,
+		Start:   at(7 * time.Second),
+		Periods: []PeriodTimeline{{Period: 0, ProposalReceived: offset(-100 * time.Millisecond)}},
+	}, {
+		Round:         10,
+		Start:         at(0),
+		Duration:      7 * time.Second,
+		BlockAssembly: 200 * time.Millisecond,
+		LedgerWrite:   50 * time.Millisecond,
+		Periods: []PeriodTimeline{
+			{Period: 0, ProposalReceived: offset(500 * time.Millisecond), SoftThreshold: offset(time.Second)},
+			{Period: 1, Start: 5 * time.Second, SoftThreshold: offset(6 * time.Second), CertThreshold: offset(6500 * time.Millisecond)},
+		},
+	}}, list)
+	require.Equal(t, list[:1], tr.List(1))
+
+	// only the latest rounds are kept
+	for r := round(12); r < 12+2*roundTimelineHistory; r++ {
+		tr.startRound(r, at(time.Duration(r)*time.Second))
+	}
+	list = tr.List(0)
+	require.Len(t, list, roundTimelineHistory)
+	require.Equal(t, round(11+2*roundTimelineHistory), list[0].Round)
+	require.Equal(t, round(12+roundTimelineHistory), list[len(list)-1].Round)
+
+	// a nil recorder records nothing
+	var none *timelineRecorder
+	none.startRound(1, at(0))
+	none.threshold(thresholdEvent{T: softThreshold, Round: 1}, at(0))
+	none.ledgerWritten(1, time.Second)
+}
+
+func TestServiceRoundTimelines(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const numNodes = 3
+	const numRounds = 3
+	_, baseLedger, cleanupFn, services, clocks, _, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	defer cleanupFn()
+	startRound := baseLedger.NextRound()
+
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(clocks, 0)
+	for j := 0; j < numRounds; j++ {
+		zeroes = runRound(clocks, activityMonitor, zeroes, FilterTimeout(0, protocol.ConsensusCurrentVersion))
+	}
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+
+	assembled := false
+	for i := 0; i < numNodes; i++ {
+		timelines := services[i].RoundTimelines(0)
+		require.Len(t, timelines, numRounds+1)
+		require.Equal(t, startRound+numRounds, timelines[0].Round)
+		require.Zero(t, timelines[0].Duration)
+		for _, timeline := range timelines[1:] {
+			require.NotZero(t, timeline.Duration)
+			require.NotZero(t, timeline.LedgerWrite)
+			require.NotEmpty(t, timeline.Periods)
+			require.NotNil(t, timeline.Periods[0].ProposalReceived)
+			require.NotNil(t, timeline.Periods[0].SoftThreshold)
+			require.NotNil(t, timeline.Periods[0].CertThreshold)
+			assembled = assembled || timeline.BlockAssembly != 0
+		}
+	}
+	require.True(t, assembled, "no node recorded assembling a block")
+}
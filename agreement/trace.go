@@ -70,6 +70,12 @@ type tracer struct {
 	verboseReports bool
 	// if timingReports is true, telemetrize more fine-grained agreement timing data
 	timingReports bool
+
+	// equivocations keeps the evidence of the detected equivocations. Optional.
+	equivocations *equivocationRecorder
+
+	// timelines keeps the timelines of the latest rounds. Optional.
+	timelines *timelineRecorder
 }
 
 const cadaverSizeMinimum = 100 * 1024 // 100 KB
@@ -123,6 +129,13 @@ func (t *tracer) timeRPlus1() *timingInfoGenerator {
 	return t.tRPlus1
 }
 
+// recordEquivocation hands the evidence of an equivocation over to the equivocation recorder, if any.
+func (t *tracer) recordEquivocation(ev equivocationVote) {
+	if t.equivocations != nil {
+		t.equivocations.Record(ev)
+	}
+}
+
 // setMetadata configures tracer to print round/period/step information.
 // optional.
 func (t *tracer) setMetadata(metadata tracerMetadata) {
@@ -211,6 +211,7 @@ func (tracker *voteTracker) handle(r routerHandle, p player, e0 event) event {
 				Proposals: [2]proposalValue{oldVote.R.Proposal, e.Vote.R.Proposal},
 				Sigs:      [2]crypto.OneTimeSignature{oldVote.Sig, e.Vote.Sig},
 			}
+			r.t.recordEquivocation(tracker.Equivocators[sender])
 			// delete the equivocator from the set of voters
 			delete(tracker.Voters, sender)
 
@@ -268,6 +268,20 @@ func (hs *historicStats) push(value int, counter uint64, class peerClass) (avera
 	return bounded
 }
 
+// PeerSelectorStats summarizes how the peer selector currently ranks the peers it fetches blocks from.
+type PeerSelectorStats struct {
+	// Peers is the number of peers known to the peer selector.
+	Peers int
+	// FailingPeers is the number of peers that were demoted after failed or invalid downloads.
+	FailingPeers int
+	// RankGroups is the number of distinct ranks the peers are grouped by.
+	RankGroups int
+	// BestRank is the rank of the peers tried first, or -1 when there are no peers.
+	BestRank int
+	// RankedRequests is the number of requests for which the peer selector ranked a peer.
+	RankedRequests uint64
+}
+
 // makePeerSelector creates a peerSelector, given a peersRetriever and peerClass array.
 func makePeerSelector(net peersRetriever, initialPeersClasses []peerClass) *peerSelector {
 	selector := &peerSelector{
@@ -367,6 +381,25 @@ func (ps *peerSelector) rankPeer(psp *peerSelectorPeer, rank int) (int, int) {
 	return initialRank, rank
 }
 
+// stats returns a summary of the current ranking of the peers.
+func (ps *peerSelector) stats() (stats PeerSelectorStats) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	stats.BestRank = -1
+	stats.RankGroups = len(ps.pools)
+	stats.RankedRequests = ps.counter
+	for _, pool := range ps.pools {
+		if len(pool.peers) > 0 && stats.BestRank < 0 {
+			stats.BestRank = pool.rank
+		}
+		stats.Peers += len(pool.peers)
+		if pool.rank >= peerRankDownloadFailed {
+			stats.FailingPeers += len(pool.peers)
+		}
+	}
+	return
+}
+
 // peerDownloadDurationToRank calculates the rank for a peer given a peer and the block download time.
 func (ps *peerSelector) peerDownloadDurationToRank(psp *peerSelectorPeer, blockDownloadDuration time.Duration) (rank int) {
 	ps.mu.Lock()
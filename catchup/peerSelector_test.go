@@ -227,6 +227,36 @@ func TestPeerDownloadRanking(t *testing.T) {
 	require.Equal(t, peerRankInvalidDownload, peerSelector.peerDownloadDurationToRank(&peerSelectorPeer{mockHTTPPeer{address: "abc123"}, 0}, time.Millisecond))
 }
 
+func TestPeerSelectorStats(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	peers := []network.Peer{&mockHTTPPeer{address: "1234"}, &mockHTTPPeer{address: "5678"}}
+	peerSelector := makePeerSelector(
+		makePeersRetrieverStub(func(options ...network.PeerOption) []network.Peer {
+			return peers
+		}), []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookArchivers}},
+	)
+
+	stats := peerSelector.stats()
+	require.Equal(t, PeerSelectorStats{BestRank: -1}, stats)
+
+	psp, err := peerSelector.getNextPeer()
+	require.NoError(t, err)
+	stats = peerSelector.stats()
+	require.Equal(t, 2, stats.Peers)
+	require.Equal(t, 0, stats.FailingPeers)
+	require.Equal(t, 1, stats.RankGroups)
+	require.Equal(t, peerRankInitialFirstPriority, stats.BestRank)
+
+	peerSelector.rankPeer(psp, peerRankInvalidDownload)
+	stats = peerSelector.stats()
+	require.Equal(t, 2, stats.Peers)
+	require.Equal(t, 1, stats.FailingPeers)
+	require.Equal(t, 2, stats.RankGroups)
+	require.Equal(t, peerRankInitialFirstPriority, stats.BestRank)
+	require.Equal(t, uint64(1), stats.RankedRequests)
+}
+
 func TestFindMissingPeer(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
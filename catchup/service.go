@@ -89,6 +89,10 @@ type Service struct {
 	protocolErrorLogged          bool
 	lastSupportedRound           basics.Round
 	unmatchedPendingCertificates <-chan PendingUnmatchedCertificate
+
+	// lastPeerSelector holds the *peerSelector used by the most recent pipelined fetch, so
+	// that its peer ranking can be reported while and after the fetch runs.
+	lastPeerSelector atomic.Value
 }
 
 // A BlockAuthenticator authenticates blocks given a certificate.
@@ -156,6 +160,16 @@ func (s *Service) SynchronizingTime() time.Duration {
 	return time.Duration(timeInNS - startNS)
 }
 
+// PeerSelectorStats returns the peer ranking statistics of the most recent catchup pass.
+// The second return value is false if the service did not catch up since it was started.
+func (s *Service) PeerSelectorStats() (PeerSelectorStats, bool) {
+	ps, ok := s.lastPeerSelector.Load().(*peerSelector)
+	if !ok {
+		return PeerSelectorStats{BestRank: -1}, false
+	}
+	return ps.stats(), true
+}
+
 // function scope to make a bunch of defer statements better
 func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeeping.Block, cert *agreement.Certificate, ddur time.Duration, err error) {
 	ctx, cf := context.WithCancel(s.ctx)
@@ -384,6 +398,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 	}()
 
 	peerSelector := s.createPeerSelector(true)
+	s.lastPeerSelector.Store(peerSelector)
 
 	if _, err := peerSelector.getNextPeer(); err == errPeerSelectorNoPeerPoolsAvailable {
 		s.log.Debugf("pipelinedFetch: was unable to obtain a peer to retrieve the block from")
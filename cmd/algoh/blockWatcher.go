@@ -20,15 +20,15 @@ import (
 	"sync"
 	"time"
 
-	"github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/rpcs"
 )
 
 var log = logging.Base()
 
 type blockListener interface {
 	init(uint64)
-	onBlock(v1.Block)
+	onBlock(rpcs.EncodedBlockCert)
 }
 
 type blockWatcher struct {
@@ -75,7 +75,7 @@ func (bw *blockWatcher) run(watchers []blockListener, stallDetect time.Duration,
 	for {
 		// Inner loop needed during catchup.
 		for {
-			block, err := bw.client.Block(curBlock)
+			block, err := bw.client.BlockCert(curBlock)
 
 			// Generally this error will be due to the new block not being ready. In the case of a stall we will
 			// return, causing the loop to restart and handle any possible stall/catchup.
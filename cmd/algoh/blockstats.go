@@ -19,8 +19,9 @@ package main
 import (
 	"time"
 
-	"github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/rpcs"
 )
 
 const downtimeLimit time.Duration = 5 * time.Minute
@@ -34,20 +35,22 @@ type blockstats struct {
 func (stats *blockstats) init(block uint64) {
 }
 
-func (stats *blockstats) onBlock(block v1.Block) {
+func (stats *blockstats) onBlock(blockCert rpcs.EncodedBlockCert) {
 	now := time.Now()
+	block := blockCert.Block
+	round := uint64(block.Round())
 
 	// Ensure we only create stats from consecutive blocks.
-	if stats.lastBlock+1 != block.Round {
-		stats.lastBlock = block.Round
+	if stats.lastBlock+1 != round {
+		stats.lastBlock = round
 		stats.lastBlockTime = now
 		return
 	}
 
 	// Grab unique users.
 	users := make(map[string]bool)
-	for _, tx := range block.Transactions.Transactions {
-		users[tx.From] = true
+	for _, tx := range block.Payset {
+		users[tx.Txn.Sender.String()] = true
 	}
 
 	duration := now.Sub(stats.lastBlockTime)
@@ -57,15 +60,15 @@ func (stats *blockstats) onBlock(block v1.Block) {
 	}
 
 	stats.log.EventWithDetails(telemetryspec.Agreement, telemetryspec.BlockStatsEvent, telemetryspec.BlockStatsEventDetails{
-		Hash:                block.Hash,
-		OriginalProposer:    block.Proposer,
-		Round:               block.Round,
-		Transactions:        uint64(len(block.Transactions.Transactions)),
+		Hash:                crypto.Digest(block.Hash()).String(),
+		OriginalProposer:    blockCert.Certificate.Proposal.OriginalProposer.String(),
+		Round:               round,
+		Transactions:        uint64(len(block.Payset)),
 		ActiveUsers:         uint64(len(users)),
 		AgreementDurationMs: uint64(duration.Nanoseconds() / 1000 / 1000),
 		NetworkDowntimeMs:   uint64(downtime.Nanoseconds() / 1000 / 1000),
 	})
 
-	stats.lastBlock = block.Round
+	stats.lastBlock = round
 	stats.lastBlockTime = now
 }
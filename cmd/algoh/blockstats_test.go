@@ -20,7 +20,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
@@ -47,13 +46,13 @@ func TestConsecutiveBlocks(t *testing.T) {
 	sender := MockEventSender{}
 	bs := blockstats{log: &sender}
 
-	bs.onBlock(v1.Block{Round: 300})
+	bs.onBlock(makeBlockCert(300))
 	// first consecutive block
-	bs.onBlock(v1.Block{Round: 301})
+	bs.onBlock(makeBlockCert(301))
 	// reset
-	bs.onBlock(v1.Block{Round: 303})
+	bs.onBlock(makeBlockCert(303))
 	// second consecutive block
-	bs.onBlock(v1.Block{Round: 304})
+	bs.onBlock(makeBlockCert(304))
 
 	require.Equal(t, 2, len(sender.events))
 }
@@ -70,9 +69,9 @@ func TestAgreementTime(t *testing.T) {
 		bs := blockstats{log: &sender}
 
 		start := time.Now()
-		bs.onBlock(v1.Block{Round: 300})
+		bs.onBlock(makeBlockCert(300))
 		time.Sleep(sleepTime)
-		bs.onBlock(v1.Block{Round: 301})
+		bs.onBlock(makeBlockCert(301))
 		end := time.Now()
 
 		require.Equal(t, 1, len(sender.events))
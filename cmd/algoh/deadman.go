@@ -23,8 +23,8 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/config"
-	"github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/rpcs"
 )
 
 type deadManWatcher struct {
@@ -88,8 +88,8 @@ func (w deadManWatcher) run(initBlock uint64) {
 	}
 }
 
-func (w deadManWatcher) onBlock(block v1.Block) {
-	w.newBlockChan <- block.Round
+func (w deadManWatcher) onBlock(block rpcs.EncodedBlockCert) {
+	w.newBlockChan <- uint64(block.Block.Round())
 }
 
 func (w deadManWatcher) reportDeadManTimeout(curBlock uint64) (err error) {
@@ -21,7 +21,9 @@ import (
 	"fmt"
 
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
-	"github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/rpcs"
 )
 
 //////////////////////////////////////
@@ -36,10 +38,14 @@ func makeNodeStatuses(blocks ...uint64) (ret []generatedV2.NodeStatusResponse) {
 	return ret
 }
 
-func makeBlocks(blocks ...uint64) (ret map[uint64]v1.Block) {
-	ret = map[uint64]v1.Block{}
+func makeBlockCert(round uint64) rpcs.EncodedBlockCert {
+	return rpcs.EncodedBlockCert{Block: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: basics.Round(round)}}}
+}
+
+func makeBlocks(blocks ...uint64) (ret map[uint64]rpcs.EncodedBlockCert) {
+	ret = map[uint64]rpcs.EncodedBlockCert{}
 	for _, block := range blocks {
-		ret[block] = v1.Block{Round: block}
+		ret[block] = makeBlockCert(block)
 	}
 	return ret
 }
@@ -54,10 +60,10 @@ type mockClient struct {
 	error              []error
 	status             []generatedV2.NodeStatusResponse
 	routine            []string
-	block              map[uint64]v1.Block
+	block              map[uint64]rpcs.EncodedBlockCert
 }
 
-func makeMockClient(error []error, status []generatedV2.NodeStatusResponse, block map[uint64]v1.Block, routine []string) mockClient {
+func makeMockClient(error []error, status []generatedV2.NodeStatusResponse, block map[uint64]rpcs.EncodedBlockCert, routine []string) mockClient {
 	return mockClient{
 		BlockCalls: make(map[uint64]int),
 		error:      error,
@@ -90,7 +96,7 @@ func (c *mockClient) Status() (s generatedV2.NodeStatusResponse, e error) {
 	return
 }
 
-func (c *mockClient) Block(block uint64) (b v1.Block, e error) {
+func (c *mockClient) BlockCert(block uint64) (b rpcs.EncodedBlockCert, e error) {
 	c.BlockCalls[block]++
 	e = c.nextError()
 	b, ok := c.block[block]
@@ -89,6 +89,7 @@ func init() {
 
 	accountCmd.AddCommand(renewParticipationKeyCmd)
 	accountCmd.AddCommand(renewAllParticipationKeyCmd)
+	accountCmd.AddCommand(scheduleKeyregCmd)
 
 	accountCmd.AddCommand(partkeyInfoCmd)
 
@@ -182,6 +183,12 @@ func init() {
 	renewParticipationKeyCmd.MarkFlagRequired("roundLastValid")
 	renewParticipationKeyCmd.Flags().Uint64VarP(&keyDilution, "keyDilution", "", 0, "Key dilution for two-level participation keys")
 	renewParticipationKeyCmd.Flags().BoolVarP(&noWaitAfterSend, "no-wait", "N", false, "Don't wait for transaction to commit")
+	renewParticipationKeyCmd.Flags().Uint64Var(&firstValid, "firstvalid", 0, "The first round for which the generated partkey will be valid, and from which its key registration may be submitted (0 for current)")
+	renewParticipationKeyCmd.Flags().StringVarP(&statusChangeTxFile, "txfile", "t", "", "Write the signed key registration transaction to this file, rather than posting it to the network")
+
+	// scheduleKeyregCmd
+	scheduleKeyregCmd.Flags().StringVarP(&statusChangeTxFile, "txfile", "t", "", "Signed key registration transaction to schedule (required)")
+	scheduleKeyregCmd.MarkFlagRequired("txfile")
 
 	// renewAllParticipationKeyCmd
 	renewAllParticipationKeyCmd.Flags().Uint64VarP(&transactionFee, "fee", "f", 0, "The Fee to set on the status change transactions (defaults to suggested fee)")
@@ -476,7 +483,7 @@ var listCmd = &cobra.Command{
 
 		// For each address, request information about it from algod
 		for _, addr := range addrs {
-			response, _ := client.AccountInformationV2(addr.Addr)
+			response, _ := client.AccountInformation(addr.Addr)
 			// it's okay to proceed without algod info
 
 			// Display this information to the user
@@ -512,7 +519,7 @@ var infoCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		dataDir := ensureSingleDataDir()
 		client := ensureAlgodClient(dataDir)
-		response, err := client.AccountInformationV2(accountAddress)
+		response, err := client.AccountInformation(accountAddress)
 		if err != nil {
 			reportErrorf(errorRequestFail, err)
 		}
@@ -604,7 +611,7 @@ func printAccountInfo(client libgoal.Client, address string, account generatedV2
 		fmt.Fprintln(report, "\t<none>")
 	}
 	for _, assetHolding := range heldAssets {
-		assetParams, err := client.AssetInformationV2(assetHolding.AssetId)
+		assetParams, err := client.AssetInformation(assetHolding.AssetId)
 		if err != nil {
 			hasError = true
 			fmt.Fprintf(errorReport, "Error: Unable to retrieve asset information for asset %d referred to by account %s: %v\n", assetHolding.AssetId, address, err)
@@ -928,8 +935,16 @@ var renewParticipationKeyCmd = &cobra.Command{
 		}
 		proto := config.Consensus[protocol.ConsensusVersion(params.ConsensusVersion)]
 
-		if roundLastValid <= (currentRound + proto.MaxTxnLife) {
-			reportErrorf(errLastRoundInvalid, currentRound)
+		keyFirstValid := currentRound
+		if firstValid != 0 {
+			if firstValid < currentRound {
+				reportErrorf(errFirstRoundInvalid, currentRound)
+			}
+			keyFirstValid = firstValid
+		}
+
+		if roundLastValid <= (keyFirstValid + proto.MaxTxnLife) {
+			reportErrorf(errLastRoundInvalid, keyFirstValid)
 		}
 
 		// Make sure we don't already have a partkey valid for (or after) specified roundLastValid
@@ -945,14 +960,14 @@ var renewParticipationKeyCmd = &cobra.Command{
 			}
 		}
 
-		err = generateAndRegisterPartKey(accountAddress, currentRound, roundLastValid, transactionFee, scLeaseBytes(cmd), keyDilution, walletName, dataDir, client)
+		err = generateAndRegisterPartKey(accountAddress, keyFirstValid, roundLastValid, statusChangeTxFile, transactionFee, scLeaseBytes(cmd), keyDilution, walletName, dataDir, client)
 		if err != nil {
 			reportErrorf(err.Error())
 		}
 	},
 }
 
-func generateAndRegisterPartKey(address string, currentRound, lastValidRound uint64, fee uint64, leaseBytes [32]byte, dilution uint64, wallet string, dataDir string, client libgoal.Client) error {
+func generateAndRegisterPartKey(address string, currentRound, lastValidRound uint64, txFile string, fee uint64, leaseBytes [32]byte, dilution uint64, wallet string, dataDir string, client libgoal.Client) error {
 	// Generate a participation keys database and install it
 	part, keyPath, err := client.GenParticipationKeysTo(address, currentRound, lastValidRound, dilution, "")
 	if err != nil {
@@ -960,10 +975,25 @@ func generateAndRegisterPartKey(address string, currentRound, lastValidRound uin
 	}
 	fmt.Printf("  Generated participation key for %s (Valid %d - %d)\n", address, currentRound, lastValidRound)
 
+	if txFile != "" {
+		// Write out the signed registration so that it can be scheduled, rather than sending it now
+		var utx transactions.Transaction
+		utx, err = client.MakeUnsignedGoOnlineTx(address, &part, currentRound, 0, fee, leaseBytes)
+		if err == nil {
+			err = writeTxnToFile(client, true, dataDir, wallet, utx, txFile)
+		}
+		if err != nil {
+			os.Remove(keyPath)
+			fmt.Fprintf(os.Stderr, "  Error writing key registration - deleting newly-generated key file: %s\n", keyPath)
+			return err
+		}
+		fmt.Printf("  Signed key registration transaction written to %s (valid from round %d)\n", txFile, utx.FirstValid)
+		return nil
+	}
+
 	// Now register it as our new online participation key
 	goOnline := true
-	txFile := ""
-	err = changeAccountOnlineStatus(address, &part, goOnline, txFile, wallet, currentRound, lastValidRound, fee, leaseBytes, dataDir, client)
+	err = changeAccountOnlineStatus(address, &part, goOnline, "", wallet, currentRound, lastValidRound, fee, leaseBytes, dataDir, client)
 	if err != nil {
 		os.Remove(keyPath)
 		fmt.Fprintf(os.Stderr, "  Error registering keys - deleting newly-generated key file: %s\n", keyPath)
@@ -1039,7 +1069,7 @@ func renewPartKeysInDir(dataDir string, lastValidRound uint64, fee uint64, lease
 		}
 
 		address := renewPart.Address().String()
-		err = generateAndRegisterPartKey(address, currentRound, lastValidRound, fee, leaseBytes, dilution, wallet, dataDir, client)
+		err = generateAndRegisterPartKey(address, currentRound, lastValidRound, "", fee, leaseBytes, dilution, wallet, dataDir, client)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "  Error renewing part key for account %s: %v\n", address, err)
 			anyErrors = true
@@ -1051,6 +1081,23 @@ func renewPartKeysInDir(dataDir string, lastValidRound uint64, fee uint64, lease
 	return nil
 }
 
+var scheduleKeyregCmd = &cobra.Command{
+	Use:   "schedulekeyreg",
+	Short: "Schedule a signed key registration transaction",
+	Long:  `Queue a signed key registration transaction, such as one written by "goal account renewpartkey --txfile", in the node's data directory. The node submits it once the transaction's first valid round is reached, and resubmits it until it is applied or expires. The participation key it registers should already be installed on the node.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := ensureSingleDataDir()
+
+		client := ensureAlgodClient(dataDir)
+		stxn, keyregPath, err := client.ScheduleKeyRegistration(statusChangeTxFile)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		fmt.Printf("Key registration for %s scheduled for round %d: %s\n", stxn.Txn.Sender, stxn.Txn.FirstValid, keyregPath)
+	},
+}
+
 var listParticipationKeysCmd = &cobra.Command{
 	Use:   "listpartkeys",
 	Short: "List participation keys",
@@ -1080,10 +1127,10 @@ var listParticipationKeysCmd = &cobra.Command{
 				votingBytes := parts[fn].Voting.OneTimeSignatureVerifier
 				vrfBytes := parts[fn].VRF.PK
 				if onlineAccountInfo.Participation != nil &&
-					(string(onlineAccountInfo.Participation.ParticipationPK) == string(votingBytes[:])) &&
-					(string(onlineAccountInfo.Participation.VRFPK) == string(vrfBytes[:])) &&
-					(onlineAccountInfo.Participation.VoteFirst == uint64(parts[fn].FirstValid)) &&
-					(onlineAccountInfo.Participation.VoteLast == uint64(parts[fn].LastValid)) &&
+					(string(onlineAccountInfo.Participation.VoteParticipationKey) == string(votingBytes[:])) &&
+					(string(onlineAccountInfo.Participation.SelectionParticipationKey) == string(vrfBytes[:])) &&
+					(onlineAccountInfo.Participation.VoteFirstValid == uint64(parts[fn].FirstValid)) &&
+					(onlineAccountInfo.Participation.VoteLastValid == uint64(parts[fn].LastValid)) &&
 					(onlineAccountInfo.Participation.VoteKeyDilution == parts[fn].KeyDilution) {
 					onlineInfoStr = "yes"
 				} else {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/libgoal"
 )
@@ -161,10 +162,12 @@ func lookupAssetID(cmd *cobra.Command, creator string, client libgoal.Client) {
 	}
 
 	nmatch := 0
-	for id, params := range response.AssetParams {
-		if params.UnitName == assetUnitName {
-			assetID = id
-			nmatch++
+	if response.CreatedAssets != nil {
+		for _, asset := range *response.CreatedAssets {
+			if asset.Params.UnitName != nil && *asset.Params.UnitName == assetUnitName {
+				assetID = asset.Index
+				nmatch++
+			}
 		}
 	}
 
@@ -240,8 +243,8 @@ var createAssetCmd = &cobra.Command{
 				if err != nil {
 					reportErrorf(err.Error())
 				}
-				if txn.TransactionResults != nil && txn.TransactionResults.CreatedAssetIndex != 0 {
-					reportInfof("Created asset with asset index %d", txn.TransactionResults.CreatedAssetIndex)
+				if txn.AssetIndex != nil && *txn.AssetIndex != 0 {
+					reportInfof("Created asset with asset index %d", *txn.AssetIndex)
 				}
 			}
 		} else {
@@ -603,40 +606,61 @@ var infoAssetCmd = &cobra.Command{
 
 		lookupAssetID(cmd, creator, client)
 
-		params, err := client.AssetInformation(assetID)
+		asset, err := client.AssetInformation(assetID)
 		if err != nil {
 			reportErrorf(errorRequestFail, err)
 		}
+		params := asset.Params
+
+		orEmpty := func(s *string) string {
+			if s == nil {
+				return ""
+			}
+			return *s
+		}
 
 		reserveEmpty := false
-		if params.ReserveAddr == "" {
+		reserveAddr := orEmpty(params.Reserve)
+		if reserveAddr == "" {
 			reserveEmpty = true
-			params.ReserveAddr = params.Creator
+			reserveAddr = params.Creator
 		}
 
-		reserve, err := client.AccountInformation(params.ReserveAddr)
+		reserve, err := client.AccountInformation(reserveAddr)
 		if err != nil {
 			reportErrorf(errorRequestFail, err)
 		}
 
-		res := reserve.Assets[assetID]
+		var res generatedV2.AssetHolding
+		if reserve.Assets != nil {
+			for _, holding := range *reserve.Assets {
+				if holding.AssetId == assetID {
+					res = holding
+					break
+				}
+			}
+		}
+
+		decimals := uint32(params.Decimals)
+		unitName := orEmpty(params.UnitName)
+		defaultFrozen := params.DefaultFrozen != nil && *params.DefaultFrozen
 
 		fmt.Printf("Asset ID:         %d\n", assetID)
 		fmt.Printf("Creator:          %s\n", params.Creator)
-		reportInfof("Asset name:       %s\n", params.AssetName)
-		reportInfof("Unit name:        %s\n", params.UnitName)
-		fmt.Printf("Maximum issue:    %s %s\n", assetDecimalsFmt(params.Total, params.Decimals), params.UnitName)
-		fmt.Printf("Reserve amount:   %s %s\n", assetDecimalsFmt(res.Amount, params.Decimals), params.UnitName)
-		fmt.Printf("Issued:           %s %s\n", assetDecimalsFmt(params.Total-res.Amount, params.Decimals), params.UnitName)
+		reportInfof("Asset name:       %s\n", orEmpty(params.Name))
+		reportInfof("Unit name:        %s\n", unitName)
+		fmt.Printf("Maximum issue:    %s %s\n", assetDecimalsFmt(params.Total, decimals), unitName)
+		fmt.Printf("Reserve amount:   %s %s\n", assetDecimalsFmt(res.Amount, decimals), unitName)
+		fmt.Printf("Issued:           %s %s\n", assetDecimalsFmt(params.Total-res.Amount, decimals), unitName)
 		fmt.Printf("Decimals:         %d\n", params.Decimals)
-		fmt.Printf("Default frozen:   %v\n", params.DefaultFrozen)
-		fmt.Printf("Manager address:  %s\n", params.ManagerAddr)
+		fmt.Printf("Default frozen:   %v\n", defaultFrozen)
+		fmt.Printf("Manager address:  %s\n", orEmpty(params.Manager))
 		if reserveEmpty {
-			fmt.Printf("Reserve address:  %s (Empty. Defaulting to creator)\n", params.ReserveAddr)
+			fmt.Printf("Reserve address:  %s (Empty. Defaulting to creator)\n", reserveAddr)
 		} else {
-			fmt.Printf("Reserve address:  %s\n", params.ReserveAddr)
+			fmt.Printf("Reserve address:  %s\n", reserveAddr)
 		}
-		fmt.Printf("Freeze address:   %s\n", params.FreezeAddr)
-		fmt.Printf("Clawback address: %s\n", params.ClawbackAddr)
+		fmt.Printf("Freeze address:   %s\n", orEmpty(params.Freeze))
+		fmt.Printf("Clawback address: %s\n", orEmpty(params.Clawback))
 	},
 }
@@ -29,7 +29,6 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
-	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -152,41 +151,41 @@ var clerkCmd = &cobra.Command{
 	},
 }
 
-func waitForCommit(client libgoal.Client, txid string, transactionLastValidRound uint64) (txn v1.Transaction, err error) {
+func waitForCommit(client libgoal.Client, txid string, transactionLastValidRound uint64) (txn generatedV2.PendingTransactionResponse, err error) {
 	// Get current round information
 	stat, err := client.Status()
 	if err != nil {
-		return v1.Transaction{}, fmt.Errorf(errorRequestFail, err)
+		return generatedV2.PendingTransactionResponse{}, fmt.Errorf(errorRequestFail, err)
 	}
 
 	for {
 		// Check if we know about the transaction yet
 		txn, err = client.PendingTransactionInformation(txid)
 		if err != nil {
-			return v1.Transaction{}, fmt.Errorf(errorRequestFail, err)
+			return generatedV2.PendingTransactionResponse{}, fmt.Errorf(errorRequestFail, err)
 		}
 
-		if txn.ConfirmedRound > 0 {
-			reportInfof(infoTxCommitted, txid, txn.ConfirmedRound)
+		if txn.ConfirmedRound != nil && *txn.ConfirmedRound > 0 {
+			reportInfof(infoTxCommitted, txid, *txn.ConfirmedRound)
 			break
 		}
 
 		if txn.PoolError != "" {
-			return v1.Transaction{}, fmt.Errorf(txPoolError, txid, txn.PoolError)
+			return generatedV2.PendingTransactionResponse{}, fmt.Errorf(txPoolError, txid, txn.PoolError)
 		}
 
 		// check if we've already committed to the block number equals to the transaction's last valid round.
 		// if this is the case, the transaction would not be included in the blockchain, and we can exit right
 		// here.
 		if transactionLastValidRound > 0 && stat.LastRound >= transactionLastValidRound {
-			return v1.Transaction{}, fmt.Errorf(errorTransactionExpired, txid)
+			return generatedV2.PendingTransactionResponse{}, fmt.Errorf(errorTransactionExpired, txid)
 		}
 
 		reportInfof(infoTxPending, txid, stat.LastRound)
 		// WaitForRound waits until round "stat.LastRound+1" is committed
 		stat, err = client.WaitForRound(stat.LastRound)
 		if err != nil {
-			return v1.Transaction{}, fmt.Errorf(errorRequestFail, err)
+			return generatedV2.PendingTransactionResponse{}, fmt.Errorf(errorRequestFail, err)
 		}
 	}
 
@@ -582,8 +581,8 @@ var rawsendCmd = &cobra.Command{
 					continue
 				}
 
-				if txn.ConfirmedRound > 0 {
-					reportInfof(infoTxCommitted, txidStr, txn.ConfirmedRound)
+				if txn.ConfirmedRound != nil && *txn.ConfirmedRound > 0 {
+					reportInfof(infoTxCommitted, txidStr, *txn.ConfirmedRound)
 					break
 				}
 
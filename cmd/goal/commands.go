@@ -30,9 +30,6 @@ import (
 	"github.com/spf13/cobra/doc"
 	"golang.org/x/crypto/ssh/terminal"
 
-	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
-	kmdclient "github.com/algorand/go-algorand/daemon/kmd/client"
-
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -378,10 +375,6 @@ func getGoalClient(dataDir string, clientType libgoal.ClientType) (client libgoa
 		CacheDir:     ensureCacheDir(dataDir),
 	}
 	client, err = libgoal.MakeClientFromConfig(clientConfig, clientType)
-	if err != nil {
-		return
-	}
-	client.SetAPIVersionAffinity(algodclient.APIVersionV2, kmdclient.APIVersionV1)
 	return
 }
 
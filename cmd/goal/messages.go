@@ -43,6 +43,7 @@ const (
 	errorBroadcastingTX            = "Couldn't broadcast tx with algod: %s"
 	warnMultisigDuplicatesDetected = "Warning: one or more duplicate addresses detected in multisig account creation. This will effectively give the duplicated address(es) extra signature weight. Continuing multisig account creation."
 	errLastRoundInvalid            = "roundLastValid needs to be well after the current round (%d)"
+	errFirstRoundInvalid           = "firstvalid cannot be before the current round (%d)"
 	errExistingPartKey             = "Account already has a participation key valid at least until roundLastValid (%d) - current is %d"
 	errorSeedConversion            = "Got private key for account %s, but was unable to convert to seed: %s"
 	errorMnemonicConversion        = "Got seed for account %s, but was unable to convert to mnemonic: %s"
@@ -68,6 +69,9 @@ const (
 	infoNodeCatchpointCatchupAccounts = "Catchpoint total accounts: %d\nCatchpoint accounts processed: %d\nCatchpoint accounts verified: %d"
 	infoNodeCatchpointCatchupBlocks   = "Catchpoint total blocks: %d\nCatchpoint downloaded blocks: %d"
 	nodeLastCatchpoint                = "Last Catchpoint: %s"
+	nodePartKeyExpiring               = "Warning: participation key of %s expires at round %d (in %d rounds)"
+	nodePartKeyRenewalScheduled       = "  Renewal key registration scheduled for round %d"
+	nodePartKeyRenewalInstalled       = "  Renewed participation key installed but not registered"
 	errorNodeCreationIPFailure        = "Parsing passed IP %v failed: need a valid IPv4 or IPv6 address with a specified port number"
 	errorNodeNotDetected              = "Algorand node does not appear to be running: %s"
 	errorNodeStatus                   = "Cannot contact Algorand node: %s"
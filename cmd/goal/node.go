@@ -18,7 +18,6 @@ package main
 
 import (
 	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -36,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/libgoal"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/nodecontrol"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/tokens"
 )
@@ -415,6 +415,17 @@ func makeStatusString(stat generatedV2.NodeStatusResponse) string {
 		if stat.StoppedAtUnsupportedRound {
 			statusString = statusString + "\n" + fmt.Sprintf(catchupStoppedOnUnsupported, stat.LastRound)
 		}
+
+		if stat.ExpiringParticipationKeys != nil {
+			for _, exp := range *stat.ExpiringParticipationKeys {
+				statusString = statusString + "\n" + fmt.Sprintf(nodePartKeyExpiring, exp.Address, exp.VoteLastValid, exp.RoundsRemaining)
+				if exp.RenewalScheduledRound != nil {
+					statusString = statusString + "\n" + fmt.Sprintf(nodePartKeyRenewalScheduled, *exp.RenewalScheduledRound)
+				} else if exp.RenewalInstalled {
+					statusString = statusString + "\n" + nodePartKeyRenewalInstalled
+				}
+			}
+		}
 	} else {
 		statusString = fmt.Sprintf(
 			infoNodeCatchpointCatchupStatus,
@@ -486,20 +497,15 @@ var pendingTxnsCmd = &cobra.Command{
 				reportErrorf(errorNodeStatus, err)
 			}
 
-			pendingTxns := statusTxnPool.TruncatedTxns
+			pendingTxns := statusTxnPool.TopTransactions
 
 			// do this inline for now, break it out when we need to reuse a Txn->String function
-			reportInfof(infoNodePendingTxnsDescription, maxPendingTransactions, statusTxnPool.TotalTxns)
-			if pendingTxns.Transactions == nil || len(pendingTxns.Transactions) == 0 {
+			reportInfof(infoNodePendingTxnsDescription, maxPendingTransactions, statusTxnPool.TotalTransactions)
+			if len(pendingTxns) == 0 {
 				reportInfof(infoNodeNoPendingTxnsDescription)
 			} else {
-				for _, pendingTxn := range pendingTxns.Transactions {
-					pendingTxnStr, err := json.MarshalIndent(pendingTxn, "", "    ")
-					if err != nil {
-						// json parsing of the txn failed, so let's just skip printing it
-						fmt.Printf("Unparseable Transaction %s\n", pendingTxn.TxID)
-						continue
-					}
+				for _, pendingTxn := range pendingTxns {
+					pendingTxnStr := protocol.EncodeJSON(&pendingTxn)
 					fmt.Printf("%s\n", string(pendingTxnStr))
 				}
 			}
@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// lightclient verifies the block headers and the transactions served by an
+// algod with compact certs, starting from trusted genesis voters
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/lightclient"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var algodURL = flag.String("algod", "http://127.0.0.1:8080", "URL of the algod REST API")
+var apiToken = flag.String("token", "", "API token of algod")
+var genesisFile = flag.String("genesis", "", "The genesis.json file of the network")
+var trustRound = flag.Uint64("trust-round", 0, "The round of the trusted block header which commits to the first voters (defaults to the genesis voters round)")
+var trustHash = flag.String("trust-hash", "", "The hash of the trusted block header, which is then trusted as is; without it, the header served by algod is verified through the hash chain from the genesis block")
+var stateFile = flag.String("state", "", "File keeping the latest certified block header across runs, which is trusted instead of the first voters when it exists")
+var headerRound = flag.Uint64("header", 0, "Verify and print the block header of this round")
+var txnRound = flag.Uint64("round", 0, "The round of the transaction to verify")
+var txid = flag.String("txid", "", "Verify that the transaction with this ID is in the block of -round")
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+// genesisBlockHeader rebuilds the header of the genesis block from the
+// genesis, as the ledger of algod does.
+func genesisBlockHeader(genesis bookkeeping.Genesis) (bookkeeping.BlockHeader, error) {
+	balances := make(map[basics.Address]basics.AccountData)
+	for _, entry := range genesis.Allocation {
+		addr, err := basics.UnmarshalChecksumAddress(entry.Address)
+		if err != nil {
+			return bookkeeping.BlockHeader{}, fmt.Errorf("cannot parse genesis addr %s: %v", entry.Address, err)
+		}
+		if _, present := balances[addr]; present {
+			return bookkeeping.BlockHeader{}, fmt.Errorf("repeated allocation to %s", entry.Address)
+		}
+		balances[addr] = entry.State
+	}
+	feeSink, err := basics.UnmarshalChecksumAddress(genesis.FeeSink)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("cannot parse fee sink addr %s: %v", genesis.FeeSink, err)
+	}
+	rewardsPool, err := basics.UnmarshalChecksumAddress(genesis.RewardsPool)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("cannot parse rewards pool addr %s: %v", genesis.RewardsPool, err)
+	}
+
+	genesisBal := bookkeeping.MakeTimestampedGenesisBalances(balances, feeSink, rewardsPool, genesis.Timestamp)
+	blk, err := bookkeeping.MakeGenesisBlock(genesis.Proto, genesisBal, genesis.ID(), crypto.HashObj(genesis))
+	if err != nil {
+		return bookkeeping.BlockHeader{}, err
+	}
+	return blk.BlockHeader, nil
+}
+
+// verifiedHeader returns the block header of a round served by the source,
+// after checking that the hash chain from the genesis block leads to it.
+func verifiedHeader(source lightclient.Source, genesis bookkeeping.Genesis, rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	hdr, err := genesisBlockHeader(genesis)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("could not rebuild the genesis block: %v", err)
+	}
+	for hdr.Round < rnd {
+		next, err := source.BlockHeader(hdr.Round + 1)
+		if err != nil {
+			return bookkeeping.BlockHeader{}, fmt.Errorf("could not fetch the block header of round %d: %v", hdr.Round+1, err)
+		}
+		if next.Round != hdr.Round+1 || next.Branch != hdr.Hash() {
+			return bookkeeping.BlockHeader{}, fmt.Errorf("the block header of round %d served by algod does not follow the block header of round %d", hdr.Round+1, hdr.Round)
+		}
+		hdr = next
+	}
+	return hdr, nil
+}
+
+// trustedHeader returns the block header the light client starts from: the
+// one kept in the state file, or the one of the first voters.  The latter is
+// verified through the hash chain from the genesis block, unless its hash is
+// given by -trust-hash.
+func trustedHeader(source lightclient.Source, genesis bookkeeping.Genesis) bookkeeping.BlockHeader {
+	var hdr bookkeeping.BlockHeader
+	if *stateFile != "" {
+		data, err := ioutil.ReadFile(*stateFile)
+		if err == nil {
+			err = protocol.Decode(data, &hdr)
+			if err != nil {
+				fail("%s: could not decode the certified block header: %v", *stateFile, err)
+			}
+			return hdr
+		}
+		if !os.IsNotExist(err) {
+			fail("%s: %v", *stateFile, err)
+		}
+	}
+
+	rnd := basics.Round(*trustRound)
+	if rnd == 0 {
+		var err error
+		rnd, err = lightclient.GenesisVotersRound(genesis.Proto)
+		if err != nil {
+			fail("could not find the genesis voters round, use -trust-round: %v", err)
+		}
+	}
+
+	if *trustHash == "" {
+		hdr, err := verifiedHeader(source, genesis, rnd)
+		if err != nil {
+			fail("could not verify the trusted block header of round %d: %v", rnd, err)
+		}
+		return hdr
+	}
+
+	hdr, err := source.BlockHeader(rnd)
+	if err != nil {
+		fail("could not fetch the trusted block header of round %d: %v", rnd, err)
+	}
+	hash := crypto.Digest(hdr.Hash())
+	if hash.String() != *trustHash {
+		fail("the block header of round %d served by algod has hash %v, not %s", rnd, hash, *trustHash)
+	}
+	return hdr
+}
+
+func main() {
+	flag.Parse()
+
+	if *genesisFile == "" {
+		fail("Must specify -genesis")
+	}
+	genesis, err := bookkeeping.LoadGenesisFromFile(*genesisFile)
+	if err != nil {
+		fail("%s: could not load the genesis: %v", *genesisFile, err)
+	}
+	genesisHash := crypto.HashObj(genesis)
+
+	u, err := url.Parse(*algodURL)
+	if err != nil {
+		fail("%s: %v", *algodURL, err)
+	}
+	source := lightclient.MakeAlgodSource(client.MakeRestClient(*u, *apiToken))
+
+	trusted := trustedHeader(source, genesis)
+	if trusted.GenesisHash != genesisHash {
+		fail("the trusted block header of round %d is for genesis %v, not %v", trusted.Round, trusted.GenesisHash, genesisHash)
+	}
+	lc, err := lightclient.MakeClient(source, trusted)
+	if err != nil {
+		fail("%v", err)
+	}
+
+	latest, syncErr := lc.Sync()
+	if *stateFile != "" {
+		err = ioutil.WriteFile(*stateFile, protocol.Encode(&latest), 0600)
+		if err != nil {
+			fail("%s: could not save the certified block header: %v", *stateFile, err)
+		}
+	}
+	if syncErr != nil {
+		fail("could not certify the block headers past round %d: %v", latest.Round, syncErr)
+	}
+	fmt.Printf("Certified up to round %d, block header %v\n", latest.Round, crypto.Digest(latest.Hash()))
+
+	if *headerRound != 0 {
+		hdr, err := lc.BlockHeader(basics.Round(*headerRound))
+		if err != nil {
+			fail("%v", err)
+		}
+		os.Stdout.Write(protocol.EncodeJSON(&hdr))
+		fmt.Println()
+	}
+
+	if *txid != "" {
+		var id transactions.Txid
+		err = id.UnmarshalText([]byte(*txid))
+		if err != nil {
+			fail("%s: %v", *txid, err)
+		}
+		hdr, err := lc.VerifyTransaction(basics.Round(*txnRound), id)
+		if err != nil {
+			fail("%v", err)
+		}
+		fmt.Printf("Transaction %v is in the block of round %d, block header %v\n", id, hdr.Round, crypto.Digest(hdr.Hash()))
+	}
+}
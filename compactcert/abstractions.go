@@ -55,4 +55,5 @@ type Network interface {
 // this package.
 type Accounts interface {
 	Keys(basics.Round) []account.Participation
+	Record(basics.Address, basics.Round, account.ParticipationAction)
 }
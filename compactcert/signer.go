@@ -23,6 +23,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/protocol"
@@ -146,7 +147,9 @@ func (ccw *Worker) signBlock(hdr bookkeeping.BlockHeader) {
 		_, err = ccw.handleSig(sfa, nil)
 		if err != nil {
 			ccw.log.Warnf("ccw.signBlock(%d): handleSig: %v", hdr.Round, err)
+			continue
 		}
+		ccw.accts.Record(sfa.Signer, sfa.Round, account.CompactCertificate)
 	}
 }
 
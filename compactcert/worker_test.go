@@ -106,6 +106,9 @@ func (s *testWorkerStubs) Keys(rnd basics.Round) (out []account.Participation) {
 	return
 }
 
+func (s *testWorkerStubs) Record(basics.Address, basics.Round, account.ParticipationAction) {
+}
+
 func (s *testWorkerStubs) BlockHdr(r basics.Round) (bookkeeping.BlockHeader, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
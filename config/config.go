@@ -63,7 +63,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17"`
 
 	// environmental (may be overridden)
 	// When enabled, stores blocks indefinitally, otherwise, only the most recents blocks
@@ -180,6 +180,7 @@ type Local struct {
 	// exponential increase factor of transaction pool's fee threshold, should always be 2 in production
 	TxPoolExponentialIncreaseFactor uint64 `version[0]:"2"`
 
+	// SuggestedFeeBlockHistory is the number of recent blocks whose fullness and fees are considered by the fee estimation
 	SuggestedFeeBlockHistory int `version[0]:"3"`
 
 	// TxPoolSize is the number of transactions that fit in the transaction pool
@@ -418,6 +419,55 @@ type Local struct {
 	// features like catchpoint catchup would be rendered completly non-operational, and many of the node inner
 	// working would be completly dis-functional.
 	DisableNetworking bool `version[16]:"false"`
+
+	// ParticipationKeysExpiryWarningRounds is the number of rounds ahead of the expiration of an online account's
+	// registered participation key at which the node starts warning about it, through telemetry and the status API.
+	// Setting it to zero disables the warning.
+	ParticipationKeysExpiryWarningRounds uint64 `version[17]:"100000"`
+
+	// EnableHoldingsIndex enables an in-memory index of the accounts holding each asset and opted into each
+	// application, which allows listing them through the REST API. Building the index requires scanning all
+	// the accounts on startup, and its memory use grows with the number of asset holdings and application
+	// opt-ins of the network.
+	EnableHoldingsIndex bool `version[17]:"false"`
+
+	// ReadinessMaxRoundAgeSeconds is the longest time since the last round was added to the ledger for which
+	// the node still reports being ready through the /ready endpoint. Setting it to zero disables the check.
+	ReadinessMaxRoundAgeSeconds int `version[17]:"30"`
+
+	// GossipCompressionTags is a comma separated list of the message tags which are compressed before being sent
+	// to the peers that negotiated a protocol version supporting compressed messages. Setting it to an empty
+	// string disables the compression of the outgoing messages; incoming compressed messages are always accepted.
+	GossipCompressionTags string `version[17]:"PP,TX"`
+
+	// EnableTxnAnnouncements asks the peers to announce the transaction groups by their digest rather than send them
+	// in full; the node then requests only the groups it hasn't seen yet. Peers which don't support announcements
+	// keep sending the transaction groups in full.
+	EnableTxnAnnouncements bool `version[17]:"false"`
+
+	// EnablePeerExchange enables the exchange of relay addresses between the nodes: relays share the addresses of
+	// the relays they are connected to with the peers asking for these, which add the addresses shared by the relays
+	// they connected to to their phonebook.
+	EnablePeerExchange bool `version[17]:"false"`
+
+	// EnableGossipIdentity makes the node authenticate its gossip connections with its node identity, which is kept in
+	// the NodeIdentityFilename file, and encrypt the traffic of the connections to the peers having an identity too.
+	EnableGossipIdentity bool `version[17]:"false"`
+
+	// GossipIdentityAllowlist is a comma separated list of the base64 encoded node identities allowed to connect to
+	// this node, and which this node connects to. When it is set, the peers which don't authenticate with one of these
+	// identities are refused, which requires EnableGossipIdentity. An empty list accepts any peer.
+	GossipIdentityAllowlist string `version[17]:""`
+
+	// IncomingMessageRateLimits is a comma separated list of the rate limits of the messages received from each of
+	// the incoming connections, made of a message tag, the number of messages per second and an optional burst,
+	// e.g. "TX:500:1000,AV:2000". The messages exceeding the limit are held back, or dropped if these would have to
+	// be held for too long. An empty list disables the rate limits.
+	IncomingMessageRateLimits string `version[17]:""`
+
+	// IncomingRateLimitDisconnectSeconds is the time after which the peers persistently exceeding the
+	// IncomingMessageRateLimits are disconnected. Setting it to zero keeps such peers connected.
+	IncomingRateLimitDisconnectSeconds int `version[17]:"30"`
 }
 
 // Filenames of config files within the configdir (e.g. ~/.algorand)
@@ -439,6 +489,18 @@ const CrashFilename = "crash.sqlite"
 // It is used to track in-progress compact certificates.
 const CompactCertFilename = "compactcert.sqlite"
 
+// ParticipationRegistryFilename is the name of the participation registry database file.
+// It is used for tracking participation key metadata.
+const ParticipationRegistryFilename = "partregistry.sqlite"
+
+// PeersFilename is the name of the file in which the network keeps the phonebook addresses.
+// It is used for remembering the relays and their reliability across restarts.
+const PeersFilename = "peers.json"
+
+// NodeIdentityFilename is the name of the file in which the network keeps the seed of the node identity.
+// It is used for authenticating the gossip connections when EnableGossipIdentity is set.
+const NodeIdentityFilename = "node.identity"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus prototocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -28,6 +28,10 @@ const rootKeyFilenameFormat = "%s.rootkey"
 // partKeyFilenameFormat is a format string for the files that hold participation keys.
 const partKeyFilenameFormat = "%s.%d.%d.partkey"
 
+// keyregFilenameFormat is a format string for the files that hold signed key registration
+// transactions which the node submits once their first valid round is reached.
+const keyregFilenameFormat = "%s.%d.keyreg"
+
 func extractPartValidInterval(filename string) (fValid, lValid uint64, ok bool) {
 	parts := strings.Split(filename, ".")
 	np := len(parts)
@@ -64,6 +68,12 @@ func PartKeyFilename(s string, firstValid, lastValid uint64) string {
 	return fmt.Sprintf(partKeyFilenameFormat, s, firstValid, lastValid)
 }
 
+// KeyregFilename gives the scheduled key registration filename that corresponds to the
+// given account name and first valid round of the transaction.
+func KeyregFilename(s string, firstValid uint64) string {
+	return fmt.Sprintf(keyregFilenameFormat, s, firstValid)
+}
+
 // MatchesRootKeyFilename returns true if the given filename is the root key file of
 // the given account name.
 func MatchesRootKeyFilename(s, filename string) bool {
@@ -90,6 +100,19 @@ func IsPartKeyFilename(filename string) bool {
 	return MatchesPartKeyFilename(n, filename)
 }
 
+// IsKeyregFilename returns true if the given filename is a valid scheduled key
+// registration filename.
+func IsKeyregFilename(filename string) bool {
+	parts := strings.Split(filename, ".")
+	np := len(parts)
+	if np < 3 || parts[np-1] != "keyreg" {
+		return false
+	}
+
+	_, err := strconv.ParseUint(parts[np-2], 10, 0)
+	return err == nil
+}
+
 // AccountNameFromRootKeyFilename returns the account name given a root key filename.
 //
 // If filename is not a valid root key filename, this returns the filename unchanged.
@@ -20,7 +20,7 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                 16,
+	Version:                                 17,
 	AccountUpdatesStatsInterval:             5000000000,
 	AccountsRebuildSynchronousMode:          1,
 	AnnounceParticipationKey:                true,
@@ -56,22 +56,30 @@ var defaultLocal = Local{
 	EnableCatchupFromArchiveServers:         false,
 	EnableDeveloperAPI:                      false,
 	EnableGossipBlockService:                true,
+	EnableGossipIdentity:                    false,
+	EnableHoldingsIndex:                     false,
 	EnableIncomingMessageFilter:             false,
 	EnableLedgerService:                     false,
 	EnableMetricReporting:                   false,
 	EnableOutgoingNetworkMessageFiltering:   true,
+	EnablePeerExchange:                      false,
 	EnablePingHandler:                       true,
 	EnableProcessBlockStats:                 false,
 	EnableProfiler:                          false,
 	EnableRequestLogger:                     false,
 	EnableTopAccountsReporting:              false,
+	EnableTxnAnnouncements:                  false,
 	EndpointAddress:                         "127.0.0.1:0",
 	FallbackDNSResolverAddress:              "",
 	ForceRelayMessages:                      false,
+	GossipCompressionTags:                   "PP,TX",
 	GossipFanout:                            4,
+	GossipIdentityAllowlist:                 "",
 	IncomingConnectionsLimit:                10000,
 	IncomingMessageFilterBucketCount:        5,
 	IncomingMessageFilterBucketSize:         512,
+	IncomingMessageRateLimits:               "",
+	IncomingRateLimitDisconnectSeconds:      30,
 	IsIndexerActive:                         false,
 	LedgerSynchronousMode:                   2,
 	LogArchiveMaxAge:                        "",
@@ -88,11 +96,13 @@ var defaultLocal = Local{
 	OptimizeAccountsDatabaseOnStartup:       false,
 	OutgoingMessageFilterBucketCount:        3,
 	OutgoingMessageFilterBucketSize:         128,
+	ParticipationKeysExpiryWarningRounds:    100000,
 	ParticipationKeysRefreshInterval:        60000000000,
 	PeerConnectionsUpdateInterval:           3600,
 	PeerPingPeriodSeconds:                   0,
 	PriorityPeers:                           map[string]bool{},
 	PublicAddress:                           "",
+	ReadinessMaxRoundAgeSeconds:             30,
 	ReconnectTime:                           60000000000,
 	ReservedFDs:                             256,
 	RestReadTimeoutSeconds:                  15,
@@ -22,47 +22,32 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/google/go-querystring/query"
-
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
-	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
 )
 
 const (
 	authHeader          = "X-Algo-API-Token"
 	healthCheckEndpoint = "/health"
+	readyCheckEndpoint  = "/ready"
 	maxRawResponseBytes = 50e6
 )
 
-// APIVersion is used to define which server side API version would be used when making http requests to the server
-type APIVersion string
-
-const (
-	// APIVersionV1 suggests that the RestClient would use v1 calls whenever it's available for the given request.
-	APIVersionV1 APIVersion = "v1"
-	// APIVersionV2 suggests that the RestClient would use v2 calls whenever it's available for the given request.
-	APIVersionV2 APIVersion = "v2"
-)
-
-// rawRequestPaths is a set of paths where the body should not be urlencoded
-var rawRequestPaths = map[string]bool{
-	"/v1/transactions": true,
-	"/v2/teal/dryrun":  true,
-	"/v2/teal/compile": true,
-}
+// msgpackFormat is the format parameter requesting msgpack encoded responses.
+var msgpackFormat = "msgpack"
 
 // unauthorizedRequestError is generated when we receive 401 error from the server. This error includes the inner error
 // as well as the likely parameters that caused the issue.
@@ -78,26 +63,54 @@ func (e unauthorizedRequestError) Error() string {
 }
 
 // RestClient manages the REST interface for a calling user.
+// It makes its requests through the clients generated from the specification
+// of the v2 API, see GeneratedClient and PrivateClient.
 type RestClient struct {
-	serverURL       url.URL
-	apiToken        string
-	versionAffinity APIVersion
+	serverURL url.URL
+	apiToken  string
+	ctx       context.Context
 }
 
 // MakeRestClient is the factory for constructing a RestClient for a given endpoint
 func MakeRestClient(url url.URL, apiToken string) RestClient {
 	return RestClient{
-		serverURL:       url,
-		apiToken:        apiToken,
-		versionAffinity: APIVersionV1,
+		serverURL: url,
+		apiToken:  apiToken,
+		ctx:       context.Background(),
 	}
 }
 
-// SetAPIVersionAffinity sets the client affinity to use a specific version of the API
-func (client *RestClient) SetAPIVersionAffinity(affinity APIVersion) (previousAffinity APIVersion) {
-	previousAffinity = client.versionAffinity
-	client.versionAffinity = affinity
-	return
+// WithContext returns a copy of the client making its requests with the given
+// context, so that they are canceled along with it.
+func (client RestClient) WithContext(ctx context.Context) RestClient {
+	client.ctx = ctx
+	return client
+}
+
+// authenticate is the request editor adding the API token to the requests of the generated clients.
+func (client RestClient) authenticate(req *http.Request, ctx context.Context) error {
+	req.Header.Set(authHeader, client.apiToken)
+	return nil
+}
+
+// GeneratedClient returns the client generated from the specification of the
+// public v2 API, authenticating with the API token of this client.
+func (client RestClient) GeneratedClient() *generatedV2.Client {
+	return &generatedV2.Client{
+		Server:        client.serverURL.String(),
+		Client:        http.DefaultClient,
+		RequestEditor: client.authenticate,
+	}
+}
+
+// PrivateClient returns the client generated from the specification of the
+// private v2 API, authenticating with the API token of this client.
+func (client RestClient) PrivateClient() *privateV2.Client {
+	return &privateV2.Client{
+		Server:        client.serverURL.String(),
+		Client:        http.DefaultClient,
+		RequestEditor: client.authenticate,
+	}
 }
 
 // filterASCII filter out the non-ascii printable characters out of the given input string.
@@ -141,49 +154,11 @@ func stripTransaction(tx string) string {
 	return tx
 }
 
-// submitForm is a helper used for submitting (ex.) GETs and POSTs to the server
-func (client RestClient) submitForm(response interface{}, path string, request interface{}, requestMethod string, encodeJSON bool, decodeJSON bool) error {
-	var err error
-	queryURL := client.serverURL
-	queryURL.Path = path
-
-	var req *http.Request
-	var body io.Reader
-
-	if request != nil {
-		if rawRequestPaths[path] {
-			reqBytes, ok := request.([]byte)
-			if !ok {
-				return fmt.Errorf("couldn't decode raw request as bytes")
-			}
-			body = bytes.NewBuffer(reqBytes)
-		} else {
-			v, err := query.Values(request)
-			if err != nil {
-				return err
-			}
-
-			queryURL.RawQuery = v.Encode()
-			if encodeJSON {
-				jsonValue, _ := json.Marshal(request)
-				body = bytes.NewBuffer(jsonValue)
-			}
-		}
-	}
-
-	req, err = http.NewRequest(requestMethod, queryURL.String(), body)
-	if err != nil {
-		return err
-	}
-
-	// If we add another endpoint that does not require auth, we should add a
-	// requiresAuth argument to submitForm rather than checking here
-	if path != healthCheckEndpoint {
-		req.Header.Set(authHeader, client.apiToken)
-	}
-
-	httpClient := &http.Client{}
-	resp, err := httpClient.Do(req)
+// decodeResponse checks the response to a request, and decodes its body into
+// the response object: as is if it is a *[]byte, with our msgpack codec if the
+// body is msgpack encoded, and as JSON otherwise. The response object may be nil
+// if the body doesn't matter.
+func decodeResponse(resp *http.Response, err error, response interface{}) error {
 	if err != nil {
 		return err
 	}
@@ -196,16 +171,8 @@ func (client RestClient) submitForm(response interface{}, path string, request i
 	if err != nil {
 		return err
 	}
-
-	if decodeJSON {
-		dec := json.NewDecoder(resp.Body)
-		return dec.Decode(&response)
-	}
-
-	// Response must implement RawResponse
-	raw, ok := response.(v1.RawResponse)
-	if !ok {
-		return fmt.Errorf("can only decode raw response into type implementing v1.RawResponse")
+	if response == nil {
+		return nil
 	}
 
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
@@ -213,260 +180,208 @@ func (client RestClient) submitForm(response interface{}, path string, request i
 		return err
 	}
 
-	raw.SetBytes(bodyBytes)
-	return nil
+	if raw, ok := response.(*[]byte); ok {
+		*raw = bodyBytes
+		return nil
+	}
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/msgpack") {
+		return protocol.DecodeReflect(bodyBytes, response)
+	}
+	return json.Unmarshal(bodyBytes, response)
 }
 
-// get performs a GET request to the specific path against the server
-func (client RestClient) get(response interface{}, path string, request interface{}) error {
-	return client.submitForm(response, path, request, "GET", false /* encodeJSON */, true /* decodeJSON */)
-}
+// getCommon performs a GET request to one of the routes common to all the API
+// versions, which are not part of the v2 API specification.
+func (client RestClient) getCommon(response interface{}, path string, queryArgs map[string]string, requiresAuth bool) error {
+	queryURL := client.serverURL
+	queryURL.Path = path
 
-// getRaw behaves identically to get but doesn't json decode the response, and
-// the response must implement the v1.RawResponse interface
-func (client RestClient) getRaw(response v1.RawResponse, path string, request interface{}) error {
-	return client.submitForm(response, path, request, "GET", false /* encodeJSON */, false /* decodeJSON */)
-}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	q := req.URL.Query()
+	for k, v := range queryArgs {
+		q.Add(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
 
-// post sends a POST request to the given path with the given request object.
-// No query parameters will be sent if request is nil.
-// response must be a pointer to an object as post writes the response there.
-func (client RestClient) post(response interface{}, path string, request interface{}) error {
-	return client.submitForm(response, path, request, "POST", true /* encodeJSON */, true /* decodeJSON */)
+	if requiresAuth {
+		req.Header.Set(authHeader, client.apiToken)
+	}
+
+	resp, err := http.DefaultClient.Do(req.WithContext(client.ctx))
+	return decodeResponse(resp, err, response)
 }
 
 // Status retrieves the StatusResponse from the running node
 // the StatusResponse includes data like the consensus version and current round
-// Not supported
 func (client RestClient) Status() (response generatedV2.NodeStatusResponse, err error) {
-	switch client.versionAffinity {
-	case APIVersionV2:
-		err = client.get(&response, "/v2/status", nil)
-	default:
-		var nodeStatus v1.NodeStatus
-		err = client.get(&nodeStatus, "/v1/status", nil)
-		if err == nil {
-			response = fillNodeStatusResponse(nodeStatus)
-		}
-	}
+	resp, err := client.GeneratedClient().GetStatus(client.ctx)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // WaitForBlock returns the node status after waiting for the given round.
 func (client RestClient) WaitForBlock(round basics.Round) (response generatedV2.NodeStatusResponse, err error) {
-	switch client.versionAffinity {
-	case APIVersionV2:
-		err = client.get(&response, fmt.Sprintf("/v2/status/wait-for-block-after/%d/", round), nil)
-	default:
-		var nodeStatus v1.NodeStatus
-		err = client.get(&nodeStatus, fmt.Sprintf("/v1/status/wait-for-block-after/%d/", round), nil)
-		if err == nil {
-			response = fillNodeStatusResponse(nodeStatus)
-		}
-	}
-	return
+	return client.StatusAfterBlock(uint64(round))
 }
 
 // HealthCheck does a health check on the the potentially running node,
 // returning an error if the API is down
 func (client RestClient) HealthCheck() error {
-	return client.get(nil, "/health", nil)
-}
-
-func fillNodeStatusResponse(nodeStatus v1.NodeStatus) generatedV2.NodeStatusResponse {
-	return generatedV2.NodeStatusResponse{
-		LastRound:                 nodeStatus.LastRound,
-		LastVersion:               nodeStatus.LastVersion,
-		NextVersion:               nodeStatus.NextVersion,
-		NextVersionRound:          nodeStatus.NextVersionRound,
-		NextVersionSupported:      nodeStatus.NextVersionSupported,
-		TimeSinceLastRound:        uint64(nodeStatus.TimeSinceLastRound),
-		CatchupTime:               uint64(nodeStatus.CatchupTime),
-		StoppedAtUnsupportedRound: nodeStatus.StoppedAtUnsupportedRound,
-	}
+	return client.getCommon(nil, healthCheckEndpoint, nil, false)
+}
+
+// ReadyCheck does a readiness check on the potentially running node,
+// returning an error explaining why the node is not ready to serve requests
+func (client RestClient) ReadyCheck() error {
+	return client.getCommon(nil, readyCheckEndpoint, nil, false)
+}
+
+// DetailedStatus retrieves the node status along with the state of its peers, transaction pool and ledger
+func (client RestClient) DetailedStatus() (response generatedV2.DetailedNodeStatusResponse, err error) {
+	resp, err := client.GeneratedClient().GetDetailedStatus(client.ctx)
+	err = decodeResponse(resp, err, &response)
+	return
 }
 
 // StatusAfterBlock waits for a block to occur then returns the StatusResponse after that block
 // blocks on the node end
-// Not supported
 func (client RestClient) StatusAfterBlock(blockNum uint64) (response generatedV2.NodeStatusResponse, err error) {
-	switch client.versionAffinity {
-	case APIVersionV2:
-		err = client.get(&response, fmt.Sprintf("/v2/status/wait-for-block-after/%d", blockNum), nil)
-	default:
-		var nodeStatus v1.NodeStatus
-		err = client.get(&nodeStatus, fmt.Sprintf("/v1/status/wait-for-block-after/%d", blockNum), nil)
-		if err == nil {
-			response = fillNodeStatusResponse(nodeStatus)
-		}
-	}
-
+	resp, err := client.GeneratedClient().WaitForBlock(client.ctx, blockNum)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
-type pendingTransactionsParams struct {
-	Max uint64 `url:"max"`
+// PendingTransactions is a snapshot of the transactions pending on the node,
+// as returned in msgpack by the pending transactions routes.
+type PendingTransactions struct {
+	TopTransactions   []transactions.SignedTxn `codec:"top-transactions"`
+	TotalTransactions uint64                   `codec:"total-transactions"`
 }
 
 // GetPendingTransactions asks algod for a snapshot of current pending txns on the node, bounded by maxTxns.
 // If maxTxns = 0, fetches as many transactions as possible.
-func (client RestClient) GetPendingTransactions(maxTxns uint64) (response v1.PendingTransactions, err error) {
-	err = client.get(&response, fmt.Sprintf("/v1/transactions/pending"), pendingTransactionsParams{maxTxns})
+func (client RestClient) GetPendingTransactions(maxTxns uint64) (response PendingTransactions, err error) {
+	resp, err := client.GeneratedClient().GetPendingTransactions(client.ctx, &generatedV2.GetPendingTransactionsParams{Max: &maxTxns, Format: &msgpackFormat})
+	err = decodeResponse(resp, err, &response)
+	return
+}
+
+// PendingTransactionsByAddr returns all the pending transactions for a PK [addr].
+func (client RestClient) PendingTransactionsByAddr(addr string, max uint64) (response PendingTransactions, err error) {
+	resp, err := client.GeneratedClient().GetPendingTransactionsByAddress(client.ctx, addr, &generatedV2.GetPendingTransactionsByAddressParams{Max: &max, Format: &msgpackFormat})
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // Versions retrieves the VersionResponse from the running node
 // the VersionResponse includes data like version number and genesis ID
 func (client RestClient) Versions() (response common.Version, err error) {
-	err = client.get(&response, "/versions", nil)
+	err = client.getCommon(&response, "/versions", nil, true)
 	return
 }
 
 // LedgerSupply gets the supply details for the specified node's Ledger
-func (client RestClient) LedgerSupply() (response v1.Supply, err error) {
-	err = client.get(&response, "/v1/ledger/supply", nil)
+func (client RestClient) LedgerSupply() (response generatedV2.SupplyResponse, err error) {
+	resp, err := client.GeneratedClient().GetSupply(client.ctx)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
-type pendingTransactionsByAddrParams struct {
-	Max uint64 `url:"max"`
-}
-
-type transactionsByAddrParams struct {
-	FirstRound uint64 `url:"firstRound"`
-	LastRound  uint64 `url:"lastRound"`
-	Max        uint64 `url:"max"`
-}
-
-type assetsParams struct {
-	AssetIdx uint64 `url:"assetIdx"`
-	Max      uint64 `url:"max"`
-}
-
-type appsParams struct {
-	AppIdx uint64 `url:"appIdx"`
-	Max    uint64 `url:"max"`
-}
-
-type rawblockParams struct {
-	Raw uint64 `url:"raw"`
-}
-
-type rawFormat struct {
-	Format string `url:"format"`
-}
-
-// TransactionsByAddr returns all transactions for a PK [addr] in the [first,
-// last] rounds range.
-func (client RestClient) TransactionsByAddr(addr string, first, last, max uint64) (response v1.TransactionList, err error) {
-	err = client.get(&response, fmt.Sprintf("/v1/account/%s/transactions", addr), transactionsByAddrParams{first, last, max})
+// Equivocations gets the evidence of the vote equivocations detected by the node
+func (client RestClient) Equivocations() (response generatedV2.EquivocationsResponse, err error) {
+	resp, err := client.GeneratedClient().GetEquivocations(client.ctx)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
-// PendingTransactionsByAddr returns all the pending transactions for a PK [addr].
-func (client RestClient) PendingTransactionsByAddr(addr string, max uint64) (response v1.PendingTransactions, err error) {
-	err = client.get(&response, fmt.Sprintf("/v1/account/%s/transactions/pending", addr), pendingTransactionsByAddrParams{max})
+// AgreementTimeline gets the agreement timelines of at most max of the latest rounds, or of all the kept rounds when max is zero
+func (client RestClient) AgreementTimeline(max uint64) (response privateV2.RoundTimelinesResponse, err error) {
+	params := privateV2.GetAgreementTimelineParams{}
+	if max != 0 {
+		params.Max = &max
+	}
+	resp, err := client.PrivateClient().GetAgreementTimeline(client.ctx, &params)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // AssetInformation gets the AssetInformationResponse associated with the passed asset index
-func (client RestClient) AssetInformation(index uint64) (response v1.AssetParams, err error) {
-	err = client.get(&response, fmt.Sprintf("/v1/asset/%d", index), nil)
-	return
-}
-
-// Assets gets up to max assets with maximum asset index assetIdx
-func (client RestClient) Assets(assetIdx, max uint64) (response v1.AssetList, err error) {
-	err = client.get(&response, "/v1/assets", assetsParams{assetIdx, max})
-	return
-}
-
-// AssetInformationV2 gets the AssetInformationResponse associated with the passed asset index
-func (client RestClient) AssetInformationV2(index uint64) (response generatedV2.Asset, err error) {
-	err = client.get(&response, fmt.Sprintf("/v2/assets/%d", index), nil)
+func (client RestClient) AssetInformation(index uint64) (response generatedV2.Asset, err error) {
+	resp, err := client.GeneratedClient().GetAssetByID(client.ctx, index)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // ApplicationInformation gets the ApplicationInformationResponse associated
 // with the passed application index
 func (client RestClient) ApplicationInformation(index uint64) (response generatedV2.Application, err error) {
-	err = client.get(&response, fmt.Sprintf("/v2/applications/%d", index), nil)
+	resp, err := client.GeneratedClient().GetApplicationByID(client.ctx, index)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
-// AccountInformation also gets the AccountInformationResponse associated with the passed address
-func (client RestClient) AccountInformation(address string) (response v1.Account, err error) {
-	err = client.get(&response, fmt.Sprintf("/v1/account/%s", address), nil)
+// AccountInformation gets the AccountInformationResponse associated with the passed address
+func (client RestClient) AccountInformation(address string) (response generatedV2.Account, err error) {
+	resp, err := client.GeneratedClient().AccountInformation(client.ctx, address, &generatedV2.AccountInformationParams{})
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
-// AccountInformationV2 gets the AccountData associated with the passed address
-func (client RestClient) AccountInformationV2(address string) (response generatedV2.Account, err error) {
-	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s", address), nil)
+// RawAccountInformation gets the raw AccountData associated with the passed address
+func (client RestClient) RawAccountInformation(address string) (response []byte, err error) {
+	resp, err := client.GeneratedClient().AccountInformation(client.ctx, address, &generatedV2.AccountInformationParams{Format: &msgpackFormat})
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
-// Blob represents arbitrary blob of data satisfying v1.RawResponse interface
-type Blob []byte
-
-// SetBytes fulfills the RawResponse interface on Blob
-func (blob *Blob) SetBytes(b []byte) {
-	*blob = b
-}
-
-// RawAccountInformationV2 gets the raw AccountData associated with the passed address
-func (client RestClient) RawAccountInformationV2(address string) (response []byte, err error) {
-	var blob Blob
-	err = client.getRaw(&blob, fmt.Sprintf("/v2/accounts/%s", address), rawFormat{Format: "msgpack"})
-	response = blob
-	return
-}
-
-// TransactionInformation gets information about a specific transaction involving a specific account
-func (client RestClient) TransactionInformation(accountAddress, transactionID string) (response v1.Transaction, err error) {
+// ConfirmedTransactionInformation gets information about a transaction confirmed
+// in one of the last rounds the node keeps track of.
+func (client RestClient) ConfirmedTransactionInformation(transactionID string) (response generatedV2.PendingTransactionResponse, err error) {
 	transactionID = stripTransaction(transactionID)
-	err = client.get(&response, fmt.Sprintf("/v1/account/%s/transaction/%s", accountAddress, transactionID), nil)
+	resp, err := client.GeneratedClient().GetConfirmedTransaction(client.ctx, transactionID, &generatedV2.GetConfirmedTransactionParams{})
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // PendingTransactionInformation gets information about a recently issued
 // transaction.  There are several cases when this might succeed:
 //
-// - transaction committed (CommittedRound > 0)
-// - transaction still in the pool (CommittedRound = 0, PoolError = "")
-// - transaction removed from pool due to error (CommittedRound = 0, PoolError != "")
+// - transaction committed (ConfirmedRound > 0)
+// - transaction still in the pool (ConfirmedRound = 0, PoolError = "")
+// - transaction removed from pool due to error (ConfirmedRound = 0, PoolError != "")
 //
 // Or the transaction may have happened sufficiently long ago that the
 // node no longer remembers it, and this will return an error.
-func (client RestClient) PendingTransactionInformation(transactionID string) (response v1.Transaction, err error) {
+func (client RestClient) PendingTransactionInformation(transactionID string) (response generatedV2.PendingTransactionResponse, err error) {
 	transactionID = stripTransaction(transactionID)
-	err = client.get(&response, fmt.Sprintf("/v1/transactions/pending/%s", transactionID), nil)
+	resp, err := client.GeneratedClient().PendingTransactionInformation(client.ctx, transactionID, &generatedV2.PendingTransactionInformationParams{})
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
-// PendingTransactionInformationV2 gets information about a recently issued transaction.
-// See PendingTransactionInformation for more details.
-func (client RestClient) PendingTransactionInformationV2(transactionID string) (response generatedV2.PendingTransactionResponse, err error) {
+// WaitForTransaction waits up to timeoutSeconds for a recently issued transaction
+// to be committed or rejected, and returns its information as PendingTransactionInformation.
+func (client RestClient) WaitForTransaction(transactionID string, timeoutSeconds uint64) (response generatedV2.PendingTransactionResponse, err error) {
 	transactionID = stripTransaction(transactionID)
-	err = client.get(&response, fmt.Sprintf("/v2/transactions/pending/%s", transactionID), nil)
-	return
-}
-
-// SuggestedFee gets the recommended transaction fee from the node
-func (client RestClient) SuggestedFee() (response v1.TransactionFee, err error) {
-	err = client.get(&response, "/v1/transactions/fee", nil)
+	resp, err := client.GeneratedClient().WaitForTransaction(client.ctx, transactionID, &generatedV2.WaitForTransactionParams{Timeout: &timeoutSeconds})
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // SuggestedParams gets the suggested transaction parameters
-func (client RestClient) SuggestedParams() (response v1.TransactionParams, err error) {
-	err = client.get(&response, "/v1/transactions/params", nil)
+func (client RestClient) SuggestedParams() (response generatedV2.TransactionParametersResponse, err error) {
+	resp, err := client.GeneratedClient().TransactionParams(client.ctx)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // SendRawTransaction gets a SignedTxn and broadcasts it to the network
-func (client RestClient) SendRawTransaction(txn transactions.SignedTxn) (response v1.TransactionID, err error) {
-	err = client.post(&response, "/v1/transactions", protocol.Encode(&txn))
+func (client RestClient) SendRawTransaction(txn transactions.SignedTxn) (response generatedV2.PostTransactionsResponse, err error) {
+	resp, err := client.GeneratedClient().RawTransactionWithBody(client.ctx, "application/x-binary", bytes.NewReader(protocol.Encode(&txn)))
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
@@ -479,50 +394,112 @@ func (client RestClient) SendRawTransactionGroup(txgroup []transactions.SignedTx
 		enc = append(enc, protocol.Encode(&tx)...)
 	}
 
-	var response v1.TransactionID
-	return client.post(&response, "/v1/transactions", enc)
+	resp, err := client.GeneratedClient().RawTransactionWithBody(client.ctx, "application/x-binary", bytes.NewReader(enc))
+	return decodeResponse(resp, err, nil)
 }
 
-// Block gets the block info for the given round
-func (client RestClient) Block(round uint64) (response v1.Block, err error) {
-	err = client.get(&response, fmt.Sprintf("/v1/block/%d", round), nil)
+// SendRawTransactionGroups broadcasts a batch of independent SignedTxn groups to the network,
+// and returns the outcome of each of them. The groups need to carry distinct group IDs, or a
+// single transaction each, for the node to tell them apart.
+func (client RestClient) SendRawTransactionGroups(txgroups [][]transactions.SignedTxn) (response generatedV2.PostTransactionGroupsResponse, err error) {
+	var enc []byte
+	for _, txgroup := range txgroups {
+		for _, tx := range txgroup {
+			enc = append(enc, protocol.Encode(&tx)...)
+		}
+	}
+
+	resp, err := client.GeneratedClient().RawTransactionBatchWithBody(client.ctx, "application/x-binary", bytes.NewReader(enc))
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // RawBlock gets the encoded, raw msgpack block for the given round
 func (client RestClient) RawBlock(round uint64) (response []byte, err error) {
-	switch client.versionAffinity {
-	case APIVersionV2:
-		var blob Blob
-		err = client.getRaw(&blob, fmt.Sprintf("/v2/blocks/%d", round), rawFormat{Format: "msgpack"})
-		response = blob
-	default:
-		var raw v1.RawBlock
-		err = client.getRaw(&raw, fmt.Sprintf("/v1/block/%d", round), rawblockParams{1})
-		response = raw
+	resp, err := client.GeneratedClient().GetBlock(client.ctx, round, &generatedV2.GetBlockParams{Format: &msgpackFormat})
+	err = decodeResponse(resp, err, &response)
+	return
+}
+
+// BlockCert gets the block for the given round, along with its certificate
+func (client RestClient) BlockCert(round uint64) (response rpcs.EncodedBlockCert, err error) {
+	raw, err := client.RawBlock(round)
+	if err != nil {
+		return
+	}
+	err = protocol.DecodeReflect(raw, &response)
+	return
+}
+
+// Block gets the block for the given round
+func (client RestClient) Block(round uint64) (response bookkeeping.Block, err error) {
+	blockCert, err := client.BlockCert(round)
+	if err != nil {
+		return
 	}
+	return blockCert.Block, nil
+}
+
+// CompactCert gets the compact cert for the given round, along with the round it was confirmed in
+func (client RestClient) CompactCert(round uint64) (response generatedV2.CompactCertResponse, err error) {
+	resp, err := client.GeneratedClient().GetCompactCert(client.ctx, round)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // Shutdown requests the node to shut itself down
 func (client RestClient) Shutdown() (err error) {
-	response := 1
-	err = client.post(&response, "/v2/shutdown", nil)
-	return
+	resp, err := client.PrivateClient().ShutdownNode(client.ctx, &privateV2.ShutdownNodeParams{})
+	return decodeResponse(resp, err, nil)
 }
 
 // AbortCatchup aborts the currently running catchup
 func (client RestClient) AbortCatchup(catchpointLabel string) (response privateV2.CatchpointAbortResponse, err error) {
-	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s", catchpointLabel), nil, "DELETE", false, true)
+	resp, err := client.PrivateClient().AbortCatchup(client.ctx, catchpointLabel)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // Catchup start catching up to the give catchpoint label
 func (client RestClient) Catchup(catchpointLabel string) (response privateV2.CatchpointStartResponse, err error) {
-	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s", catchpointLabel), nil, "POST", false, true)
+	resp, err := client.PrivateClient().StartCatchup(client.ctx, catchpointLabel)
+	err = decodeResponse(resp, err, &response)
+	return
+}
+
+// NetworkPeers lists the peers the node is connected to
+func (client RestClient) NetworkPeers() (response privateV2.NetworkPeersResponse, err error) {
+	resp, err := client.PrivateClient().GetNetworkPeers(client.ctx)
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
+// DisconnectPeer disconnects the node from the given peer
+func (client RestClient) DisconnectPeer(peerID uint64) error {
+	resp, err := client.PrivateClient().DisconnectNetworkPeer(client.ctx, peerID)
+	return decodeResponse(resp, err, nil)
+}
+
+// NetworkBans lists the peer addresses and hosts the node currently bans
+func (client RestClient) NetworkBans() (response privateV2.NetworkBansResponse, err error) {
+	resp, err := client.PrivateClient().GetNetworkBans(client.ctx)
+	err = decodeResponse(resp, err, &response)
+	return
+}
+
+// BanPeer bans the given peer address or host for the given number of seconds
+func (client RestClient) BanPeer(address string, durationSeconds uint64) (response privateV2.NetworkBanResponse, err error) {
+	resp, err := client.PrivateClient().BanNetworkPeer(client.ctx, &privateV2.BanNetworkPeerParams{Address: address, Duration: durationSeconds})
+	err = decodeResponse(resp, err, &response)
+	return
+}
+
+// UnbanPeer lifts the ban on the given peer address or host
+func (client RestClient) UnbanPeer(address string) error {
+	resp, err := client.PrivateClient().UnbanNetworkPeer(client.ctx, &privateV2.UnbanNetworkPeerParams{Address: address})
+	return decodeResponse(resp, err, nil)
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -530,14 +507,17 @@ func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string,
 	query := make(map[string]string)
 	query["debug"] = "1"
 
-	goRoutines, err = client.doGetWithQuery(ctx, "/debug/pprof/goroutine", query)
+	var dump []byte
+	err = client.WithContext(ctx).getCommon(&dump, "/debug/pprof/goroutine", query, true)
+	goRoutines = string(dump)
 	return
 }
 
 // Compile compiles the given program and returned the compiled program
 func (client RestClient) Compile(program []byte) (compiledProgram []byte, programHash crypto.Digest, err error) {
 	var compileResponse generatedV2.CompileResponse
-	err = client.submitForm(&compileResponse, "/v2/teal/compile", program, "POST", false, true)
+	resp, err := client.GeneratedClient().TealCompileWithBody(client.ctx, "text/plain", bytes.NewReader(program))
+	err = decodeResponse(resp, err, &compileResponse)
 	if err != nil {
 		return nil, crypto.Digest{}, err
 	}
@@ -554,53 +534,17 @@ func (client RestClient) Compile(program []byte) (compiledProgram []byte, progra
 	return
 }
 
-func (client RestClient) doGetWithQuery(ctx context.Context, path string, queryArgs map[string]string) (result string, err error) {
-	queryURL := client.serverURL
-	queryURL.Path = path
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return
-	}
-	q := req.URL.Query()
-	for k, v := range queryArgs {
-		q.Add(k, v)
-	}
-	req.URL.RawQuery = q.Encode()
-
-	req.Header.Set(authHeader, client.apiToken)
-
-	httpClient := http.Client{}
-	resp, err := httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return
-	}
-	defer resp.Body.Close()
-
-	err = extractError(resp)
-	if err != nil {
-		return
-	}
-
-	bytes, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return
-	}
-	result = string(bytes)
-	return
-}
-
 // RawDryrun gets the raw DryrunResponse associated with the passed address
 func (client RestClient) RawDryrun(data []byte) (response []byte, err error) {
-	var blob Blob
-	err = client.submitForm(&blob, "/v2/teal/dryrun", data, "POST", false /* encodeJSON */, false /* decodeJSON */)
-	response = blob
+	resp, err := client.GeneratedClient().TealDryrunWithBody(client.ctx, "application/octet-stream", bytes.NewReader(data))
+	err = decodeResponse(resp, err, &response)
 	return
 }
 
 // Proof gets a Merkle proof for a transaction in a block.
 func (client RestClient) Proof(txid string, round uint64) (response generatedV2.ProofResponse, err error) {
 	txid = stripTransaction(txid)
-	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d/transactions/%s/proof", round, txid), nil)
+	resp, err := client.GeneratedClient().GetProof(client.ctx, round, txid, &generatedV2.GetProofParams{})
+	err = decodeResponse(resp, err, &response)
 	return
 }
@@ -19,11 +19,13 @@ package common
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
 )
 
@@ -88,6 +90,46 @@ func HealthCheck(ctx lib.ReqContext, context echo.Context) {
 	json.NewEncoder(w).Encode(nil)
 }
 
+// ReadyCheck is an httpHandler for route GET /ready
+func ReadyCheck(ctx lib.ReqContext, context echo.Context) {
+	// swagger:operation GET /ready ReadyCheck
+	//---
+	//     Summary: Returns OK if ready to serve requests.
+	//     Description: Returns OK when the node is in sync with the network and can serve requests.
+	//     Produces:
+	//     - application/json
+	//     Schemes:
+	//     - http
+	//     Responses:
+	//       200:
+	//         description: OK.
+	//       500:
+	//         description: Internal Error
+	//       503:
+	//         description: Node not ready, the message tells why.
+	//       default: { description: Unknown Error }
+	w := context.Response().Writer
+	w.Header().Set("Content-Type", "application/json")
+
+	stat, err := ctx.Node.Status()
+	if err != nil {
+		ctx.Log.Info(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(generated.ErrorResponse{Message: "failed retrieving node status"})
+		return
+	}
+
+	maxRoundAge := time.Duration(ctx.Node.Config().ReadinessMaxRoundAgeSeconds) * time.Second
+	if err := stat.ReadinessError(maxRoundAge); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(generated.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(nil)
+}
+
 // VersionsHandler is an httpHandler for route GET /versions
 func VersionsHandler(ctx lib.ReqContext, context echo.Context) {
 	// swagger:route GET /versions GetVersion
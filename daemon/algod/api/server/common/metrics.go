@@ -41,7 +41,8 @@ func Metrics(ctx lib.ReqContext, context echo.Context) {
 	//       404:
 	//         description: metrics were compiled out
 	w := context.Response().Writer
-	w.Header().Set("Content-Type", "text/plain")
+	// Served in the Prometheus text exposition format, so that it can be scraped directly.
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
 	w.WriteHeader(http.StatusOK)
 
 	var buf strings.Builder
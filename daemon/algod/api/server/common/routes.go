@@ -41,6 +41,13 @@ var Routes = lib.Routes{
 		HandlerFunc: HealthCheck,
 	},
 
+	lib.Route{
+		Name:        "readycheck",
+		Method:      "GET",
+		Path:        "/ready",
+		HandlerFunc: ReadyCheck,
+	},
+
 	lib.Route{
 		Name:        "swagger.json",
 		Method:      "GET",
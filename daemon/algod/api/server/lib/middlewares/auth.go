@@ -21,8 +21,12 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 // TokenPathParam is the name of the path parameter used by URLAuthPrefix
@@ -35,25 +39,93 @@ const urlAuthFormatter = "/urlAuth/%s"
 // InvalidTokenMessage is the message set when an invalid / missing token is found.
 const InvalidTokenMessage = "Invalid API Token"
 
+// InsufficientScopeMessage is the message set when a valid token isn't granted
+// the scope required by the route.
+const InsufficientScopeMessage = "API Token not allowed to access this route"
+
+// QuotaExceededMessage is the message set when a token exceeds its request quota.
+const QuotaExceededMessage = "API Token request quota exceeded"
+
+var restRequests = metrics.MakeCounter(metrics.MetricName{Name: "algod_rest_requests_total", Description: "number of authenticated REST API requests, by token"})
+var restRequestsRejected = metrics.MakeCounter(metrics.MetricName{Name: "algod_rest_requests_rejected_total", Description: "number of REST API requests rejected for their token scope or quota, by token and reason"})
+
 // AuthMiddleware provides some data to the handler.
 type AuthMiddleware struct {
 	// Header is the token header which needs to be provided. For example 'X-Algod-API-Token'.
 	header string
 
 	// Tokens is the set of tokens which can be set to allow access.
-	tokens [][]byte
+	tokens []authToken
+
+	// scope returns the scope required by the request, or an empty string if
+	// any valid token may access it.
+	scope func(echo.Context) string
+}
+
+// authToken is a token accepted by the middleware.
+type authToken struct {
+	value []byte
+	name  string
+
+	// scopes is the set of scopes granted to the token, nil granting them all.
+	scopes map[string]bool
+
+	// quota limits the requests made with the token, nil meaning no limit.
+	quota *requestQuota
 }
 
 // MakeAuth constructs the auth middleware function
 func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
-	apiTokenBytes := make([][]byte, 0)
+	apiTokens := make([]authToken, 0)
 	for _, token := range tokens {
-		apiTokenBytes = append(apiTokenBytes, []byte(token))
+		apiTokens = append(apiTokens, authToken{value: []byte(token)})
 	}
 
 	auth := AuthMiddleware{
 		header: header,
-		tokens: apiTokenBytes,
+		tokens: apiTokens,
+	}
+
+	return auth.handler
+}
+
+// ScopedAuth builds auth middleware functions accepting tokens only on the
+// requests requiring a scope they are granted. The middleware functions share
+// the request quotas of the tokens.
+type ScopedAuth struct {
+	header string
+	tokens []authToken
+}
+
+// MakeScopedAuth constructs a ScopedAuth for the given tokens.
+func MakeScopedAuth(header string, scopedTokens []tokens.ScopedToken) *ScopedAuth {
+	apiTokens := make([]authToken, 0, len(scopedTokens))
+	for _, token := range scopedTokens {
+		scopes := make(map[string]bool, len(token.Scopes))
+		for _, s := range token.Scopes {
+			scopes[s] = true
+		}
+		apiTokens = append(apiTokens, authToken{
+			value:  []byte(token.Token),
+			name:   token.Name,
+			scopes: scopes,
+			quota:  makeRequestQuota(token.RequestsPerSecond, token.MaxConcurrentRequests),
+		})
+	}
+
+	return &ScopedAuth{
+		header: header,
+		tokens: apiTokens,
+	}
+}
+
+// Middleware constructs the auth middleware function for the requests requiring
+// the scope returned by the given function.
+func (sa *ScopedAuth) Middleware(scope func(echo.Context) string) echo.MiddlewareFunc {
+	auth := AuthMiddleware{
+		header: sa.header,
+		tokens: sa.tokens,
+		scope:  scope,
 	}
 
 	return auth.handler
@@ -94,13 +166,37 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 		}
 
 		// Check the tokens in constant time
-		for _, tokenBytes := range auth.tokens {
-			if subtle.ConstantTimeCompare(providedToken, tokenBytes) == 1 {
-				// Token was correct, keep serving request
-				return next(ctx)
+		for i := range auth.tokens {
+			token := &auth.tokens[i]
+			if subtle.ConstantTimeCompare(providedToken, token.value) == 1 {
+				// Token was correct, keep serving request if it is allowed to
+				return auth.serve(ctx, token, next)
 			}
 		}
 
 		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
 	}
 }
+
+// serve passes the request on to the next handler, unless the token isn't
+// granted the scope it requires or is over its quota.
+func (auth *AuthMiddleware) serve(ctx echo.Context, token *authToken, next echo.HandlerFunc) error {
+	if token.name == "" {
+		return next(ctx)
+	}
+	restRequests.Inc(map[string]string{"token": token.name})
+
+	if auth.scope != nil && token.scopes != nil && !token.scopes[auth.scope(ctx)] {
+		restRequestsRejected.Inc(map[string]string{"token": token.name, "reason": "scope"})
+		return echo.NewHTTPError(http.StatusForbidden, InsufficientScopeMessage)
+	}
+
+	if token.quota != nil {
+		if !token.quota.acquire(time.Now()) {
+			restRequestsRejected.Inc(map[string]string{"token": token.name, "reason": "quota"})
+			return echo.NewHTTPError(http.StatusTooManyRequests, QuotaExceededMessage)
+		}
+		defer token.quota.release()
+	}
+	return next(ctx)
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/tokens"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
 )
@@ -189,3 +190,37 @@ func TestAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestScopedAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	scopedTokens := []tokens.ScopedToken{
+		{Name: "reader", Token: "token1", Scopes: []string{tokens.ScopeRead}},
+		{Name: "submitter", Token: "token2", Scopes: []string{tokens.ScopeRead, tokens.ScopeSubmit}, RequestsPerSecond: 2},
+	}
+	auth := MakeScopedAuth(testAPIHeader, scopedTokens)
+	readHandler := auth.Middleware(func(echo.Context) string { return tokens.ScopeRead })(success)
+	submitHandler := auth.Middleware(func(echo.Context) string { return tokens.ScopeSubmit })(success)
+
+	request := func(handler echo.HandlerFunc, token string) error {
+		req, _ := http.NewRequest("GET", "N/A", nil)
+		req.Header.Set(testAPIHeader, token)
+		ctx := e.NewContext(req, nil)
+		ctx.SetPath("")
+		return handler(ctx)
+	}
+
+	require.Equal(t, errSuccess, request(readHandler, "token1"))
+	require.Equal(t, echo.NewHTTPError(http.StatusForbidden, InsufficientScopeMessage), request(submitHandler, "token1"))
+	require.Equal(t, invalidTokenError, request(readHandler, "token3"))
+
+	// the quota of the submitter is shared between the routes it may access.
+	require.Equal(t, errSuccess, request(submitHandler, "token2"))
+	require.Equal(t, errSuccess, request(readHandler, "token2"))
+	require.Equal(t, echo.NewHTTPError(http.StatusTooManyRequests, QuotaExceededMessage), request(submitHandler, "token2"))
+
+	// the reader has no quota.
+	for i := 0; i < 10; i++ {
+		require.Equal(t, errSuccess, request(readHandler, "token1"))
+	}
+}
@@ -23,8 +23,14 @@ import (
 	"github.com/labstack/echo/v4"
 
 	log "github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
+// unmatchedRoute labels the requests which don't match any route.
+const unmatchedRoute = "unmatched"
+
+var restRequestSeconds = metrics.MakeLatencyHistogram(metrics.MetricName{Name: "algod_rest_request_seconds", Description: "seconds spent serving REST API requests, by route, method and status"})
+
 // LoggerMiddleware provides some extra state to the logger middleware
 type LoggerMiddleware struct {
 	log log.Logger
@@ -66,6 +72,17 @@ func (logger *LoggerMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc
 			req.UserAgent(),
 			time.Since(start),
 		)
+		// Label the requests by their route rather than their path, which keeps the number of labels bounded.
+		// echo sets the path of the requests which don't match any route to their raw path.
+		route := ctx.Path()
+		if err == echo.ErrNotFound {
+			route = unmatchedRoute
+		}
+		restRequestSeconds.ObserveSince(start, map[string]string{
+			"route":  route,
+			"method": req.Method,
+			"status": strconv.Itoa(res.Status),
+		})
 
 		return
 	}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLoggerUnmatchedRoute(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+	e.Use(MakeLogger(logging.TestingLog(t)))
+	e.GET("/v2/status", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	for _, path := range []string{"/v2/status", "/probe/one", "/probe/two"} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// the requests which don't match any route share a single series.
+	var buf strings.Builder
+	restRequestSeconds.WriteMetric(&buf, "")
+	metric := buf.String()
+	require.NotContains(t, metric, "/probe/")
+	require.Contains(t, metric, `algod_rest_request_seconds_count{method="GET",route="/v2/status",status="200"} 1`)
+	require.Contains(t, metric, `algod_rest_request_seconds_count{method="GET",route="unmatched",status="404"} 2`)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// requestQuota limits the rate of requests made with a token, using a token
+// bucket holding up to a second worth of requests, as well as the number of
+// requests in flight.
+type requestQuota struct {
+	mu deadlock.Mutex
+
+	rate          float64
+	burst         float64
+	maxConcurrent int
+
+	available  float64
+	lastRefill time.Time
+	concurrent int
+}
+
+// makeRequestQuota returns the quota enforcing the given limits, or nil if there
+// are none.
+func makeRequestQuota(requestsPerSecond float64, maxConcurrent int) *requestQuota {
+	if requestsPerSecond <= 0 && maxConcurrent <= 0 {
+		return nil
+	}
+	burst := requestsPerSecond
+	if burst < 1 {
+		burst = 1
+	}
+	return &requestQuota{
+		rate:          requestsPerSecond,
+		burst:         burst,
+		maxConcurrent: maxConcurrent,
+		available:     burst,
+	}
+}
+
+// acquire reserves a request at the given time, returning false if it would
+// exceed the quota. Each successful acquire must be followed by a release once
+// the request is served.
+func (q *requestQuota) acquire(now time.Time) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.maxConcurrent > 0 && q.concurrent >= q.maxConcurrent {
+		return false
+	}
+
+	if q.rate > 0 {
+		if q.lastRefill.IsZero() {
+			q.lastRefill = now
+		} else if now.After(q.lastRefill) {
+			q.available += now.Sub(q.lastRefill).Seconds() * q.rate
+			if q.available > q.burst {
+				q.available = q.burst
+			}
+			q.lastRefill = now
+		}
+		if q.available < 1 {
+			return false
+		}
+		q.available--
+	}
+
+	q.concurrent++
+	return true
+}
+
+// release marks a request reserved by acquire as served.
+func (q *requestQuota) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.concurrent--
+}
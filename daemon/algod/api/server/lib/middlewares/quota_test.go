@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRequestQuota(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Nil(t, makeRequestQuota(0, 0))
+
+	now := time.Now()
+	rateQuota := makeRequestQuota(4, 0)
+	for i := 0; i < 4; i++ {
+		require.True(t, rateQuota.acquire(now))
+		rateQuota.release()
+	}
+	require.False(t, rateQuota.acquire(now))
+
+	// the bucket refills at the given rate, up to a second worth of requests.
+	require.True(t, rateQuota.acquire(now.Add(250*time.Millisecond)))
+	require.False(t, rateQuota.acquire(now.Add(250*time.Millisecond)))
+	later := now.Add(time.Hour)
+	for i := 0; i < 4; i++ {
+		require.True(t, rateQuota.acquire(later))
+	}
+	require.False(t, rateQuota.acquire(later))
+
+	concurrencyQuota := makeRequestQuota(0, 2)
+	require.True(t, concurrencyQuota.acquire(now))
+	require.True(t, concurrencyQuota.acquire(now))
+	require.False(t, concurrencyQuota.acquire(now))
+	concurrencyQuota.release()
+	require.True(t, concurrencyQuota.acquire(now))
+}
@@ -102,16 +102,56 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 // TokenHeader is the header where we put the token.
 const TokenHeader = "X-Algo-API-Token"
 
+// routeScopes lists the scopes required by the routes of the public API which
+// don't only read the node state. Every other GET route of the public API
+// requires the read scope, and every route of the private API requires the
+// admin scope.
+var routeScopes = map[string]string{
+	http.MethodPost + " " + apiV1Tag + "/transactions": tokens.ScopeSubmit,
+	http.MethodPost + " /v2/transactions":              tokens.ScopeSubmit,
+	http.MethodPost + " /v2/transactions/batch":        tokens.ScopeSubmit,
+	http.MethodPost + " /v2/teal/compile":              tokens.ScopeDryrun,
+	http.MethodPost + " /v2/teal/dryrun":               tokens.ScopeDryrun,
+}
+
+// publicRouteScope returns the scope required by a request to the public API.
+// Requests to the routes missing from routeScopes require the submit scope
+// unless they are GET requests, so that a new route changing the node state
+// isn't granted to read-only tokens.
+func publicRouteScope(ctx echo.Context) string {
+	if scope, ok := routeScopes[ctx.Request().Method+" "+ctx.Path()]; ok {
+		return scope
+	}
+	if ctx.Request().Method == http.MethodGet {
+		return tokens.ScopeRead
+	}
+	return tokens.ScopeSubmit
+}
+
+// adminRouteScope returns the scope required by a request to the private API.
+func adminRouteScope(ctx echo.Context) string {
+	return tokens.ScopeAdmin
+}
+
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener) *echo.Echo {
+// Besides the API and admin API tokens, which are granted access to the public and
+// to all routes respectively, the scoped tokens are granted access to the routes
+// requiring one of their scopes.
+func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-chan struct{}, apiToken string, adminAPIToken string, scopedTokens []tokens.ScopedToken, listener net.Listener) *echo.Echo {
 	if err := tokens.ValidateAPIToken(apiToken); err != nil {
 		logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
 	}
 	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
 		logger.Errorf("Invalid adminAPIToken was passed to NewRouter ('%s'): %v", adminAPIToken, err)
 	}
-	adminAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken})
-	apiAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken})
+	authTokens := []tokens.ScopedToken{
+		{Name: tokens.AlgodAdminTokenFilename, Token: adminAPIToken, Scopes: tokens.AllScopes},
+		{Name: tokens.AlgodTokenFilename, Token: apiToken, Scopes: []string{tokens.ScopeRead, tokens.ScopeSubmit, tokens.ScopeDryrun}},
+	}
+	authTokens = append(authTokens, scopedTokens...)
+	auth := middlewares.MakeScopedAuth(TokenHeader, authTokens)
+	adminAuthenticator := auth.Middleware(adminRouteScope)
+	apiAuthenticator := auth.Middleware(publicRouteScope)
 
 	e := echo.New()
 
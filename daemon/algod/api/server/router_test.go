@@ -17,6 +17,7 @@ package server
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -25,7 +26,10 @@ import (
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v1/routes"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 type TestSuite struct {
@@ -200,6 +204,40 @@ func (s *TestSuite) TestGetTransactionByID() {
 	assert.Equal(s.T(), nil, ctx.Handler()(ctx))
 	assert.Equal(s.T(), callsBefore+1, s.calls)
 }
+func (s *TestSuite) TestPublicRouteScope() {
+	// partitiontest.PartitionTest(s.T())
+	// Partitioning in TestTestSuite()
+	ctx := s.e.NewContext(httptest.NewRequest(http.MethodPost, "/v1/transactions", nil), nil)
+	s.e.Router().Find(http.MethodPost, "/v1/transactions", ctx)
+	assert.Equal(s.T(), tokens.ScopeSubmit, publicRouteScope(ctx))
+
+	ctx = s.e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/transactions/pending", nil), nil)
+	s.e.Router().Find(http.MethodGet, "/v1/transactions/pending", ctx)
+	assert.Equal(s.T(), tokens.ScopeRead, publicRouteScope(ctx))
+
+	ctx = s.e.NewContext(httptest.NewRequest(http.MethodDelete, "/v1/transactions/pending", nil), nil)
+	ctx.SetPath("/v1/transactions/pending")
+	assert.Equal(s.T(), tokens.ScopeSubmit, publicRouteScope(ctx))
+}
+
+// TestPublicRouteScopesListed makes sure that every route of the public API which
+// doesn't only read the node state is listed in routeScopes.
+func TestPublicRouteScopesListed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+	registerHandlers(e, apiV1Tag, routes.V1Routes, lib.ReqContext{})
+	generated.RegisterHandlers(e, &v2.Handlers{})
+
+	for _, route := range e.Routes() {
+		if route.Method == http.MethodGet {
+			continue
+		}
+		_, ok := routeScopes[route.Method+" "+route.Path]
+		assert.True(t, ok, "%s %s is missing from routeScopes", route.Method, route.Path)
+	}
+}
+
 func TestTestSuite(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	suite.Run(t, new(TestSuite))
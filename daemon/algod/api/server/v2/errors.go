@@ -32,10 +32,28 @@ var (
 	errInternalFailure                         = "internal failure"
 	errNoTxnSpecified                          = "no transaction ID was specified"
 	errTransactionNotFound                     = "could not find the transaction in the transaction pool or in the last 1000 confirmed rounds"
+	errConfirmedTxnNotFound                    = "could not find the transaction in the recently confirmed rounds"
 	errServiceShuttingDown                     = "operation aborted as server is shutting down"
 	errRequestedRoundInUnsupportedRound        = "requested round would reach only after the protocol upgrade which isn't supported"
 	errFailedToParseCatchpoint                 = "failed to parse catchpoint"
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
+	errFailedToParseParticipationID            = "failed to parse the participation ID"
+	errFailedRetrievingParticipationKeys       = "failed retrieving participation keys"
+	errParticipationKeyNotFound                = "participation key not found"
+	errParticipationKeyEmpty                   = "participation key database was empty"
+	errFailedParsingEventType                  = "failed to parse the event type"
+	errFailedParsingLastEventID                = "failed to parse the Last-Event-ID header"
+	errFailedSubscribingToEvents               = "failed subscribing to the node events"
+	errRoundNotAvailable                       = "requested round is not available"
+	errIndexerNotActive                        = "the node indexer is not enabled"
+	errFailedSearchingIndexer                  = "failed retrieving information from the indexer"
+	errFailedParsingNotePrefix                 = "failed to parse the note prefix"
+	errFailedParsingNextToken                  = "failed to parse the next token"
+	errPeerNotFound                            = "peer not found"
+	errNoBanAddressSpecified                   = "no address to ban was specified"
+	errInvalidBanDuration                      = "the ban duration must be between 1 second and %v"
+	errBanNotFound                             = "the address isn't banned"
+	errCompactCertNotFound                     = "no compact cert for the round in the ledger"
 )
@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// eventStreamKeepAlive is the interval at which a comment is sent on an idle
+// event stream, so that proxies don't close the connection.
+const eventStreamKeepAlive = 15 * time.Second
+
+// laggedEvent is the type of the event sent before closing the stream of a
+// client which fell too far behind.
+const laggedEvent = "lagged"
+
+// eventData is the payload of a streamed event.
+type eventData struct {
+	Round uint64                        `codec:"round,omitempty"`
+	Block *bookkeeping.BlockHeader      `codec:"block,omitempty"`
+	Txn   *transactions.SignedTxnWithAD `codec:"txn,omitempty"`
+	TxID  string                        `codec:"txid,omitempty"`
+}
+
+// parseEventFilter converts the query parameters of StreamEvents into an event filter.
+func parseEventFilter(params generated.StreamEventsParams) (filter node.EventFilter, errMsg string, err error) {
+	if params.Types != nil {
+		filter.Types = make(map[node.EventType]bool)
+		for _, t := range *params.Types {
+			eventType := node.EventType(t)
+			switch eventType {
+			case node.BlockEvent, node.TransactionEvent, node.PendingTransactionEvent:
+				filter.Types[eventType] = true
+			default:
+				return node.EventFilter{}, errFailedParsingEventType, fmt.Errorf("unknown event type: %s", t)
+			}
+		}
+	}
+	if params.Address != nil {
+		for _, a := range *params.Address {
+			addr, err := basics.UnmarshalChecksumAddress(a)
+			if err != nil {
+				return node.EventFilter{}, errFailedToParseAddress, err
+			}
+			filter.Addresses = append(filter.Addresses, addr)
+		}
+	}
+	if params.ApplicationId != nil {
+		for _, appID := range *params.ApplicationId {
+			filter.ApplicationIDs = append(filter.ApplicationIDs, basics.AppIndex(appID))
+		}
+	}
+	if params.AssetId != nil {
+		for _, assetID := range *params.AssetId {
+			filter.AssetIDs = append(filter.AssetIDs, basics.AssetIndex(assetID))
+		}
+	}
+	return filter, "", nil
+}
+
+// writeEvent writes an event to the stream in the Server-Sent Events format. Block
+// events carry the round as their id, and are reduced to the id alone when block
+// events were not requested, so that clients can always resume where they stopped.
+func writeEvent(w *echo.Response, ev node.Event, filter node.EventFilter) error {
+	var buf bytes.Buffer
+	if ev.Type == node.BlockEvent {
+		fmt.Fprintf(&buf, "id: %d\n", ev.Round)
+	}
+
+	if ev.Type != node.BlockEvent || filter.MatchType(node.BlockEvent) {
+		data := eventData{Round: uint64(ev.Round)}
+		if ev.Type == node.BlockEvent {
+			data.Block = &ev.Header
+		} else {
+			data.Txn = &ev.Txn
+			data.TxID = ev.Txn.ID().String()
+		}
+
+		encoded, err := encode(protocol.JSONStrictHandle, data)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(&buf, "event: %s\n", ev.Type)
+		for _, line := range bytes.Split(encoded, []byte("\n")) {
+			fmt.Fprintf(&buf, "data: %s\n", line)
+		}
+	}
+	buf.WriteString("\n")
+
+	_, err := w.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	w.Flush()
+	return nil
+}
+
+// writeLagged tells the client that it fell behind and lost events, and the
+// round from which to resume.
+func writeLagged(w *echo.Response, next basics.Round) {
+	encoded, err := encode(protocol.JSONStrictHandle, eventData{Round: uint64(next)})
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", laggedEvent, encoded)
+	w.Flush()
+}
+
+// StreamEvents streams the node's block and transaction events as Server-Sent Events.
+// (GET /v2/events)
+func (v2 *Handlers) StreamEvents(ctx echo.Context, params generated.StreamEventsParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("StreamEvents failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	filter, errMsg, err := parseEventFilter(params)
+	if err != nil {
+		return badRequest(ctx, err, errMsg, v2.Log)
+	}
+
+	replay := false
+	var next basics.Round
+	if params.Round != nil {
+		replay = true
+		next = basics.Round(*params.Round)
+	}
+	if lastEventID := ctx.Request().Header.Get("Last-Event-ID"); lastEventID != "" {
+		lastRound, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return badRequest(ctx, err, errFailedParsingLastEventID, v2.Log)
+		}
+		replay = true
+		next = basics.Round(lastRound) + 1
+	}
+
+	latest := v2.Node.Ledger().Latest()
+	if !replay {
+		next = latest + 1
+	}
+
+	// Rounds to replay are read from the ledger before subscribing, so that a
+	// long replay cannot overflow the backlog of the subscription.
+	var sub *node.EventSubscription
+	var events []node.Event
+	if next <= latest {
+		events, err = v2.Node.BlockEvents(next, filter)
+		if err != nil {
+			return notFound(ctx, err, errRoundNotAvailable, v2.Log)
+		}
+	} else {
+		sub, err = v2.Node.SubscribeEvents(filter)
+		if err != nil {
+			return serviceUnavailable(ctx, err, errFailedSubscribingToEvents, v2.Log)
+		}
+		defer sub.Close()
+	}
+
+	w := ctx.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	// replayTo writes the events of the committed rounds from next up to the
+	// given round, reading them from the ledger.
+	replayTo := func(latest basics.Round) bool {
+		for ; next <= latest; next++ {
+			if events == nil {
+				events, err = v2.Node.BlockEvents(next, filter)
+				if err != nil {
+					v2.Log.Warnf("StreamEvents: unable to replay round %d: %v", next, err)
+					return false
+				}
+			}
+			for _, ev := range events {
+				if err = writeEvent(w, ev, filter); err != nil {
+					return false
+				}
+			}
+			events = nil
+
+			select {
+			case <-ctx.Request().Context().Done():
+				return false
+			case <-v2.Shutdown:
+				return false
+			default:
+			}
+		}
+		return true
+	}
+
+	if sub == nil {
+		for next <= latest {
+			if !replayTo(latest) {
+				return nil
+			}
+			latest = v2.Node.Ledger().Latest()
+		}
+
+		sub, err = v2.Node.SubscribeEvents(filter)
+		if err != nil {
+			v2.Log.Infof("StreamEvents: %v", err)
+			return nil
+		}
+		defer sub.Close()
+	}
+
+	// The subscription only gets the blocks committed after it started, so
+	// replay the ones committed since the ledger was last looked at.
+	if !replayTo(v2.Node.Ledger().Latest()) {
+		return nil
+	}
+
+	keepAlive := time.NewTicker(eventStreamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case ev, ok := <-sub.Events():
+			if !ok {
+				// the subscription fell behind, or the node is shutting down.
+				if sub.Lagged() {
+					writeLagged(w, next)
+				}
+				return nil
+			}
+			if ev.Type != node.PendingTransactionEvent && ev.Round < next {
+				// already replayed.
+				continue
+			}
+			if err = writeEvent(w, ev, filter); err != nil {
+				return nil
+			}
+			if ev.Type == node.BlockEvent {
+				next = ev.Round + 1
+			}
+		case <-keepAlive.C:
+			if _, err = w.Write([]byte(": keep-alive\n\n")); err != nil {
+				return nil
+			}
+			w.Flush()
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-v2.Shutdown:
+			return nil
+		}
+	}
+}
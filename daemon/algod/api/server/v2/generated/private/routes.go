@@ -17,12 +17,42 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Gets the agreement timelines of the latest rounds.
+	// (GET /v2/agreement/timeline)
+	GetAgreementTimeline(ctx echo.Context, params GetAgreementTimelineParams) error
 	// Aborts a catchpoint catchup.
 	// (DELETE /v2/catchup/{catchpoint})
 	AbortCatchup(ctx echo.Context, catchpoint string) error
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Lifts a peer ban.
+	// (DELETE /v2/network/bans)
+	UnbanNetworkPeer(ctx echo.Context, params UnbanNetworkPeerParams) error
+	// Gets the peer bans.
+	// (GET /v2/network/bans)
+	GetNetworkBans(ctx echo.Context) error
+	// Bans a peer address or host.
+	// (POST /v2/network/bans)
+	BanNetworkPeer(ctx echo.Context, params BanNetworkPeerParams) error
+	// Gets the connected peers.
+	// (GET /v2/network/peers)
+	GetNetworkPeers(ctx echo.Context) error
+	// Disconnects a peer.
+	// (DELETE /v2/network/peers/{peer-id})
+	DisconnectNetworkPeer(ctx echo.Context, peerId uint64) error
+	// Return a list of participation keys
+	// (GET /v2/participation)
+	GetParticipationKeys(ctx echo.Context) error
+	// Add a participation key to the node
+	// (POST /v2/participation)
+	AddParticipationKey(ctx echo.Context) error
+	// Delete a given participation key by ID
+	// (DELETE /v2/participation/{participation-id})
+	DeleteParticipationKeyByID(ctx echo.Context, participationId string) error
+	// Get participation key info given a participation ID
+	// (GET /v2/participation/{participation-id})
+	GetParticipationKeyByID(ctx echo.Context, participationId string) error
 
 	// (POST /v2/register-participation-keys/{address})
 	RegisterParticipationKeys(ctx echo.Context, address string, params RegisterParticipationKeysParams) error
@@ -36,6 +66,42 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAgreementTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAgreementTimeline(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"max":    true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgreementTimelineParams
+	// ------------- Optional query parameter "max" -------------
+	if paramValue := ctx.QueryParam("max"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAgreementTimeline(ctx, params)
+	return err
+}
+
 // AbortCatchup converts echo context to params.
 func (w *ServerInterfaceWrapper) AbortCatchup(ctx echo.Context) error {
 
@@ -96,6 +162,277 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// UnbanNetworkPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) UnbanNetworkPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"address": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnbanNetworkPeerParams
+	// ------------- Required query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument address is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnbanNetworkPeer(ctx, params)
+	return err
+}
+
+// GetNetworkBans converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNetworkBans(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetNetworkBans(ctx)
+	return err
+}
+
+// BanNetworkPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) BanNetworkPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":   true,
+		"address":  true,
+		"duration": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BanNetworkPeerParams
+	// ------------- Required query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument address is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Required query parameter "duration" -------------
+	if paramValue := ctx.QueryParam("duration"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument duration is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BanNetworkPeer(ctx, params)
+	return err
+}
+
+// GetNetworkPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNetworkPeers(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetNetworkPeers(ctx)
+	return err
+}
+
+// DisconnectNetworkPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) DisconnectNetworkPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "peer-id" -------------
+	var peerId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "peer-id", ctx.Param("peer-id"), &peerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter peer-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DisconnectNetworkPeer(ctx, peerId)
+	return err
+}
+
+// GetParticipationKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationKeys(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationKeys(ctx)
+	return err
+}
+
+// AddParticipationKey converts echo context to params.
+func (w *ServerInterfaceWrapper) AddParticipationKey(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddParticipationKey(ctx)
+	return err
+}
+
+// DeleteParticipationKeyByID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteParticipationKeyByID(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "participation-id" -------------
+	var participationId string
+
+	err = runtime.BindStyledParameter("simple", false, "participation-id", ctx.Param("participation-id"), &participationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participation-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteParticipationKeyByID(ctx, participationId)
+	return err
+}
+
+// GetParticipationKeyByID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationKeyByID(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "participation-id" -------------
+	var participationId string
+
+	err = runtime.BindStyledParameter("simple", false, "participation-id", ctx.Param("participation-id"), &participationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participation-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationKeyByID(ctx, participationId)
+	return err
+}
+
 // RegisterParticipationKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) RegisterParticipationKeys(ctx echo.Context) error {
 
@@ -225,8 +562,18 @@ func RegisterHandlers(router interface {
 		Handler: si,
 	}
 
+	router.GET("/v2/agreement/timeline", wrapper.GetAgreementTimeline, m...)
 	router.DELETE("/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST("/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.DELETE("/v2/network/bans", wrapper.UnbanNetworkPeer, m...)
+	router.GET("/v2/network/bans", wrapper.GetNetworkBans, m...)
+	router.POST("/v2/network/bans", wrapper.BanNetworkPeer, m...)
+	router.GET("/v2/network/peers", wrapper.GetNetworkPeers, m...)
+	router.DELETE("/v2/network/peers/:peer-id", wrapper.DisconnectNetworkPeer, m...)
+	router.GET("/v2/participation", wrapper.GetParticipationKeys, m...)
+	router.POST("/v2/participation", wrapper.AddParticipationKey, m...)
+	router.DELETE("/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
+	router.GET("/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST("/v2/register-participation-keys/:address", wrapper.RegisterParticipationKeys, m...)
 	router.POST("/v2/shutdown", wrapper.ShutdownNode, m...)
 
@@ -235,136 +582,206 @@ func RegisterHandlers(router interface {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPbtrLov4LROTP5eKJk56On8UznPDdOW7+maSZ2++49cW4LkSsJNQmwAGhJzfX/",
-	"fgcLgARJUJI/Tnoz5/yUWAQWi93FYnexWHwcpaIoBQeu1ejo46ikkhagQeJfNE1FxXXCMvNXBiqVrNRM",
-	"8NGR/0aUlowvRuMRM7+WVC9H4xGnBTRtTP/xSMLvFZOQjY60rGA8UukSCmoA601pWteQ1slCJA7EsQVx",
-	"ejK63vKBZpkEpfpY/sjzDWE8zasMiJaUK5qaT4qsmF4SvWSKuM6EcSI4EDEnetlqTOYM8kxN/CR/r0Bu",
-	"glm6wYendN2gmEiRQx/Pl6KYMQ4eK6iRqhlCtCAZzLHRkmpiRjC4+oZaEAVUpksyF3IHqhaJEF/gVTE6",
-	"ej9SwDOQyK0U2BX+dy4B/oBEU7kAPfowjk1urkEmmhWRqZ066ktQVa4VwbY4xwW7Ak5Mrwn5oVKazIBQ",
-	"Tt5985I8ffr0hZlIQbWGzAnZ4Kya0cM52e6jo1FGNfjPfVmj+UJIyrOkbv/um5c4/pmb4L6tqFIQXyzH",
-	"5gs5PRmagO8YESHGNSyQDy3pNz0ii6L5eQZzIWFPntjG98qUcPw/lSsp1emyFIzrCF8IfiX2c1SHBd23",
-	"6bAagVb70lBKGqDvD5IXHz4ejg8Prv/y/jj5h/vz+dPrPaf/soa7gwLRhmklJfB0kywkUFwtS8r79Hjn",
-	"5EEtRZVnZEmvkPm0QFXv+hLT16rOK5pXRk5YKsVxvhCKUCdGGcxplWviByYVz42aMtCctBOmSCnFFcsg",
-	"Gxvtu1qydElSqiwIbEdWLM+NDFYKsiFZi89uy2K6Dkli8LoVPXBC/3uJ0cxrByVgjdogSXOhINFix/bk",
-	"dxzKMxJuKM1epW62WZHzJRAc3Hywmy3SjhuZzvMN0cjXjFBFKPFb05iwOdmIiqyQOTm7xP5uNoZqBTFE",
-	"Q+a09lGzeIfI1yNGhHgzIXKgHInn112fZHzOFpUERVZL0Eu350lQpeAKiJj9Bqk2bP9/Zz++IUKSH0Ap",
-	"uoC3NL0kwFORDfPYDRrbwX9TwjC8UIuSppfx7TpnBYug/ANds6IqCK+KGUjDL78/aEEk6EryIYQsxB1y",
-	"VtB1f9BzWfEUmdsM2zLUjCgxVeZ0MyGnc1LQ9VcHY4eOIjTPSQk8Y3xB9JoPGmlm7N3oJVJUPNvDhtGG",
-	"YcGuqUpI2ZxBRmooWzBxw+zCh/Gb4dNYVgE6HsggOvUoO9DhsI7IjFm65gsp6QICkZmQn5zmwq9aXAKv",
-	"FRyZbfBTKeGKiUrVnQZwxKG3m9dcaEhKCXMWkbEzRw6jPWwbp14LZ+CkgmvKOGRG8yLSQoPVRIM4BQNu",
-	"d2b6W/SMKvji2dAG3nzdk/tz0eX6Vo7vxW1slNglGdkXzVe3YONmU6v/Hs5fOLZii8T+3GMkW5ybrWTO",
-	"ctxmfjP882SoFCqBFiH8xqPYglNdSTi64I/NXyQhZ5ryjMrM/FLYn36ocs3O2ML8lNufXosFS8/YYoCY",
-	"Na5Rbwq7FfYfAy+ujvU66jS8FuKyKsMJpS2vdLYhpydDTLYwbyqYx7UrG3oV52vvady0h17XjBxAcpB2",
-	"JTUNL2EjwWBL0zn+s56jPNG5/MP8U5Z5jKZGgN1Gi0EBFyx4534zP5klD9YnMFBYSg1Rp7h9Hn0MEPqr",
-	"hPnoaPSXaRMpmdqvaurgmhGvx6PjBs79j9T0tPPrODLNZ8K45Q42HVuf8P7xMVCjmKCh2sHh61ykl7fC",
-	"oZSiBKmZ5ePMwOmvFARPlkAzkCSjmk4ap8raWQPyjh2/w37oJYGMbHE/4n9oTsxnswqp9uabMV2ZMkac",
-	"CAJNmbH47D5iRzIN0BIVpLBGHjHG2Y2wfNkMbhV0rVHfO7J86EKLcOeVtSsJ9vCTMFNvvMbjmZC3k5eO",
-	"IHDS+MKEGqi19Wtm3uYsNq3KxNEnYk/bBh1ATfixr1ZDCnXBx2jVosKZpv8EKigD9T6o0AZ031QQRcly",
-	"uIf1uqRq2Z+EMXCePiFn3x0/P3zyy5PnX5gdupRiIWlBZhsNijx0+wpRepPDo/7MUMFXuY5D/+KZ96Da",
-	"cHdSCBGuYe+zos7BaAZLMWLjBQa7E7mRFb8HEoKUQkZsXhQdLVKRJ1cgFROR8MVb14K4FkYPWbu787vF",
-	"lqyoImZsdMcqnoGcxChv/Czc0jUUatdGYUGfr3lDGweQSkk3PQ7Y+UZm58bdhydt4nvrXpESZKLXnGQw",
-	"qxbhHkXmUhSEkgw7okJ8IzI401RX6h60QAOsQcYwIkSBzkSlCSVcZGZBm8Zx/TAQy8QgCsZ+dKhy9NLu",
-	"PzMw1nFKq8VSE2NWihhrm44JTS1TEtwr1IDrV/vstpUdzsbJcgk025AZACdi5vwr5/nhJCmGZbQ/cXHa",
-	"qUGr9glaeJVSpKAUZIk7XtqJmm9nuay30AkRR4TrUYgSZE7lLZHVQtN8B6LYJoZubU44p7SP9X7Db2Ng",
-	"d/CQjVQaH9NKgbFdzOrOQcMQCfekyRVIdM7+qfzzg9yWfVU5cHTiduBzVpjlSzjlQkEqeKaiwHKqdLJr",
-	"2ZpGLTPBzCBYKbGVioAHAgSvqdLWRWc8Q5PRqhscB/vgEMMID+4oBvLPfjPpw06NnuSqUvXOoqqyFFJD",
-	"FpsDh/WWsd7Auh5LzAPY9falBakU7II8RKUAviOWnYklENUuRlTHsPqTw3C82Qc2UVK2kGgIsQ2RM98q",
-	"oG4YPh5AxPgXdU8UHKY6klPHrMcjpUVZmvWnk4rX/YbIdGZbH+ufmrZ94aK60euZADO69jg5zFeWsvbg",
-	"YEmNbYeQSUEvzd6ElpqNJfRxNosxUYynkGyTfLMsz0yrcAnsWKQDRrI7mgxG6yyOjvxGhW5QCHZwYWjC",
-	"Axb7WxsBPw/i5vdgtUSgGkmjnKDp5uNqZnMIm8CapjrfGJWrl7AhK5BAVDUrmNb2SKNt1GhRJiGAqBO1",
-	"ZUTnxtrosTdJ9/GrzxBUML2+cToe2S10O37nnU20RQ63eZdC5JPd0tcjRhSDfYzgY1IKw3XmTtD8MUvO",
-	"lO4h6TZUjGHUC/mBapEZZ0D+U1QkpRyNgUpDrZ2ExCWPW4EZwSjTekxmd92GQpBDAdbGwS+PH3cn/vix",
-	"4zlTZA4rf+xsGnbJ8fgxWuxvhdJ3XgEd0VyfRpQMupZGY0VShYwDOdnpZiLcvbzLAPTpiR8QF5MyGsVO",
-	"XAoxv4fZsmwdO2zIYB2bqeMcGowPjHW1UaAn0Y2wNAhGzhtBXubojYp5RyJJAUZU1JKVBmRzNrLR0Mqr",
-	"+K+Hfz96f5z8gyZ/HCQv/s/0w8dn148e9358cv3VV//d/unp9VeP/v7XmPGgNJvFIxffUbU0mDrNsean",
-	"3MYe50Jak3PjdjIx/9R4d0TMMNNTPpjSPkL3NsYQxgm1zEaZM4ZKvrmHTcYCIhJKCQpVQmjgK/tVzMO0",
-	"Cid5aqM0FH0f2Xb9ZcBCeOf3156UCp4zDkkhOGyimYSMww/4MdbbqqWBzrhBDPXt2h8t/DtotcfZh5l3",
-	"pS9yO1BDb+skj3tgfhduJzwSJpSgewd5SShJc4bOn+BKyyrVF5yieRmIayS06o3mYYfjpW8S93AiDogD",
-	"dcGpMjSsjc5o2GwOEXfyGwDvd6hqsQClO8bNHOCCu1aMk4ozjWMVhl+JZVgJEuObE9uyoBsypzn6R3+A",
-	"FGRW6fZ2j+feShv3xcZqzDBEzC841SQH48r9wPj5GsH542UvMxz0SsjLmgpxnb8ADoqpJK5Iv7VfUZ+6",
-	"6S+dbsUkRPvZ65tPvQF43GOnsg7z0xNnCp+eoL3TRGl6uH8y171gPIkK2fkSSME4Jvd0ZIs8NFabF6BH",
-	"TbzHcf2C6zU3gnRFc5ZRfTtx6Kq43lq0q6MjNS1GdDwxP9cPsSO0hUhKml7iCcpowfSymk1SUUy9CzBd",
-	"iNodmGYUCsHxWzalJZuqEtLp1eEOc+wO+opE1NX1eOS0jrr3s1oHODah7ph1DMT/rQV58O2rczJ1nFIP",
-	"bIqGBR2crUe8NndDoBXkNpO3KcY2R+WCX/ATmDPOzPejC55RTaczqliqppUC+TXNKU9hshDkiDiQJ1TT",
-	"C95T8YO3ADCB0mFTVrOcpeQy3IqbpWkzO/sQLi7eGwG5uPjQi5j2N043VHSN2gGSFdNLUenEpa4lElZU",
-	"ZhHUVZ26hJBt4um2UcfEwbYS6VLjHPy4qqZlqZJcpDRPlKYa4tMvy9xMPxBDRbATnrgTpYX0StBoRosN",
-	"8veNcDFjSVc+77FSoMivBS3fM64/kOSiOjh4CuS4LF8bmGcGj1+drjEyuSmh5d/vmSvRAIv59jhxa1DB",
-	"WkualHQBKjp9DbRE7uNGXWBYOs8JdgtpUp83IqhmAp4ewwyweNw4GwQnd2Z7+TsI8SngJ2QhtjHaqQkW",
-	"3pZfBtR3IjdCdmt2BTCiXKr0MjFrOzorZUTcc6ZOTV4YnewjuIotuFkELot7BiRdQnoJGSaUQlHqzbjV",
-	"3R8SuB3Oqw6mbOK1TfrA7EAMhcyAVGVGnQ1A+aabpqVAa5+b9g4uYXMumuTCm+RlXY9HqU2FTozMDC1U",
-	"lNRgMzLCGi5bB6PLfHfgZDClZUkWuZi51V2LxVEtF77P8EK2O+Q9LOKYUNRk2CLvJZURQljhHyDBLSZq",
-	"4N1J9GPTK6nULGWlnf9+WWhvW30MkF2bS3Q7EfPurtFT6lElZhsnM6riGwiYL4YfZg11z+P8SDaqiDOY",
-	"ELy85wR3lqMtUh8F2pVNJRpdftr2NtIQanEpAcmbXd2j0aZIaD4sqfIXEPCehl8we220Q4cW9aGTkSJ/",
-	"6oT+XmM5MTNuDld0iP7DWbOnwVFScBmjzon1iq27GMZ1frS9F+lzZ33CrM+SHY1vlPE6Hrnshhg7BEcr",
-	"I4McFnbitrEXFIfaAxUwyODx43yeMw4kiZ1KUaVEyuwNkkaXuzHAGKGPCbEBHrI3hJgYB2hjtBwBkzci",
-	"XJt8cRMkOTAMr1MPG+Pswd+wO9rcXFB15u1OM7SvO5pFNG4SyC0b+1Go8SiqkoY8hFYrYpvMoOdSxUTU",
-	"qKZ+XKYf/VGQA27HSUuzJpexaJ2xKgDF8Mx3C9wG8pDNzSb/KDg0kbBgSkPjN5vV6gNBnzZ2cSU0JHMm",
-	"lU7QZY9OzzT6RqEx+I1pGlc/LVIRe8ONZXHtg8NewibJWF7Fue3G/f7EDPum9p9UNbuEDW4yQNMlmeGN",
-	"TLMLtYY3bbYMbU9mt074tZ3wa3pv891PlkxTM7AUQnfG+EykqqNPti2miADGhKPPtUGSblEv6PucQK5j",
-	"ibeBT4ZerVGYNjN8MGrQW0yZh73N/AqwGNa8FlJ0LoGhu3UWDE/iKM8I08GFxn6W4MAaoGXJsnXHh7dQ",
-	"B47t0IC/gaFuLf7IUdSoBraDAoG/HktEkeBjDpalwZ5pr6bycG6TvShjrK+QIIFCCIdiyhdW6BPKiDbe",
-	"/t1Fq3Og+few+dm0xemMrseju7n8MVo7iDto/bZmb5TOGMu2LmArgndDktOylOKK5okLjAyJphRXTjSx",
-	"uY+jfGJVF3e/z18dv37r0De+Zw5U2lDZ1llhu/KzmZXxiIUcWCD+4raxVr3vbA2xgPn1bZgwmLJagrsk",
-	"G9hyRos54bLLqwmUBUvRBVfm8SO1naESF9OzU9wS24OyDu01HrGN7LWjefSKsty7oh7bgeMvnFwTT72x",
-	"VggB3DkqGAR3k3tVN73VHV8djXTt0EnhWFuu8Rb2proigncTi4wJiR4uimpBN0aCbHC6r5x4VSRm+SUq",
-	"Z2k8bMFnyggHtzFf05hg4wFj1ECs2MARAq9YAMs0U3uclnWQDMaIEhNDSltoNxOuxFDF2e8VEJYB1+aT",
-	"xFXZWahmXfoyFf3t1NgO/bEcYFuyogF/FxvDgBqyLhCJ7QZGGGHuoXtSO5x+onVo3PwQBAZvcFAVjtjb",
-	"ErccMjn5cNJsT/uX7UhxWBGor/+MYNjb47vLEfmwxdIiOjBGtLzQ4G5xPLxTmN432COaLQHRDTeDsS0+",
-	"kisRAVPxFeW2WojpZ2noeiuwMQPTayUkpt0riJ7SM5XMpfgD4p7s3DAqkvvoSInmIvaeRNKZu0q0jso0",
-	"daA8fUM8BkV7yJILPpL2QeLACkcpD0LneI/VB7got2JtK5u0jq/jiyNMOZla+M3icDj30nRyuprR2CVf",
-	"Y1AZnI6bQ5pWKE4L4jt7LrioYSN7wXlP3ZbZXPUSZJOg3L8XdUvj6PMS+QxSVtA8biVlSP32zZyMLZgt",
-	"D1MpCOqPOEC2rpaVIlfDxR6DNaQ5nZODcVDhyHEjY1dMsVkO2OLQtphRhbtWHW6tu5jpAddLhc2f7NF8",
-	"WfFMQqaXyhJWCVIbsOjK1bHvGegVACcH2O7wBXmIUX/FruCRoaKzRUZHhy8wLcX+cRDb7FwdqG16JUPF",
-	"8v+dYonLMR57WBhmk3JQJ9F7E7Z437AK27KabNd91hK2dFpv91oqKKcLiJ/mFjtwsn2Rmxg07NCFZ7by",
-	"lNJSbAjT8fFBU6OfBlLTjPqzaJBUFAXThVlAWhAlCiNPTXERO6gHZ8tYuQv/Hi//EY9YSus2QNdh/rQB",
-	"YruXx2aNB2FvaAFtso4JtdeLctZc4HQKcUJO/SVFrIBQFz6wtDFjmamjSWdYiBe9GdfoRFV6nnxJ0iWV",
-	"NDXqbzKEbjL74lmk6kP7oje/GeKfnO4SFMirOOnlgNh7a8L1JQ+54ElhNEr2qEkFDVZl9Lq20DSPJ7V4",
-	"jd7NadoOel8D1EBJBsWtaokbDTT1nQSPbwF4R1Gs53MjebzxzD65ZFYyLh60Mhz66d1rZ2UUQsaurDfL",
-	"3VkcErRkcIX5NXEmGZh35IXM9+LCXbD/c09ZGg+gNsv8Wo45Al9XLM9+blLbO4VzJOXpMnrGMTMdf2kq",
-	"fdVTtus4ekN6STmHPArO7pm/+L01svv/JvYdp2B8z7bdgjh2up3JNYi30fRI+QENeZnOzQAhVdu5vnVy",
-	"WL4QGcFxmuu4jZT1a/wExUF+r0DpWNVR/GDzKjGWZfwCW5uCAM/Qqp6Qb22l3iWQ1g1NtGZZUeX2th9k",
-	"C5AuyFqVuaDZmBg456+OXxM7qu1jKyra2hgLNObas+jEMIK7+/ulOvlSWfE0zP3hbM8LM7NWGi/vKk2L",
-	"MpZhb1qc+waYxh/GddHMC6kzISfWwlbefrODGHmYM1kYy7SGZnU8yoT5j9Y0XaLp2tImwyK/f1EXL5Uq",
-	"KG5Y14mrr9/jujN4u7outqzLmAjjX6yYsgVa4QraSf31DRfnOvkk//b0ZMW5lZSojt52A+s2ZPfI2cN7",
-	"H/qNYtYh/A0NFyUqmcJNa9ycYa/oHeJuwZxeVUN7m7CuKuYLb6eUC85SvMEblIStUXbFXvc5F9njsnM3",
-	"LOWXuFuhkcUVLdNTpwc5Kg4W7vGK0BGuH5gNvhqmWumwf2qsKrqkmixAK6fZIBv7UkwuXsK4AldOAev+",
-	"BnpSyNZZE2rI6PFlUoe5byhGmOI7YAB/Y769ce4RpuVdMo6GkCObywC0EQ2sRamN9cQ0WQhQbj7tK7nq",
-	"vekzwWupGaw/THztSoRhj2rMtO25ZB/UsT+ldKeCpu1L05bgsUzzcyud2A56XJZu0OiN2prDsWJSgwSO",
-	"nDYlPtwfELeGH0LbIm5b0wtwPzWCBld4OAkl7sM9wajrcnUK7F3RvLIShS2ITeuJXgNjPILGa8ahqawa",
-	"2SDS6JaAjMH1OtBPpZJqawLupdPOgeZ4IhlTaEq7EO1dQXUYjCTBOfoxhtnYlBQbUBx1g8Zwo3xTF3Q1",
-	"0h0YEy+xkrQjZL9AGFpVzojKMHGzUzIspjiM4vbF9tobQH8Z9G0i211LalfOTXaioQsvqYjZm6/WkFb2",
-	"wF3Y2hC0LEmKN0iD/SIa0WTKOE/FLI/kvp3UH4M6fJhkO9vgv7GKHcMkcSfiN87J8sff2PHGBmsbUs/c",
-	"NMKUKLa4JZub/vfK51ws2oh82oDC1jUeikxsdb8yajO8A9mrBWMVa31FEdOQhC/Sik5TfbmmvSZRkUed",
-	"0qbe5nanfLhy5hhV/0Ay4rvm9j21u4s9YxhKSUwHM2ipdunxmpLmqnt/YdpylzEINp/Bltm0T1ZE4ytD",
-	"OQw2hcF87vXezy7qWZkIeytBfXJMH6HvfeYdKSlzB2jNiu1T1uXo9rOm98neaxjcnYTLfEUgsZn0KykN",
-	"C/gJaMpyVdeDrF83CM5bjT3XrceycjdTMHW4dk39HRVQ/jefZW9Hsa9mNFXPMBCwojLzLaI7m980k4EM",
-	"kG5OpU1dZXGk5/XIrDk+7acVRq5N4nF5mgvF+CIZyqpon1jW4b4HysZl0YfAElWI1xykq3ao/aMkiRb+",
-	"uHUbHttI4Wpi34YIarCqjkVu8G7Tu+byFtaKoPZJGhdzDidIJBTUYCeDK1bDY24j9kv73efR+VoBncoc",
-	"EbheXpOdd6T8wTlTPSKGUj8nTuXuzs+7jUnBOLfFZFXsvhU3pAydzVKKrEptrD9cGOBNr72vDG5RJVFD",
-	"IO3PsqfTc7xA+zrIdr6EzdTq1XRJeXOTub2sbU1ZO4fgbk6H2/dqbcX3tHxhJ7C4Fzz/TGNpPCqFyJMB",
-	"7/K0f22suwYuWXoJGTF7hz9yGqjlRh6iU1OHD1fLja+iWpbAIXs0IcSYW0WpNz6S2K5K0hmcP9Dbxl/j",
-	"qFllb3I6O25yweOnpfaRpzvqNw9mu1azrx7ecSgLZPtAes0HVBtdRSob7vtAQCS21zFQAqGyWMSslFte",
-	"p9lrffdtuYjoh4nQO4zoy5bhZ+/dd+J5QsI9G4BBIOOGBmA/xXvf6eE8UKtVCvrz3JsBLdoO0H4fwjfe",
-	"S5+4w06Hnu3jdMSvL5vu6PVYgvgL9v3V9cl8ltZbAm7cGNd/HjrDsecUA8eFHZpWLM92Mbd1+NsUsMLj",
-	"zV/cMfmfUkLrF5t+3F9urprQTaIlXSYgYSJzbQ0eDBUc6+5xouu6Rc5vccNIK8n0Bm8qeK+I/RK9Afot",
-	"cPeignugps73dOmG9m00l32wqFs3z1l9K+wTE4XZrzF+prEU66s1Lcoc3Lr46sHsb/D0y2fZwdPDv82+",
-	"PHh+kMKz5y8ODuiLZ/TwxdNDePLl82cHcDj/4sXsSfbk2ZPZsyfPvnj+In367HD27IsXf3vg35KyiDbv",
-	"NP0H1plLjt+eJucG2YYmtGTfw8ZWljJi7GtW0RRXovEr8tGR/+n/+hU2SUURPH/rfh25VJTRUutSHU2n",
-	"q9VqEnaZLtDPSrSo0uXUj9OvfPv2tD4mt+nNyFF7AmpEAZnqROEYv717dXZOjt+eThqBGR2NDiYHk0Ms",
-	"DVkCpyUbHY2e4k+4epbI96kTttHRx+vxaLoEmuul+6MALVnqP6kVXSxATlzxLvPT1ZOpP2WbfnQ+5vW2",
-	"b+2cahcaCDoEVV6mH1uOehbCxRoo048+3zz4ZOv/Tz+irzX4exuNj3rNsuupr/Lqerg62tOPTWH7a7s6",
-	"coidv9h0BhrUwR8bXxjf+1H2V7MgfBYlU+13EGrunmaGq6bXy7rIf/is+ft/0UeAP3TeRHtycPAv9rrT",
-	"sxvOeKs924pSRyrrfU0z4jN8cOzDTzf2Kcc79UahEauwr8ej559y9qfciDzNCbYMct/7rP+JX3Kx4r6l",
-	"2V2roqBy45exaikF/3QH6nC6UOjdSHZFNYw+oPscO+IaUC74jNaNlQu+DfZv5fKplMvn8Wjakxsu8M9/",
-	"xv9Wp5+bOj2z6m5/depMOZtEOrXFwhsLz9en6RdtaVuzQzrZuTrkIZ7mclg9cudPFmykAFCd9CcyGxPx",
-	"xWT9hYngnKats985oK1aU9/DRu1S4OdLIL868AnLfsXLbpgCMiZCkl9pnge/YVFQb7ZP4vq+KQqz81Xk",
-	"ZoHG0JoD+Kt3mFnv3lgxG9kl+PJBlgat04h+ZmVTenwOgy/j2wrNoQZzInh4cHAQS8nu4uziNxZjjNOv",
-	"RJLDFeR9Vg8h0akitO0d6cGXtvrFn0K/OyJ1+M7TDJp6UIPParcrGt0EuxPBH2iyosydmgWRdfv0WsG0",
-	"f3Hepmq7i0H1HhF/pTwxIGO4NLeR77p5f35vplxvUXZqWelMrPiw4sJaCjR3lxHxemAdbtCCeAC1ppoQ",
-	"/4RwvvFv4BOKSeOi0k08yHT25yudJ7Xq0rULxnEAXOU4ir11S4MzbvfUVV8JnjnM3tiXwTp6L/pCt8Ux",
-	"vu5ji/6ustQ3NLbyyheSbP09NSJvzFX78mGCFOqHNDTQfOrShTu/2qS+4Mf280+RX6d1IYvox26gJvbV",
-	"xVF8oyZCGkYckVN1rPH9B0NwvBvomNgE0I6mUzz9XQqlpyOjcNrBtfDjh5rGHz3nPa2vP1z/TwAAAP//",
-	"+Zih2CWOAAA=",
+	"H4sIAAAAAAAC/+x9f3PcNrLgV0HNe1X5cUNJ/pHsxlVb75Q4yerWybpsZ9/ds3NZDNkzgxUH4AKgpFmf",
+	"vvtVNwASJEEOR9I6m3r+J7GGQKPRaDS6G43u94tc7SolQVqzePZ+UXHNd2BB0188z1UtbSYK/KsAk2tR",
+	"WaHk4ln4xozVQm4Wy4XAXytut4vlQvIdLJ7F/ZcLDX+vhYZi8czqGpYLk29hxxGw3VfYuoF0k21U5kGc",
+	"OxAXzxe3Ex94UWgwZojln2W5Z0LmZV0As5pLw3P8ZNi1sFtmt8Iw35kJyZQEptbMbjuN2VpAWZiTMMm/",
+	"16D30Sz94ONTum1RzLQqYYjnN2q3EhICVtAg1SwIs4oVsKZGW24ZjoC4hoZWMQNc51u2VvoAqg6JGF+Q",
+	"9W7x7O3CgCxA02rlIK7on2sN8A/ILNcbsIufl6nJrS3ozIpdYmoXnvoaTF1aw6gtzXEjrkAy7HXCfqiN",
+	"ZStgXLJX333Dnjx58hVOZMethcIz2eis2tHjObnui2eLglsIn4e8xsuN0lwWWdP+1Xff0Piv/QTntuLG",
+	"QHqznOMXdvF8bAKhY4KFhLSwoXXocD/2SGyK9ucVrJWGmWviGj/oosTj/6qrknObbyslpE2sC6OvzH1O",
+	"yrCo+5QMaxDotK+QUhqBvj3Lvvr5/aPlo7Pbf3t7nv2X//OLJ7czp/9NA/cABZIN81prkPk+22jgtFu2",
+	"XA7p8crzg9mquizYll/R4vMdiXrfl2FfJzqveFkjn4hcq/Nyowzjno0KWPO6tCwMzGpZgjEEzXM7E4ZV",
+	"Wl2JAoolE5Jdb0W+ZTk3DgS1Y9eiLJEHawPFGK+lZzexmW5jkiBed6IHTehflxjtvA5QAm5IGmR5qQxk",
+	"Vh04nsKJw2XB4gOlPavMcYcVe7MFRoPjB3fYEu0k8nRZ7pmldS0YN4yzcDQtmVizvarZNS1OKS6pv58N",
+	"Um3HkGi0OJ1zFDfvGPkGxEgQb6VUCVwS8cK+G5JMrsWm1mDY9Rbs1p95GkylpAGmVn+D3OKy/6/Xf/6R",
+	"Kc1+AGP4Bl7y/JKBzFUxvsZ+0NQJ/jejcMF3ZlPx/DJ9XJdiJxIo/8BvxK7eMVnvVqBxvcL5YBXTYGst",
+	"xxByEA/w2Y7fDAd9o2uZ0+K2w3YUNWQlYaqS70/YxZrt+M0fzpYeHcN4WbIKZCHkhtkbOaqk4diH0cu0",
+	"qmUxQ4exuGDRqWkqyMVaQMEaKBOY+GEO4SPkcfi0mlWEjpAH0BFyHjoSbhI8g1sXv7CKbyBimRP2k5dc",
+	"9NWqS5CNgGOrPX2qNFwJVZum0wiONPS0ei2VhazSsBYJHnvtyYHSw7Xx4nXnFZxcScuFhIIJ6ZBWFpwk",
+	"GsUpGnDamBke0Stu4Muni9tDX2eu/lr1V31yxWetNjXK3JZMnIv41W/YtNrU6T/D+IvHNmKTuZ8HCyk2",
+	"b/AoWYuSjpm/4foFMtSGhECHEOHgMWIjua01PHsnP8e/WMZeWy4Lrgv8Zed++qEurXgtNvhT6X56oTYi",
+	"fy02I8RscE1aU9Rt5/6H8NLi2N4kjYYXSl3WVTyhvGOVrvbs4vnYIjuYxzLmeWPKxlbFm5tgaRzbw940",
+	"CzmC5CjtKo4NL2GvAbHl+Zr+d7MmfuJr/Q/8X1WVKZoiA/uDlpwC3lnwyv+GP+GWB2cTIBSRcyTqKR2f",
+	"z95HCP27hvXi2eLfTltPyan7ak49XBzxdrk4L3bCGKHkdwB3GqrSqgJthUMargQtdLYGyCrQ2WpvU3sC",
+	"9cItyIHXolKqZMKwdV2WS1J7tsDWAKwCzRAW4532EqAwXoMCJwqVLpzGRLgQgFJdg0Fxv8fd1hy9EZyN",
+	"VnV1slgOtvZyMT0TPEt2QpIO0sEzSI9wbvRnufTifKPVtWmJgZ/YVqE2uXMHNSrcbFWq/JJdK223fWUj",
+	"jTUekWs4gHDHXwTAPpXKNjP4rJ2CF9bvpL2RSNkrXgo0hcMHbyRYJsFeK32Jh6ZVuSrTuOEcs5xXPBd2",
+	"P4LhQK2LpxxOvD5NJ4Yz4h8jxLj/ELfxWfG2yzDtSvTnHSPWigOnYjtxMMR1DWC6y8LD/g2bZYgw7vJ2",
+	"C/vtbx5gs3tXqUnT1dtNYIJ3MjQn8W9hZxJaUUMHrjXfL7wKl5EqNhzmJ+OpUPGNkIT00m2lHb/ELc6l",
+	"IisGSUYSwClzzrwioK330muE3uQ6Gbg4xnUbp1lw63e0N5nofL8GDQyFcG2hiGCO8U5D0jDYXM4IHZmq",
+	"LMlBq8hUb5ezzwgPf7C0PVNYRp+ZkO4wpqZL5wL8mpdc5vAQjLnyoPDfDadNof6DkIKQ+KMqi4+M+Oxt",
+	"S8I7M+LWkbJxGJ00K/3wnIdQkzyHH/rc9jWepQ/BZQhnuAgEnm2BoxpScMtPFn3SpdVS6vhH6odo5qAT",
+	"tuuf6R+8ZPgZ1Ws8hh1Y9EkJw4RhKrpBKlrdwo2EDZAqVrGd894w9LocheU37eBDzsEGs9jlW+cw8sqN",
+	"nwROvXUHn6+Uvhu/9BhBstbJzThCbdxaOPPuylLTuso8fRKOMtegB6i9VxzaSzGF+uBTtOpQ4bXl/wQq",
+	"GMsj5O9BhS6gh6aC2lU8t9/AHSnQm1NyU5HG6ZyPzouJcstrLbkbP95vSKrW8+G0vIGIxvZjrjAcjz41",
+	"gzjgzV5w0iOtzubopNU7KGZD98YDnQnOtBiZWKw8zjgjoikO0fICbO6xkcIGne89gniGEOVDGKtbbrZD",
+	"8qEr68lj9vqP5188evzL4y++RDwqrTaa78gwMuxT70Fgxu5L+Cx5QtO5m4b+5dPgK+/CPbhlCOEG9izS",
+	"Ah4VjmLM3Qwhds/BclFC8aMq4LXltn4IrWvq0pKWuPmOVi2dQyvAnZbzerO1DP1Gii5IuNwn1Z4gMirw",
+	"wR5TSoGXTi8BNE7RxADSN8xvxI7cVtI6XBE3FG+KrbmmCy7JpTKQK1mYE/ZfoFV7uEpVAE5Jqk7v9B4W",
+	"ckW+xmYiU2YpNWK5khJy1O3J0KORCkiDL7mxWa52O2HttJzAll5YrMvabB34CrQRBnmAGas034C77yrE",
+	"eg0aZO5v1Wgc11s4oVJCsQHN3Nis5JsJBOehxYsizDmAT8OUymYaeLHH/3pW7UL+z+1+sFTUw93t6SsI",
+	"+rlJsp+q7dHL1qwUE901TE/C+6ay2BXxz3JboCDhxT5Fp/buL6A+Qqcl4/itUtq2rq5T1xhkQbs9Gru5",
+	"hFwucAtmRsgcskluoD2JzZjtMsY1NynmGOzT5Mwj+mQH/EPkiuLlw/uJommPbNkxKvUEWVjIvlwZcGxf",
+	"go7w2xh55hw44WRxjGPobFl6WwQlorDG7YzlgGR0OR92OB5Seq9r+RBOaa2VTjqbgp8yuwJtREpmvPQt",
+	"mG8RBF3V/91hS1yJY9PtcC2LEQMer31neycc6Dc3sj3Au86JHmO5+SZm58edtY4d4ofLRlw7naEXuIBV",
+	"vYkta7bWasc4K6gjLeC3f6/FlXKL9BAKBsTwZlMvxuJbdLrIHA6TsDPUXCUWPPigeBdgnbjvgFuynaIr",
+	"wBykZWuhjbN6vwP41lix4/ZBbmI8qJHjA939TZOluzRxElzIHA9Q3KytyCO50/XcTtE8mkrKlXbo+O9Y",
+	"LyViaL0dYLcR2hRvgwp1wdL2yr/yNUif4ZrlWnYPhjCDWTzYm0gDlPjrR4fV1/whZGohTKPNzNOHmuZe",
+	"M/LXX8qyGFbQIVZ8jgWawGLuXlW1zdXOG5nY1Q3ZodPD+KKPkFXt0AclFMGdO9kwv0B1DAqUn1gGNxVx",
+	"9t67Z/34aDM9xNwbvjhm8jj4wdkft9Y91nNTvZ/p2x2kBdaelMjF8fnIV6q2jMeKUdrlNmVCewEzy5Qe",
+	"taCpY8ZzR86MJOvB7etaueFcTGnp1PwVgGRq5WORVpGJxSmE0TZOLqd7pp1aLV6VVjkYA0U2fb/Yohba",
+	"ORXETtCJECeEm1G8eX9HZK2yvDyAKLVJodt46IUcwXre8FML2B88XkY8PpvDzSryE5VgYYyEM2lyBZp8",
+	"mf/U9QuD3HX5Rp1A3m1EdmfXkkwCIwmKFhSOLnJR0WbPLmGfftkjZHRFRk6UTkeGHZmSkd/geqsMrtNG",
+	"GEs62iXsveB2EAL3hODdYqC3zVXbXsao/An23+Io+1EN7pC0wkbxEhpcuEhApATUlGr4ghvrrjiFLMhF",
+	"bPpOARxi3OM0auUh5L8EA28IO1fSgDS1aaw9U1fO55GaA90Uj471I9w0Y6l1BLsxKa1itYFDkMeoFMF/",
+	"Fbvm9PByGMElJkcR+3j87ZOk7CDREmIKkdehVUTdOMJ8BBFhWkI7xvGbIulRMlZVFYodm9Wy6TdGpteu",
+	"9bn9qW07ZC5u2+OsUOB8hr69x/zabzJyX2y5YR6PcPVPLn4XbngvL9hrbBVvgQOyaeS6zXuMhs6n1knQ",
+	"4d8k040ywYFVGJvwyN1fXx49eOBAf4BkDAErgk8r+uDthrg/c/GjfZh30y/vJK8TanNiOqUwdE4ODx5C",
+	"33kEI1PyIRTkBFTc3VwyQjSEO6MeEjeBG57bco+nu93C3kWxmHrlvKTDK2urqgOu8/PJEX0QQjJEbTIq",
+	"4jWBiqaXOjSdtnbAtd/T11K+5pn+5QExkhjMsaHOWaVw1YV/2BRevwRO6iDpdbdyH9BF4fmJGXrI2f9R",
+	"Ncu5DGFJzYmgNIlZOn5xBGGiMYVT8FoKQQk7cOo0ffn88/7EP//cr7kwbA3X4TXg558PyfH552QcvlTG",
+	"djbXQxjDXNuLhGzvBxz0ZcrJwWthD3nOSr7sAQ+D0p6iONIw/YiXv9eqrh7CH+CD0WbLtz4OM/3fYZg5",
+	"BPlz6wbq0gF/AY6KUj9MfBk4zUWa+57+RuwkQb+HIJ29mcM7Ma4YLHCYdwjusb7FFN+4iWul1g8wW1Hc",
+	"pJ4QFXCTmqlfDjJtP0E7cE/hhsn7VUQw8YoQ9GVJlzpq3RNobAcoacxWVKm4n/a19P/99D+e4Stpnv3j",
+	"LPvqf5z+/P7p7WefD358fPuHP/y/7k9Pbv/w2X/8e0rfN1as0lEqf+SGXgL4g+dGXkgXeIi+aDKO9175",
+	"VOsPjXePxXAxA+WjKc0SV6kFEc2DCOI5UodRQUbb+kGklLOZ5wqpzviHZZMDPtdzacO8erchDszYTRKa",
+	"W+X+AdQ2B4hpqDQYOmRj74xxX9U6fj/uN6PZGwu7oYPTdf1lKoA5uXEVuU6ynZKwH3Os/EAfU73dQT/S",
+	"mVSusb59K6qDfw+t7jhzlvi+9KXVjiTzy+Y1+wMsfh9uz7cdv5wn3xyUFeMsLwVIZ8xbXef2neRkJPdC",
+	"C3tsEUz/cbfJN6FJ2k+TcKN4UO8kp93TmM7JC/nkJeF3AMF7YurNBoztmQtrgHfStxKS1VJYGmuH65W5",
+	"BQsXiSeu5Y7v2RpfgFvF/gFasVVtuwo0PfA1Fp0wztGOwzC1fie5ZSVwY9kPAsMBENzdXmBtQIIRJkuf",
+	"Ld+7r3TE+Olv/XGD//adgwj+0GdiwF0Uo5hfPPfG5cVzsiBaF/sA9w/mgPzN3EQP96LbHT2u6SzE3Dtr",
+	"/+IWA60ponyxEXZbr05ytTsNRvXpRjUG9mnBYackfStOeSVOTQX56dWjAxrqPeQVS4irrpB9CB3jX+kx",
+	"0eFYw12IZ42bLttBdPBINq7lffPgqHXVzHNpDRxFDbUPqVZHuzXiNWVrmkN8fSnQ3vCxaP7QMQ/ugfSA",
+	"U+j1x2wc+eFvq9gn33/7hp36jWo+IZp40NEb8oQbzH3oXlAj77tUWi4Xwzv5Tj6HtZACvz97J/E10+mK",
+	"G5Gb09qA9k/2TjaKPWMe5HNu+Ts5OOFHs91FL0NZVa9KkaMrMsWnLoPREMK7d29RPrx79/PgtnOoN/mh",
+	"kiLaDZAhW6vaZiE2UsM110UCddOk6CDI1HtyVLdlVO18Wx4+8/DTJzWvKpOVKudlZiy3kJ5+VZU4/YgN",
+	"DaNO9ACNgrjDGShMwIbW90fl73s1vw75fWoDhv11x6u3QtqfWfauPjt7Auy8ql4gTAx0gL/6owZ5cl/B",
+	"7P0dvQJtgaWcpTRxp0/DjdU8w2QtJjl9C7yi1Sc9bUeu+bJk1C2mSfPagkC1Ewj0GF8Ah8fRz2Bpcq9d",
+	"r5BrLz0F+kRLSG3wcGpvvO66XtGD1jsv14FHsby22wz3dnJWBlk8rEyTgmuDR3K4hkQfPG4Cn61sBSzf",
+	"Qn4JBSVOgl1l98tOd7XuKDhBdAjjEoy5N5CUBYd8y5h4rCq4VwG53PfTkRiwNuRgeQWXsH+j2iQ6x+Qf",
+	"wcgCl/IrQ54Z26jEqZEugswab1sPo7/4UQg1ryq2KdXK7+6GLZ41fBH6jG9kpyA9wCZOMUVDhgl+r7hO",
+	"EII6jJHgDhNFePdi/dT0Og76mdlWOn73+KHH6OGSPE7oWUnn1BgI9ZGHHtg4w1jZ5HIAfsH1wD3Uj6UJ",
+	"I7lrGpdLgFGSWs+4q5J0kTashXY21527DLmZQi3NJaBle6oHNLoUidWHLTch0V4RvzmYddAeDEdGLgr6",
+	"LZn7reZE72NKuOJj9B/PDnURxUNESQeb3E9BsPU3w7LJA+by/4YcUSExVMgGtVgeldlpufCRianlUJK0",
+	"jAJK2LiJu8a9BB+fmGiBEI8/r9cU95SlQiu4MSoXtN8jWe7HAFRCP2fMB07NhpBi4whtun4kwOxHFe9N",
+	"uTkGSQmC7DAeYNPFZfQ3HL5/aRMxe/X2oBo6lB3tJlq2idLcMg7NoOUiKZLGLIROKx8AsYKBRZ1iUSZk",
+	"wi03dP4ZKME/NerH0KW1CiA2fB26RWYD+9S9Hv0suoWOIueag6DJNfdhXVdXykJGbvqMPDbJ6WGj7wwp",
+	"g99h07T46ZCKuUyuokhLHxr2EvZZIco6vdp+3D89x2F/bOwnU68oIFFIdxe6oszDydiRiaFdeNHkhF+4",
+	"Cb/gDzbfebyETXFgrZTtjfEb4aqePJnaTAkGTDHHcNVGSTohXsj2eQ6lTeWhiGwysmpRYFo+FA2R12Cw",
+	"mYoAe0r9irAYl7wOUnIuLaLTsyBfEUUA0gPHRjAOZjSyB3hVieKmZ8M7qCMX2TjEMYq60/gTl7OLBtgB",
+	"CkT2eiqaUkPwObgljc5Ml4K5l41qDmUooVDbKRYI8VDChAICQ0Iha1OW64PBJsDLP8H+L9iWprO4XS7u",
+	"Z/KnaO0hHqD1y2Z5k3SmqwxnAnY8eEeSnFfoOuZl5h0jY6yp1ZVnTWoe/CgfWNSlze83356/eOnRR9uz",
+	"BK6dq2xyVtSu+s3MSgO3Sk8m2nPaarCdnSIWLX6THCp2plxvwSeDjnQ5lGKeudz2ah1lLbzgXFmnb1QP",
+	"ukq8T89NccK3B1Xj2mstYurc8+bxKy7KYIoGbEduP2lyrT/1aKkQA7i3VzBy7mYPKm4Guzu9O1ruOiCT",
+	"4rEm0lX71J3+FUsnUpPC6dDCJVbFm/AVeOf0UDjJekcpNDNTijzttpArg8whnc8XGzNqPKKMIsRajFwh",
+	"yFpEsLCZmXFZ2kMyGiNJTHIpTdBupfyNWi3F32tgogBJiaE07creRsV9GbLrDY9T1B2GY3nA1CcCfx8d",
+	"A0GNaReExLSCEXuYB+g+bwzOMNHGNY4/RI7BIy6q4hEHR+LEJZPnD8/NLthj2/UUx5VvhvIPGcNlST9c",
+	"die4LXwaxZExkmV0Rk+L8/GTAnsfcUa0RwKhGx8GS2JVXhqVAFPLay5dVQzs52joextwPgPsda00vR0z",
+	"kLyyFiZba/UPSFuya1yoRDC5JyWpi9Q7lZmmL0Qbr0xb7yjQN8ZjlLXHNLnoI+teJI7scOLyyHVOEQHB",
+	"wcWlY2tXwaMTvZDeHFELc+rgt5vD4zyI0ir59Yrnl2mFCnE6by9pOq44q1joHFbBew1b3ovue5q2PklT",
+	"BboNIxi+ab6jcvTbYvkCckxDlNaSCqJ+91VtITbClUGpDUR1NjwgVz/KcZGvVeKuwVrSXKzZ2TKq5ONX",
+	"oxBXwohVCdTi0dLnazB0ajXu1qYLTg+k3Rpq/nhG820tCw2F3RpHWKNYo8CSKdf4vldgrwEkO6N2j75i",
+	"n5LX34gr+Ayp6HWRxbNHX1FUkvvjLHXY+XpHU3KlIMESsmOl+ZiuPRwMPKQ81HQKLFekblyETewm13XO",
+	"XqKWXuod3ks7LvkG0re5uwM4ub60mu4Vc5cusnAVlozVas+ETY8PlqN8GolMRPHn0PChRTvcQFYxo3bI",
+	"T20RDTdoAOfS17lzuMErfKQrlipkEesZzB/WQezO8tSs6SLsR76DLlkp/xrFCYs2+YIXiCfsIiQYoITA",
+	"TapCRxscyyWl21UKl5DSXAppyYiq7Tr7Pcu3XPPcUs6MEXSz1ZdPE0mQu2ku5XGIf3C6a6C0dknS6xG2",
+	"D9qE74uxmjLbCRT1n7WRwNGuTA1MV5vJYW2Q6P2YpmnQcxVQhJKNslvdYTceSep7MZ6cAHhPVmzmcxQ/",
+	"Hj2zD86ZtU6zB69xhX569cJrGVQsZJhupt3uXuPQYLWAKyhGFwlh3nMtdDlrFe6D/a97y9JaAI1aFvZy",
+	"yhD4uhZl8Zf2ZUMvQ5TmMt8m7zhW2PGXtqJVM2W3j5PZTbZcSiiT4NyZ+Us4WxOn/9/U3HF2Qs5s209c",
+	"5abbm1yLeBfNgFQYEMkrbIkDxFTthno3wWEYNs5onDanRMtlw5T3Iet6nDZ4+PROXcc5ZigjqsiBaS4v",
+	"vQUHoA0Tlq3B5lswIesO5rcZOrpWYGyGnUeiT7i8bN4GE2DcA4V77rWk2kbaJYBC9j9h5yuyKsJ+1uDL",
+	"UobcV8kaR1yUlMRmfmLbAnaKrBoyLNYuQYLSTEi6M3RTZoW6lqXiY0E3R4x3KdV1U+SmR/o0cCRcRi92",
+	"Dw5RCGOFzO1gDZF0BIKOgvFxoMhCStxZibSaBLrRPbdPQ4cZRB3IGf7HkMe1u4DduQ8xTMmokPCTmqSq",
+	"ytIHF09sqZ6d0j7ZZ5vpl33vKjFvgXWC68mKE7u6dGkDXJJod7lQV8gfS4Zw8NaDuVFdH1cx0yUb3ZAR",
+	"09294/WI5oX4uQ5j4cfz4UzHQ7o3m5R5xVi+q1IPi7DFm9CAid59Bpk3MXVO2HNnWZom+zGBYE0aftYM",
+	"53UbkoX4D2t5vm1yUM8Q9fOz5AZpbKLilf7feSOB3XmDePtEuS5P7pLRq5ZrYVwBXriC7lumgEYQhuFt",
+	"U3d6upbSccrxlXPuQPaAHMFtrjySmPUIf6TCblStjylp5Pbza+qVYspBBuJB1Ur3rrwpLhMKq+dcKily",
+	"SgUSlfxtUPbFfOfcB87ImjJeH4t2aGJzJfMeN2FxnoqjmZCXiw7hhhcS0VdcVMcd7k9LVWPR0bgBa7xk",
+	"w1BUX4DB+wmFNKCbIl2dp59Kd+5YSUImr+2z5nrnSDai0PYRw+87/PajdwvgFmSXwuXh9WRzDC2cJ49q",
+	"jVrUMoRlGwXGz6ebnMG8xT4nlKCggJufT0JtUoLhrihx2u4+fgjqPNzO+9twbPsNtmV0Hdn+3Amjd4Oe",
+	"V5UfNCUJTLPCqezcowRO3LJm4ZorIm4DP4Y2wW6TYTV0niKjwRVdykNF5/CAMZpE5706S+g0dRxFLXyJ",
+	"jeTrVyETaLwQEtrKuYkDIk8eCbQwtF9H+plcoyI3Px8M8JJu4lMCzVh/NXFfUL0FJpLQHMMY48vY5mgf",
+	"ERxNg9Zg4XLfFOxF7o6UiW+oUrgn5DDjOmlVXokqKGC5l4M9JThQcIeaS90D4GApxqa71TyHTt8ZJ9HY",
+	"Q69cpfTNb28gp3BEht/99mY4eixdklxVCMONgd2qTMR8Pm8+RtV3cInR04P/P646pY8EOToWMYR9UMej",
+	"FdYupIG6icyU4ZODuy1z2/9B17lUmy4iH9aRNrnHY5ZJ7e5kDYHhlS6ruCAbD8NlTcgv512IBg/WQdy0",
+	"u1a8Vk1tHRucBUK23bS7ZaxAC1X4fQ/VyfxXtm+GL+iCH9vllx0iFrBXduvmM3It6UocJJ6xS3HTGiGU",
+	"NMsnq1wGFPBrlF/Tg6Jf4ooJY74DJEZ6qu5bGKaPfde0qZRJXqu6gkcbMtIDvteqTaDcUvCKHCE+AnWU",
+	"vQ/uu7kFESamg1yRhoBfZgCgT8cVyOvwfJQUINdQuJyBTexS86qpz4ShGDaehcQLOfcpnveziu1dg9hs",
+	"R7IQu2+dufe3QNhqfk8d0viawPFgVXhW9PRv0InZK9opgcpJQaO10nGSgUH2SqfBNTkAiNwqFAUl70zz",
+	"erVXsYFbnvb6tvUdp73e45Ual6RjjkT7v2qzG3GnxrpL/LGY/3z0iQq3/v2Z5Wy8SuFy4arppSC4gEH6",
+	"7rBIX2CMBQm6GEH8POg9zwAbmLMEe5KgIfp0iNCfQmg77UHnSmxVgyFl/SOY4bOkOeHx7QL3J+GflhCQ",
+	"1EziYjCjtWhG6vsLg6nOm7p3sl/jfxXlGAnJ0Hmybk2XFIeL+ofaKUUHPTrGouxOE29JDzqCXauAdZuV",
+	"e2L+q15KleMq0Y/mnlsuBgWwE8qNEbuqdNEVV23+8rjXkrIthdf0voS0D0/rX5u3ZaGPU2J6YJorwpNj",
+	"U4Y8YDTnBwxHHD4XnY5BjCrKJJbUwq5Smus9W3EZlcJpVEXNtsocu0YrLlFz3JLh5iA8o4TpvldyqXxF",
+	"g/vpkTgLD+iogzwMPkFBKkuTUvkBdCgwk76VOEInR2An7DulWajcF4Fu3G/UVEPJ96G/cyyu6fptupt/",
+	"1diGRUJY8StE2dDL+YkKrE21Jcrhfr/VanGkSy/ssiqF2cLIu85CaBhRDuKSlT2wFPjKo9JSNF+lOwUF",
+	"TqJ38p6EUfnE5EP5VPD2RQioN31McG24YaVC0Ud3w8byPdUKlGM1Yo1F+TnirH1D5YVcExfP0swt1ONM",
+	"rmBFL8dxVpnVYrQUbmN0YCNasn5hzWWbktRYVjmZbq5DGbmWnf2FtFjTJTS2w2Vp2rrspeXY5SpdM2fb",
+	"pJPozRa8lAmZKrAxg6iwdmdjDk0wSh5u9T7b1GPvD5s27PufLp7PpLLVfL0W+QhE95HBDcY8bMJrvQCZ",
+	"7PymNr/lm45ZObOC1huPQMLOHL3Fe0NXVxvlt0t0yMeXWolUgqOJf/Gcap/ZNtu3xWEoUVraHRDGb+5A",
+	"Yn+80WZkRshNCX1C92Iz0FLIQlKRg1f72LpJQdKWM6J9MG6pZAaknQfb+HjXaah+SvMRDx2Owr0ZZQ76",
+	"zQjzZmD5Jg2xt1rTDIhQlv017BA9Rav+zFJ8OKi8MW31Dr1rbcIMaA6gmXrDeXR2IyiUphuQvuZs9yH0",
+	"7OeY6zXuzasD6Sn+cwsySn2w7KgW6yhbhWie91FWruNV6hahkt8Rn5I/HDpjh8Ml7D8xrMMNyYoNy2B8",
+	"3yVXFFGA/I5ZcCiNRTD4qC9hGs4gKoQQfdcd2vzpU9XjK57bLEeGXFM+nDuOGViTceaBsgho6xOcQOZK",
+	"3Xl07HqUKUCH1lhui5ECadPPFlWn6tuwnttQOAgTAmCVN2eONCu6Q95BHmiQcM3LjDTMsoRiXOVOSTfa",
+	"rqzChejlEnFPfDzQTqG79BuZgAjukaIux+t5uVw5boyOu7zpSZg5umuHKgEPXoTBLBKG0MDHk2nYcSGT",
+	"HpMf+56eEtaWrWCt/OOySR6YMGNnpNUZSaRzmO9G0+qMO8EHrJIgzhDl5PYaT/6b2GKWi9I0ZVVDkrf4",
+	"6SOGmPQ9atfcNCZHGy0X0sWBCb+FhFdulFJcQlxFj2ITMedWaJG8bA/3+NnIY+x+ehNqxkQa6XUzsmhf",
+	"Mg4zfAyZxb1czUuFCm825hLrqmuNW+0T455IUFgT5XQmvNagfdFQbImwIbMq4eAZ4DFFCkPvQO5EBDNa",
+	"8sUhN5pm8FWbR5H8upzSCnL//COeIHPs7D3Pk/kWDxH7G/c9pLQIWdt7NRIScAO/Hq6eHt6wCjMgYsz1",
+	"a+bV1MOpMu4S5SCkBJ2FWMN+6kMJOkaOksQVde4043hjQIgG+afkEe/m4CjSt1jv3r0tKZftiyjx0CXs",
+	"T90NjDMzTbOUMfauNLObQ5Qmr7faDxoAkr79KjduApsHwfPXjN9YLiqlymwk4O1imMGxvwcuBSYZZnh2",
+	"qHWrhCTq1LFPKd6iiWi+3u5DMeKqAgnFZyeMnUv33jYEN3frQ/QGx8rrE+Pf0KhF7ZKq+hvfk3cy/XCR",
+	"jFZ9T/kWwExLNQOyuPdQDsj0QPZGjr2FuU5UbRy+45kdbtx/TtEylcMiraVoodqKTyMFPUowVvnCTXhA",
+	"bTRQnUIfm7IkBz4auA1hbJxzl9IqsR4op8bhKYwspMGFk3MNjK+CDO+lbABtM7vVYPC2KY1rNwrHYFpl",
+	"9vdaaZcJByFMRtAcDMOZjryZg1PjlWrdDKE7q/gew6yb2phuSHZBD0qpwE7zsjE05VoTtEgVp2FAOsW4",
+	"XYD0NlDre9IUIUyH8XBt5wCOMR4ndp/J22gVHCbF4t2aZinTz9ON0OlzuFdAkgzp3Bjj8ZkJ4lUI0/cg",
+	"2WpNs/zd527CskIU8pOmOYzbb0WtR/I6NiiQD5RIHGxENzOrur9SjRUlIUJGlLG54NJahELQI44OehSV",
+	"XWthYQKnHlmweUCDFiA8vAuvgghqg1gtrSjbYokU56+FtTAZY2emdrfpiKxebcwUo+6O0OE6gvYOIXNH",
+	"ba/E1WXqumt8B44OmaoB2Oy/lsqpnXjHNKqz6DsMMUqQOE6Ad8DLfdmJR3L1FnrvWVTKlXWvuKQokP/I",
+	"uKRhar+506N5kApdGxjOc/YCdGg7Qvs5hG+D6kZusNIZJlZzYuHSaeuxOwXjOYKEwgrDvfbBQuncPD0M",
+	"P25y1dM1hdNu1KniwHxYGfhk7oucN6Rzc+PfC1Jn75n6G12Gxrfm3Ra8KFplelBTO7mCN5kYk+EXz02i",
+	"om7zWzOrua8HBtWFaejUOvxl7BbavZccea7f42182X9ok3WSL7T1Aym9wC8+TcWvUsHwF+e4HYo9h+tR",
+	"rzb6m4EIk5hrZ/BoqCitwoyMCr5bIn8CWYl5rYXdU6bQ4AoVvyQzsH/fXFtugaPC0FaqY2/aanY++0d7",
+	"yVmboPR8r3hJMZFopNOlh8V9wL694buqBC+f/vDJ6nfw5PdPi7Mnj363+v3ZF2c5PP3iq7Mz/tVT/uir",
+	"J4/g8e+/eHoGj9ZffrV6XDx++nj19PHTL7/4Kn/y9NHq6Zdf/e4T3ASIskN0EXI1Lf43BYJm5y8vsjeI",
+	"bEsTXgm8GabKbsjGoWYcz0ncoDOxXDwLP/3PIOmwGGILPvy68KlgFltrK/Ps9PT6+vok7nK6IedqZlWd",
+	"b0/DOMNS/i8vmuf6Lg6CVtS9xEZWOFm0rHBO3159+/oNO395cbKIYkcWZydnJ48QvqpA8kosni2e0E+0",
+	"e7a07qee2RbP3t8uF6db4KXd+j92YLXIwycNvNj7f5trvkGl1RfSw5+uHp+Gl7+n772T+XbqWze/ob8b",
+	"iDoEW+U0fmdiUg1sZAVtUtlbXwjj6/YeXaz5WWubdu1c3tq37qrBPxzfgrMd8UcyzBsztK3REPwL2Gar",
+	"rl3ImbDTdhS1nmtMUJ4Ccjv0VeQZPo3FctEwG5a0X3wP9jxQu9H1l4tGEpjFs7fpopSU228YUG2Vzxtx",
+	"ws7LJlvAJVRhJQh11wSKxnIM7gKlGZ3yLucq7fm/16D37Z7c8ZtFnBp+oO7/jNLYeZtpHzw+Oxs7pZp2",
+	"pyN1zG+Xi6dnjx6s3mT3gUmi6uSFz9+CgsIJtNvl4ouzsw+JgQUtecmoZZSbMWWyubQwviWePvVux/Xe",
+	"8ZVpWdklCpzcoCT5OLrO3y4qLa64hcXPt0EgtPNFMdP+lYkilkRTzU6jBCWhvTFAcstnl72d+HTqA+qj",
+	"7rQ9zel7msDt2O9dYfje3iAwV4+/6eEz6py+p3/Q8XDr6F1CyiHhEr1w1jZf4ibiK6UpvakvGBvyKgoT",
+	"tRyIgHPs5RMvHdr65w4QC5Bog+KJ0+7PzkitWmR1DfG2bZS+TvtW9Xt7ln318/tHy0dnt/+Gqp3/84sn",
+	"tzPjKb5p4LLXjd42s+GICLljKfdzGZHfLVLzhCzhNHYrkUUPw3rXl65BDxBriHEgeVoPfKpUL0m9Dyhz",
+	"vuYFC7mPPkrcO0rcc7f5Y6HA/GKnZetyUSXjukeEC2kTRwuX19jro3D5UMLFqXwPIFy6gB5YuDw+coP/",
+	"9mf8UZz+1sTpayfu5ovToMq5KNsctB1qhnAFHQXU2XSnlO923/7sH3ucrrg0U2rgC7G2pnl6F9yZTnwP",
+	"3xB2BfNPcsVl/LpuhtEXPS1cDh8W4lg/vXoxZrfFMYtjcru/10ZEZRIztlGWlWJtofjX4PqnZ08/5J6X",
+	"WE2VfYcc91vdc46f/TOhFZdjessBT5DnNDDeC2M6VZHbKpKOnZM+kfbhrmPYexzXPac5l/MTDrVYHPY5",
+	"82RCveFSvQkv6LCHJ8uWX1FMi4t6Ltge7MnHk+Pero+GzMfp369gXTvm3cdvWC05x5qXYUkx3wQWuq8h",
+	"ysK5IwthPLjO+1gofLbdXdDshT1h5w7ep0ycwAnjkl28bAb7zDVFFMuyt+OU9OF5yUPn66OPnOmzhrIy",
+	"cPmQR84yhUUgZPBcrbiMH1ePjR+6zUHgoP/yjgKnXXUo5mWY7vOFkxEbqj3TwgrP54KYng66SGAxV1hF",
+	"18DxyfBRqf4NikY8TyeyXIwq1UEhbvj3gAZA7dqbHSqoEPjWqiXjdC3TvPseea9MFzpq3YRNjWkJL0Pa",
+	"74fbtc1Ej315flBROG7z9WTBR63g/lrBgKRz+f70Pf7PX1OM2oT4uGWYAMOqSC1wiSEaRRDf5vqmjG+4",
+	"kEtWyxK3pruXG1OTnzcy/cgzXSQSRFDdl1KYXqYQ02a3TzsEPUke5IQdUZT7J99/Q+sSV/a3b14+jxRg",
+	"3qY/GNt8nReao4fOK1ckgRPzIl8PHnaa1NHRf8t87/NjXkxvb9TEaTGMWZma2X97LezDbsPOAvwJ9r/9",
+	"PTlv+0wZz73L7KIYMLk7G8DYr1Wxn6CQz7HaJVIbnCgk14m07SMulv40mHugG05i1EvpzLP+YZWLBHZG",
+	"zqBvwS2nop1rUcLgrLt9WNWTa3uRCOsfZp6NsUzm3eirng7yHN3zZQ/4MAj4o+j5lbf7F2dPPtzwr32V",
+	"rzc+ZaIo9+wn2ZSLufuleVEkN1y0S1PiB+96c1XABmSo65StVLEP5cA7AC9hv0jqFKfvO38e0uyf0++M",
+	"By1+gPRqzy6eD9V06tYXil/vL54PdfWUgt1D8eHvb14OJkIaN6Fd+El91DM+6hn30/3nbp7Z900hTKZ/",
+	"Di5D3bRURU1uh0PPMQ9+1e36IAs9ND1SpobL5wIFiz6klKKPIuGjSHgAj1xCDuCuDZdrQ6abcBeE3E5Z",
+	"dwuiBdN5NTEWdRcStX2qtEuN9Zl/feDADlE1TcE7VYDPqukR98nOooRAXQnzygNNeSEOuu/+6sFnovgr",
+	"s8qXP1oypdlfeVlGv9H9oG9tRhx4D3ZHtwZ6+FBTAUOQzlSw9KRikHosDskeVhU09WYDxiefH7vbWwN0",
+	"niE0LPjo7OwsdR/Wx9m/3XQY+zo0WQlXUA6XegwJfBRbiLL2l4wTLs8kycqxPGXtW68E112LsmQraJOV",
+	"pTAjqHHGseOwe64wHuGaC3+l3a4XUsxl4Q8ZJNxx64vBN1GAKaSkyhBkCpc25fvDXr/amzm2dDw/fC14",
+	"2Ja2NzMt6ejFbdKOPiHQo1LNbGuLZYTHBdfrCnLBS7bjkm/cS4/miZtVLABoc62yP/uCKeWeYXlBUVDd",
+	"CbED1JEa8YOdQyKfNpMQQmBmq+oSM4hshKQBaJfTKK4gclyEIrqj74Ume8x+dEZeSrfq8Y/HMb3vU5v+",
+	"vrw0DCWdXCvLbR1FF7q/T4NONfiAewEjlX12UyLdMGbRAi9PfQ3N3q+u0l30YyRW07+e8sLzXYYCNN1m",
+	"5csSpr5hUQ1o65mkmtBCjo0/eIyY+upf6Yw0mvGRSBtatA+K4we6xGTN09y3PyOvGNBXgf/a96bPTk8p",
+	"Q9pWGXu6uF3G30zv488Ne7xvLALPJrc/3/7/AQAyYhFSvPcAAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
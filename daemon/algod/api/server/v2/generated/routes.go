@@ -23,24 +23,42 @@ type ServerInterface interface {
 	// Get a list of unconfirmed transactions currently in the transaction pool by address.
 	// (GET /v2/accounts/{address}/transactions/pending)
 	GetPendingTransactionsByAddress(ctx echo.Context, address string, params GetPendingTransactionsByAddressParams) error
+	// Gets the evidence of the detected vote equivocations.
+	// (GET /v2/agreement/equivocations)
+	GetEquivocations(ctx echo.Context) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
 	GetApplicationByID(ctx echo.Context, applicationId uint64) error
+	// Get the accounts opted into an application.
+	// (GET /v2/applications/{application-id}/accounts)
+	GetApplicationAccounts(ctx echo.Context, applicationId uint64, params GetApplicationAccountsParams) error
 	// Get asset information.
 	// (GET /v2/assets/{asset-id})
 	GetAssetByID(ctx echo.Context, assetId uint64) error
+	// Get the accounts holding an asset.
+	// (GET /v2/assets/{asset-id}/balances)
+	GetAssetBalances(ctx echo.Context, assetId uint64, params GetAssetBalancesParams) error
 	// Get the block for the given round.
 	// (GET /v2/blocks/{round})
 	GetBlock(ctx echo.Context, round uint64, params GetBlockParams) error
 	// Get a Merkle proof for a transaction in a block.
 	// (GET /v2/blocks/{round}/transactions/{txid}/proof)
 	GetProof(ctx echo.Context, round uint64, txid string, params GetProofParams) error
+	// Get the compact certificate for the given round.
+	// (GET /v2/compactcert/{round})
+	GetCompactCert(ctx echo.Context, round uint64) error
+	// Subscribe to block and transaction events.
+	// (GET /v2/events)
+	StreamEvents(ctx echo.Context, params StreamEventsParams) error
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
 	// Gets the current node status.
 	// (GET /v2/status)
 	GetStatus(ctx echo.Context) error
+	// Gets the detailed node status.
+	// (GET /v2/status/detailed)
+	GetDetailedStatus(ctx echo.Context) error
 	// Gets the node status after waiting for the given round.
 	// (GET /v2/status/wait-for-block-after/{round})
 	WaitForBlock(ctx echo.Context, round uint64) error
@@ -50,9 +68,21 @@ type ServerInterface interface {
 	// Provide debugging information for a transaction (or group).
 	// (POST /v2/teal/dryrun)
 	TealDryrun(ctx echo.Context) error
+	// Search for transactions using the node indexer.
+	// (GET /v2/transactions)
+	SearchForTransactions(ctx echo.Context, params SearchForTransactionsParams) error
 	// Broadcasts a raw transaction to the network.
 	// (POST /v2/transactions)
 	RawTransaction(ctx echo.Context) error
+	// Get the minimum fee for admission to the transaction pool.
+	// (GET /v2/transactions/admission-fee)
+	GetAdmissionFee(ctx echo.Context) error
+	// Broadcasts a batch of raw transaction groups to the network.
+	// (POST /v2/transactions/batch)
+	RawTransactionBatch(ctx echo.Context) error
+	// Get transaction fee estimates for target inclusion delays.
+	// (GET /v2/transactions/fee-estimate)
+	GetFeeEstimate(ctx echo.Context) error
 	// Get parameters for constructing a new transaction
 	// (GET /v2/transactions/params)
 	TransactionParams(ctx echo.Context) error
@@ -62,6 +92,12 @@ type ServerInterface interface {
 	// Get a specific pending transaction.
 	// (GET /v2/transactions/pending/{txid})
 	PendingTransactionInformation(ctx echo.Context, txid string, params PendingTransactionInformationParams) error
+	// Get a recently confirmed transaction.
+	// (GET /v2/transactions/{txid})
+	GetConfirmedTransaction(ctx echo.Context, txid string, params GetConfirmedTransactionParams) error
+	// Wait for a transaction to be committed or rejected.
+	// (GET /v2/transactions/{txid}/wait)
+	WaitForTransaction(ctx echo.Context, txid string, params WaitForTransactionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -166,6 +202,29 @@ func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Contex
 	return err
 }
 
+// GetEquivocations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEquivocations(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetEquivocations(ctx)
+	return err
+}
+
 // GetApplicationByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 
@@ -196,6 +255,60 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 	return err
 }
 
+// GetApplicationAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationAccounts(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"limit":  true,
+		"next":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationAccountsParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationAccounts(ctx, applicationId, params)
+	return err
+}
+
 // GetAssetByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 
@@ -226,6 +339,60 @@ func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 	return err
 }
 
+// GetAssetBalances converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetBalances(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"limit":  true,
+		"next":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetBalancesParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetBalances(ctx, assetId, params)
+	return err
+}
+
 // GetBlock converts echo context to params.
 func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 
@@ -320,6 +487,116 @@ func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 	return err
 }
 
+// GetCompactCert converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompactCert(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCompactCert(ctx, round)
+	return err
+}
+
+// StreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamEvents(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":         true,
+		"round":          true,
+		"types":          true,
+		"address":        true,
+		"application-id": true,
+		"asset-id":       true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "types" -------------
+	if paramValue := ctx.QueryParam("types"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", false, false, "types", ctx.QueryParams(), &params.Types)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter types: %s", err))
+	}
+
+	// ------------- Optional query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", false, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "application-id" -------------
+	if paramValue := ctx.QueryParam("application-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", false, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", false, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamEvents(ctx, params)
+	return err
+}
+
 // GetSupply converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 
@@ -366,6 +643,29 @@ func (w *ServerInterfaceWrapper) GetStatus(ctx echo.Context) error {
 	return err
 }
 
+// GetDetailedStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDetailedStatus(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDetailedStatus(ctx)
+	return err
+}
+
 // WaitForBlock converts echo context to params.
 func (w *ServerInterfaceWrapper) WaitForBlock(ctx echo.Context) error {
 
@@ -442,6 +742,141 @@ func (w *ServerInterfaceWrapper) TealDryrun(ctx echo.Context) error {
 	return err
 }
 
+// SearchForTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":      true,
+		"address":     true,
+		"tx-type":     true,
+		"asset-id":    true,
+		"app-id":      true,
+		"note-prefix": true,
+		"min-round":   true,
+		"max-round":   true,
+		"limit":       true,
+		"next":        true,
+		"format":      true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchForTransactionsParams
+	// ------------- Optional query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "tx-type" -------------
+	if paramValue := ctx.QueryParam("tx-type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tx-type", ctx.QueryParams(), &params.TxType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tx-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "app-id" -------------
+	if paramValue := ctx.QueryParam("app-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "app-id", ctx.QueryParams(), &params.AppId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "note-prefix" -------------
+	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchForTransactions(ctx, params)
+	return err
+}
+
 // RawTransaction converts echo context to params.
 func (w *ServerInterfaceWrapper) RawTransaction(ctx echo.Context) error {
 
@@ -465,6 +900,75 @@ func (w *ServerInterfaceWrapper) RawTransaction(ctx echo.Context) error {
 	return err
 }
 
+// GetAdmissionFee converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdmissionFee(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAdmissionFee(ctx)
+	return err
+}
+
+// RawTransactionBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) RawTransactionBatch(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RawTransactionBatch(ctx)
+	return err
+}
+
+// GetFeeEstimate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFeeEstimate(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFeeEstimate(ctx)
+	return err
+}
+
 // TransactionParams converts echo context to params.
 func (w *ServerInterfaceWrapper) TransactionParams(ctx echo.Context) error {
 
@@ -578,6 +1082,103 @@ func (w *ServerInterfaceWrapper) PendingTransactionInformation(ctx echo.Context)
 	return err
 }
 
+// GetConfirmedTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) GetConfirmedTransaction(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameter("simple", false, "txid", ctx.Param("txid"), &txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConfirmedTransactionParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetConfirmedTransaction(ctx, txid, params)
+	return err
+}
+
+// WaitForTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) WaitForTransaction(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"timeout": true,
+		"format":  true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameter("simple", false, "txid", ctx.Param("txid"), &txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WaitForTransactionParams
+	// ------------- Optional query parameter "timeout" -------------
+	if paramValue := ctx.QueryParam("timeout"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "timeout", ctx.QueryParams(), &params.Timeout)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timeout: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WaitForTransaction(ctx, txid, params)
+	return err
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router interface {
 	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -597,191 +1198,319 @@ func RegisterHandlers(router interface {
 
 	router.GET("/v2/accounts/:address", wrapper.AccountInformation, m...)
 	router.GET("/v2/accounts/:address/transactions/pending", wrapper.GetPendingTransactionsByAddress, m...)
+	router.GET("/v2/agreement/equivocations", wrapper.GetEquivocations, m...)
 	router.GET("/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
+	router.GET("/v2/applications/:application-id/accounts", wrapper.GetApplicationAccounts, m...)
 	router.GET("/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
+	router.GET("/v2/assets/:asset-id/balances", wrapper.GetAssetBalances, m...)
 	router.GET("/v2/blocks/:round", wrapper.GetBlock, m...)
 	router.GET("/v2/blocks/:round/transactions/:txid/proof", wrapper.GetProof, m...)
+	router.GET("/v2/compactcert/:round", wrapper.GetCompactCert, m...)
+	router.GET("/v2/events", wrapper.StreamEvents, m...)
 	router.GET("/v2/ledger/supply", wrapper.GetSupply, m...)
 	router.GET("/v2/status", wrapper.GetStatus, m...)
+	router.GET("/v2/status/detailed", wrapper.GetDetailedStatus, m...)
 	router.GET("/v2/status/wait-for-block-after/:round", wrapper.WaitForBlock, m...)
 	router.POST("/v2/teal/compile", wrapper.TealCompile, m...)
 	router.POST("/v2/teal/dryrun", wrapper.TealDryrun, m...)
+	router.GET("/v2/transactions", wrapper.SearchForTransactions, m...)
 	router.POST("/v2/transactions", wrapper.RawTransaction, m...)
+	router.GET("/v2/transactions/admission-fee", wrapper.GetAdmissionFee, m...)
+	router.POST("/v2/transactions/batch", wrapper.RawTransactionBatch, m...)
+	router.GET("/v2/transactions/fee-estimate", wrapper.GetFeeEstimate, m...)
 	router.GET("/v2/transactions/params", wrapper.TransactionParams, m...)
 	router.GET("/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
 	router.GET("/v2/transactions/pending/:txid", wrapper.PendingTransactionInformation, m...)
+	router.GET("/v2/transactions/:txid", wrapper.GetConfirmedTransaction, m...)
+	router.GET("/v2/transactions/:txid/wait", wrapper.WaitForTransaction, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PbuJLoX8HVblUeK0rOa86Jq6b2euLMjO9JMqnYM3vuxrmzENmScEwCPABoS5Pr",
-	"/76FBkCCJCjJj7xm/SmxiEej0Wj0C90fR6koSsGBazXa/zgqqaQFaJD4F01TUXGdsMz8lYFKJSs1E3y0",
-	"778RpSXji9F4xMyvJdXL0XjEaQFNG9N/PJLwz4pJyEb7WlYwHql0CQU1A+t1aVrXI62ShUjcEAd2iKPD",
-	"0eWGDzTLJCjVh/IXnq8J42leZUC0pFzR1HxS5ILpJdFLpojrTBgnggMRc6KXrcZkziDP1MQv8p8VyHWw",
-	"Sjf58JIuGxATKXLow/lCFDPGwUMFNVD1hhAtSAZzbLSkmpgZDKy+oRZEAZXpksyF3AKqBSKEF3hVjPbf",
-	"jxTwDCTuVgrsHP87lwB/QKKpXIAefRjHFjfXIBPNisjSjhz2Jagq14pgW1zjgp0DJ6bXhLyulCYzIJST",
-	"dz++IE+ePHluFlJQrSFzRDa4qmb2cE22+2h/lFEN/nOf1mi+EJLyLKnbv/vxBc5/7Ba4ayuqFMQPy4H5",
-	"Qo4OhxbgO0ZIiHENC9yHFvWbHpFD0fw8g7mQsOOe2Ma3uinh/F90V1Kq02UpGNeRfSH4ldjPUR4WdN/E",
-	"w2oAWu1LgylpBn2/lzz/8PHR+NHe5b+8P0j+0/357Mnljst/UY+7BQPRhmklJfB0nSwkUDwtS8r7+Hjn",
-	"6EEtRZVnZEnPcfNpgaze9SWmr2Wd5zSvDJ2wVIqDfCEUoY6MMpjTKtfET0wqnhs2ZUZz1E6YIqUU5yyD",
-	"bGy478WSpUuSUmWHwHbkguW5ocFKQTZEa/HVbThMlyFKDFzXwgcu6OtFRrOuLZiAFXKDJM2FgkSLLdeT",
-	"v3Eoz0h4oTR3lbraZUVOlkBwcvPBXraIO25oOs/XROO+ZoQqQom/msaEzclaVOQCNydnZ9jfrcZgrSAG",
-	"abg5rXvUHN4h9PWQEUHeTIgcKEfk+XPXRxmfs0UlQZGLJeilu/MkqFJwBUTM/gGpNtv+f45/eUOEJK9B",
-	"KbqAtzQ9I8BTkQ3vsZs0doP/Qwmz4YValDQ9i1/XOStYBOTXdMWKqiC8KmYgzX75+0ELIkFXkg8BZEfc",
-	"QmcFXfUnPZEVT3Fzm2lbgpohJabKnK4n5GhOCrr6fm/swFGE5jkpgWeML4he8UEhzcy9HbxEiopnO8gw",
-	"2mxYcGuqElI2Z5CRepQNkLhptsHD+NXgaSSrABw/yCA49SxbwOGwitCMObrmCynpAgKSmZBfHefCr1qc",
-	"Aa8ZHJmt8VMp4ZyJStWdBmDEqTeL11xoSEoJcxahsWOHDsM9bBvHXgsn4KSCa8o4ZIbzItBCg+VEgzAF",
-	"E25WZvpX9Iwq+O7p0AXefN1x9+eiu+sbd3yn3cZGiT2SkXvRfHUHNi42tfrvoPyFcyu2SOzPvY1kixNz",
-	"lcxZjtfMP8z+eTRUCplACxH+4lFswamuJOyf8ofmL5KQY015RmVmfinsT6+rXLNjtjA/5fanV2LB0mO2",
-	"GEBmDWtUm8Juhf3HjBdnx3oVVRpeCXFWleGC0pZWOluTo8OhTbZjXpUwD2pVNtQqTlZe07hqD72qN3IA",
-	"yEHcldQ0PIO1BAMtTef4z2qO9ETn8g/zT1nmMZwaAnYXLRoFnLHgnfvN/GSOPFidwIzCUmqQOsXrc/9j",
-	"ANC/SpiP9kf/Mm0sJVP7VU3duGbGy/HooBnn9mdqetr1dRSZ5jNh3O4ONh1bnfD24TGjRiFBQbUDww+5",
-	"SM+uBUMpRQlSM7uPMzNO/6Tg8GQJNANJMqrppFGqrJw1QO/Y8Wfsh1oSyMgV9wv+h+bEfDankGovvhnR",
-	"lSkjxInA0JQZic/eI3Ym0wAlUUEKK+QRI5xdCcoXzeSWQdcc9b1Dy4fuaJHdeWnlSoI9/CLM0hut8WAm",
-	"5PXopUMInDS6MKFm1Fr6NStv7yw2rcrE4SciT9sGnYEa82OfrYYY6g4fw1ULC8eafgIsKDPqbWChPdBt",
-	"Y0EUJcvhFs7rkqplfxFGwHnymBz/fPDs0ePfHz/7ztzQpRQLSQsyW2tQ5L67V4jS6xwe9FeGDL7KdXz0",
-	"7556Dao97lYMIcD12LucqBMwnMFijFh7gYHuUK5lxW8BhSClkBGZF0lHi1TkyTlIxUTEfPHWtSCuheFD",
-	"Vu7u/G6hJRdUETM3qmMVz0BOYpg3ehZe6RoKte2isEOfrHiDGzcglZKueztg1xtZnZt3lz1pI99L94qU",
-	"IBO94iSDWbUI7ygyl6IglGTYERniG5HBsaa6UrfABZrBGmDMRoQg0JmoNKGEi8wcaNM4zh8GbJloREHb",
-	"jw5Zjl7a+2cGRjpOabVYamLEShHb2qZjQlO7KQneFWpA9at1dtvKTmftZLkEmq3JDIATMXP6ldP8cJEU",
-	"zTLae1wcd2rAqnWCFlylFCkoBVni3EtbQfPt7C7rDXhCwBHgehaiBJlTeU1gtdA03wIotomBW4sTTint",
-	"Q73b9Js2sDt5uI1UGh3TUoGRXczpzkHDEAp3xMk5SFTOPun++Umuu31VOeA6cTfwCSvM8SWccqEgFTxT",
-	"0cFyqnSy7diaRi0xwawgOCmxk4oDDxgIXlGlrYrOeIYio2U3OA/2wSmGAR68UczIv/nLpD92avgkV5Wq",
-	"bxZVlaWQGrLYGjisNsz1Blb1XGIejF1fX1qQSsG2kYewFIzvkGVXYhFEtbMR1Tas/uLQHG/ugXUUlS0g",
-	"GkRsAuTYtwqwG5qPBwAx+kXdEwmHqQ7l1Dbr8UhpUZbm/Omk4nW/ITQd29YH+tembZ+4qG74eibAzK49",
-	"TA7yC4tZ6zhYUiPb4cikoGfmbkJJzdoS+jCbw5goxlNINlG+OZbHplV4BLYc0gEh2bkmg9k6h6NDv1Gi",
-	"GySCLbswtOABif2ttYCfBHbzW5BaIqMaSqOcoOjm7WrmcgibwIqmOl8blquXsCYXIIGoalYwra1Loy3U",
-	"aFEm4QBRJWrDjE6NtdZjL5Luolcf41DB8vrC6Xhkr9DN8J10LtEWOtzlXQqRT7ZTXw8ZUQh2EYIPSCnM",
-	"rjPnQfNulpwp3QPSXahow6gP8j3VQjOugPxfUZGUchQGKg01dxISjzxeBWYGw0zrOZm9dRsMQQ4FWBkH",
-	"vzx82F34w4duz5kic7jwbmfTsIuOhw9RYn8rlL7xCeiQ5uoowmRQtTQcKxIqZBTIyVY1E8fdSbsMhj46",
-	"9BPiYVKGo9iFSyHmt7Balq1izoYMVrGVup1DgfGeka7WCvQkehGWBsCIvxHkWY7aqJh3KJIUYEhFLVlp",
-	"hmx8I2sNrbiK/3f/3/ffHyT/SZM/9pLn/zb98PHp5YOHvR8fX37//f9v//Tk8vsH//6vMeFBaTaLWy5+",
-	"pmppIHWcY8WPuLU9zoW0Iufa3WRi/rnh7pCY2UyP+WBJuxDd29iGME6o3WykOSOo5OtbuGTsQERCKUEh",
-	"SwgFfGW/inkYVuEoT62VhqKvI9uuvw9ICO/8/dqjUsFzxiEpBId1NJKQcXiNH2O9LVsa6IwXxFDfrvzR",
-	"gr8DVnueXTbzpvjF3Q7Y0Ns6yOMWNr87bsc8EgaUoHoHeUkoSXOGyp/gSssq1aecongZkGvEtOqF5mGF",
-	"44VvEtdwIgqIG+qUU2VwWAudUbPZHCLq5I8AXu9Q1WIBSneEmznAKXetGCcVZxrnKsx+JXbDSpBo35zY",
-	"lgVdkznNUT/6A6Qgs0q3r3v0eytt1BdrqzHTEDE/5VSTHIwq95rxkxUO593LnmY46Ashz2osxHn+Ajgo",
-	"ppI4I/3JfkV+6pa/dLwVgxDtZ89vPvcF4GGPeWUd5EeHThQ+OkR5p7HS9GD/bKp7wXgSJbKTJZCCcQzu",
-	"6dAWuW+kNk9ADxp7j9v1U65X3BDSOc1ZRvX1yKHL4npn0Z6ODtW0NqKjifm1foi50BYiKWl6hh6U0YLp",
-	"ZTWbpKKYehVguhC1OjDNKBSC47dsSks2VSWk0/NHW8SxG/ArEmFXl+OR4zrq1n21buDYgrpz1jYQ/7cW",
-	"5N5PL0/I1O2UumdDNOzQgW89orW5FwItI7dZvA0xtjEqp/yUH8KccWa+75/yjGo6nVHFUjWtFMgfaE55",
-	"CpOFIPvEDXlINT3lPRY/+AoAAygdNGU1y1lKzsKruDmaNrKzP8Lp6XtDIKenH3oW0/7F6aaKnlE7QXLB",
-	"9FJUOnGha4mECyqzCOiqDl3CkW3g6aZZx8SNbSnShca58eOsmpalSnKR0jxRmmqIL78sc7P8gAwVwU7o",
-	"cSdKC+mZoOGMFhrc3zfC2YwlvfBxj5UCRf6roOV7xvUHkpxWe3tPgByU5Ssz5rGB478crzE0uS6hpd/v",
-	"GCvRDBbT7XHhVqCClZY0KekCVHT5GmiJu48XdYFm6Twn2C3ESe1vxKGaBXh8DG+AhePK0SC4uGPby79B",
-	"iC8BP+EWYhvDnRpj4XX3ywz1s8gNkV17u4IxortU6WViznZ0VcqQuN+ZOjR5YXiyt+AqtuDmELgo7hmQ",
-	"dAnpGWQYUApFqdfjVnfvJHA3nGcdTNnAaxv0gdGBaAqZAanKjDoZgPJ1N0xLgdY+Nu0dnMH6RDTBhVeJ",
-	"y7ocj1IbCp0Ymhk6qEipwWVkiDU8tm6M7uY7h5OBlJYlWeRi5k53TRb7NV34PsMH2d6Qt3CIY0RRo2ED",
-	"vZdURhBhiX8ABddYqBnvRqQfW15JpWYpK+36d4tCe9vqYwbZdrlErxMx794aPaYeZWK2cTKjKn6BgPli",
-	"9sOcoa4/zs9krYq4ggnBx3uOcGc5yiK1K9CebCpR6PLLtq+RhkCLUwlI3tzqHow2RkLxYUmVf4CA7zT8",
-	"gdnpoh1yWtROJ0NF3uuE+l4jOTEzbw7ndAj/w1GzR4ErKXiMUcfEesbWPQzjOj7avov0sbM+YNZHyY7G",
-	"V4p4HY9cdENsOwRHKSODHBZ24baxJxQH2j0VbJCB45f5PGccSBLzSlGlRMrsC5KGl7s5wAihDwmxBh6y",
-	"8wgxMg7ARms5DkzeiPBs8sVVgOTA0LxO/dhoZw/+hu3W5uaBqhNvt4qhfd7RHKJxE0But7FvhRqPoixp",
-	"SENotSK2yQx6KlWMRA1r6ttl+tYfBTngdZy0OGtyFrPWGakCkAyPfbdAbSD32dxc8g8Cp4mEBVMaGr3Z",
-	"nFZvCPq8totzoSGZM6l0gip7dHmm0Y8KhcEfTdM4+2mhitgXbiyLcx+c9gzWScbyKr7bbt6/HZpp39T6",
-	"k6pmZ7DGSwZouiQzfJFpbqHW9KbNhqmtZ3bjgl/ZBb+it7be3WjJNDUTSyF0Z45vhKo6/GTTYYoQYIw4",
-	"+rs2iNIN7AV1n0PIdSzwNtDJUKs1DNNGhg9aDXqHKfNjbxK/AiiGOa8dKbqWQNDduAqGnjjKM8J08KCx",
-	"HyU4cAZoWbJs1dHh7agDbjsU4K8gqFuJP+KKGtWDbcFAoK/HAlEkeJuD3dLgzrRPU3m4tslOmDHSV4iQ",
-	"gCGEUzHlEyv0EWVIG1//bsPVCdD8b7D+zbTF5Ywux6ObqfwxXLsRt+D6bb29UTyjLduqgC0L3hVRTstS",
-	"inOaJ84wMkSaUpw70sTm3o7ymVldXP0+eXnw6q0D3+ieOVBpTWUbV4Xtym9mVUYjFnLggPiH20Za9bqz",
-	"FcSCza9fw4TGlIsluEeygSxnuJgjLnu8GkNZcBSdcWUed6ltNZU4m55d4gbbHpS1aa/RiK1lr23No+eU",
-	"5V4V9dAOuL9wcY099cpcIRzgxlbBwLib3Cq76Z3u+OloqGsLTwrn2vCMt7Av1RURvBtYZERI1HCRVAu6",
-	"NhRkjdN95sSrIjHHL1E5S+NmCz5Thji4tfmaxgQbDwijZsSKDbgQeMWCsUwztYO3rANkMEcUmWhS2oC7",
-	"mXAphirO/lkBYRlwbT5JPJWdg2rOpU9T0b9OjezQn8sNbFNWNMPfRMYwQw1JFwjEZgEjtDD3wD2sFU6/",
-	"0No0bn4IDINXcFSFM/auxA1OJkcfjpqtt3/ZthSHGYH6/M8Qhn09vj0dkTdbLC2gA3NE0wsN3hYHwzeF",
-	"6X2FO6K5EhDc8DIY2+QjuRKRYSp+QbnNFmL6WRy63gqszcD0uhASw+4VRL30TCVzKf6AuCY7NxsViX10",
-	"qERxEXtPIuHMXSZaW2WaPFAevyEcg6Q9JMkFH0nbkThwwpHKA9M5vmP1Bi7KLVnbzCYt93X8cIQhJ1M7",
-	"fnM4HMy9MJ2cXsxo7JGvEagMTAeNk6ZlitOC+M5+F5zVsKG9wN9Tt2U2Vr0E2QQo999FXVM4+rZIPoOU",
-	"FTSPS0kZYr/9MidjC2bTw1QKgvwjbiCbV8tSkcvhYt1gDWqO5mRvHGQ4cruRsXOm2CwHbPHItphRhbdW",
-	"bW6tu5jlAddLhc0f79B8WfFMQqaXyiJWCVILsKjK1bbvGegLAE72sN2j5+Q+Wv0VO4cHBotOFhntP3qO",
-	"YSn2j73YZefyQG3iKxkylv9wjCVOx+j2sGOYS8qNOom+m7DJ+4ZZ2IbTZLvucpawpeN6289SQTldQNyb",
-	"W2yByfbF3USjYQcvPLOZp5SWYk2Yjs8Pmhr+NBCaZtifBYOkoiiYLswB0oIoURh6apKL2En9cDaNlXvw",
-	"7+HyH9HFUlq1AboK8+c1ENu7PLZqdIS9oQW00Tom1D4vylnzgNMxxAk58o8UMQNCnfjA4sbMZZaOIp3Z",
-	"QnzozbhGJarS8+SvJF1SSVPD/iZD4Caz755Gsj60H3rzqwH+2fEuQYE8j6NeDpC9lyZcX3KfC54UhqNk",
-	"D5pQ0OBURp9rC03zeFCL5+jdmKbNQ+8qgJpRkkFyq1rkRgNOfSPC4xsGvCEp1uu5Ej1eeWWfnTIrGScP",
-	"Wpkd+vXdKydlFELGnqw3x91JHBK0ZHCO8TXxTTJj3nAvZL7TLtwE+i/rZWk0gFos82c5pgj8ULE8+60J",
-	"be8kzpGUp8uoj2NmOv7eZPqql2zPcfSF9JJyDnl0OHtn/u7v1sjt/w+x6zwF4zu27SbEscvtLK4BvA2m",
-	"B8pPaNDLdG4mCLHajvWtg8PyhcgIztM8x22orJ/jJ0gO8s8KlI5lHcUPNq4SbVlGL7C5KQjwDKXqCfnJ",
-	"ZupdAmm90ERplhVVbl/7QbYA6YysVZkLmo2JGefk5cErYme1fWxGRZsbY4HCXHsVHRtG8HZ/t1Annyor",
-	"Hoa5+zib48LMqpXGx7tK06KMRdibFie+AYbxh3ZdFPNC7EzIoZWwlZff7CSGHuZMFkYyrUezPB5pwvxH",
-	"a5ouUXRtcZNhkt89qYunShUkN6zzxNXP7/HcGbhdXheb1mVMhNEvLpiyCVrhHNpB/fULF6c6+SD/9vJk",
-	"xbmllCiP3vQC6zpo98BZ5703/UYh6yD+ioKLEpVM4ao5bo6xV/QNcTdhTi+roX1NWGcV84m3U8oFZym+",
-	"4A1SwtYgu2Svu/hFdnjs3DVL+SPuTmjkcEXT9NThQQ6Lg4l7PCN0iOsbZoOvZlMtddg/NWYVXVJNFqCV",
-	"42yQjX0qJmcvYVyBS6eAeX8DPilky9eEHDLqvkxqM/cVyQhDfAcE4B/NtzdOPcKwvDPGURByaHMRgNai",
-	"gbkotZGemCYLAcqtp/0kV703fSb4LDWD1YeJz12JY1hXjVm29Uv2hzrwXkrnFTRtX5i2BN0yzc+tcGI7",
-	"6UFZukmjL2rrHY4lkxpEcMTblHhzf4DcevxwtA3ktjG8AO9TQ2hwjs5JKPEe7hFGnZerk2DvnOaVpShs",
-	"QWxYT/QZGOMRMF4xDk1m1cgFkUavBNwYPK8D/VQqqbYi4E487QRojh7JGENT2plobzpUZ4MRJbhGP8fw",
-	"NjYpxQYYR92gEdwoX9cJXQ11B8LEC8wk7RDZTxCGUpUTojIM3OykDIsxDsO4fbK99gXQPwZ9mch215La",
-	"k3OVm2jowUsqYvLmyxWklXW4C5sbgpYlSfEFaXBfRC2aTBnlqZjlkdi3w/pjkIcPg2xna/w3lrFjGCXO",
-	"I37lmCzv/saOVxZY2yP1xE1DTIlii2tuc9P/Vvc5F4s2IJ/XoLDxjIckEzvdLw3bDN9A9nLBWMZaP1HE",
-	"MCThk7Si0lQ/rmmfSWTkUaW0ybe5WSkfzpw5RtY/EIz4rnl9T+3tYn0MQyGJ6WAELdUuPF5T0jx17x9M",
-	"m+4yNoKNZ7BpNm3Jiqh9ZSiGwYYwmM+93rvJRT0pE8feiFAfHNMH6G8+8o6UlDkHWnNi+5h1Mbr9qOld",
-	"oveaDe4uwkW+4iCxlfQzKQ0T+CFoynJV54OsqxsE/lYjz3XzsVy4lykYOlyrpv6NCij/m4+yt7PYqhlN",
-	"1jM0BFxQmfkW0ZvNX5rJQARIN6bShq6yONDzembWuE/7YYWRZ5PoLk9zoRhfJENRFW2PZW3uu6esXRZ1",
-	"CExRhXDNQbpsh9oXJUm08O7WTXBsQoXLiX0dJKjBrDoWuMG3Te+ax1uYK4LakjTO5hwukEgoqIFOBk+s",
-	"hufchOwX9ruPo/O5AjqZOSLjenpNtr6R8o5zpnpIDKl+ThzL3R6fdx2RgnFuk8mq2HsrblAZKpulFFmV",
-	"Wlt/eDDAi147PxncwEqigkDaX2WPp+f4gPZVEO18Buup5avpkvLmJXP7WNucsnYNwduczm7fqrQVv9Py",
-	"hV3A4lbg/JLC0nhUCpEnA9rlUf/ZWPcMnLH0DDJi7g7vchrI5Ubuo1JTmw8vlmufRbUsgUP2YEKIEbeK",
-	"Uq+9JbGdlaQzOb+nN82/wlmzyr7kdHLc5JTHvaW2yNMN+ZsfZjNXs1UPbziVHWTzRHrFB1gbvYhkNty1",
-	"QEDEttcRUAKislDEpJRrPqfZ6Xz3ZbkI6YeB0FuE6LOW4Gff3XfseULCLQuAgSHjigJgP8R71+XhOpCr",
-	"VQr669x5A1q4HcD9LohvtJc+coeVDj3bRemIP1823VHrsQjxD+z7p+uz6SytWgJu3tiu/zbkw7F+igF3",
-	"YQenFcuzbZvbcv42CazQvfm7c5N/kRRav9vw4/5xc9mErmIt6W4CIiay1tbkwVSBW3cHj67rFvHf4oWR",
-	"VpLpNb5U8FoR+z36AvQn4K6igitQU8d7unBDWxvNRR8s6tZNOaufhC0xUZj7Gu1nGlOxvlzRoszBnYvv",
-	"783+Ak/++jTbe/LoL7O/7j3bS+Hps+d7e/T5U/ro+ZNH8Pivz57uwaP5d89nj7PHTx/Pnj5++t2z5+mT",
-	"p49mT797/pd7vpaUBbSp0/R3zDOXHLw9Sk4MsA1OaMn+BmubWcqQsc9ZRVM8iUavyEf7/qf/7U/YJBVF",
-	"UP7W/TpyoSijpdal2p9OLy4uJmGX6QL1rESLKl1O/Tz9zLdvj2o3uQ1vxh21HlBDCripjhQO8Nu7l8cn",
-	"5ODt0aQhmNH+aG+yN3mEqSFL4LRko/3RE/wJT88S933qiG20//FyPJougeZ66f4oQEuW+k/qgi4WICcu",
-	"eZf56fzx1HvZph+djnlpRl3E3nBYh3/g5e3ntBpbiQttt75EYpA2QblsCmMys68ViBMBeYZ+WKu2GdZW",
-	"I+soC4ptB1Wdxq1a4e+/ofKXsezSseRgsYLm9Xve4YJ2Qc1fX+f32V8vI+E+HzpFyh7v7X2CwmTj1ige",
-	"L9escPb0FkFs23lvDGh3uB5XeE1zQzdQF60d4YIefbMLOuL4ct6wLWLZ8uV49Owb3qEjbg4OzQm2DALm",
-	"+6zwV37GxQX3Lc2VXBUFlWu8cIOUXaFodTnIcttPVZzFdZgPQ5DnPEiX1LL4zNaezsZE1YUZSsmEERyw",
-	"xHMGqQSK17yQGJXTZEx32j3YShSvD/6ONt/XB38n35Oh8rfB9FarbjPxn0BHMvr/sG5KOG7k6F+KTY6/",
-	"2orB386dd9Or5q4uxDdbF2IHpn23u3dVP77Zqh/ftki6qp8ZUsIFTzimjzsHEpi17mTUr1pGfbb35Jtd",
-	"zTHIc5YCOYGiFJJKlq/Jr7yOy76ZCF7znIoHkfIb+U/PRdVI0YH4HqSynX5sRSNk240nrbCEbEyYbiTD",
-	"eBHtIMune5MzbhL6UJ7ZeFof4KbGPrENWuusT9Xux7iX9mYSE9IDV8sP66PDXeTy1pqCfBsx2byFr6uV",
-	"5v+kFotrFzj/lDdAD44faEb8w51PzJt3Y6ZP955+PgjCXXgjNPkRgzU+MUv/pHaCOFkFzAbTRU8/+tQc",
-	"OzAYl/amzVq6VfFjTMWc0LF7i+sK89QeesNPLCO0mYf6XMPMsCu/6GfmiXGKJhvJ18IjbLrsCF120XvH",
-	"F+74wo34QpegGo5gKyRPP2I0WsgOekcSS8P9iRwlQZ5yKQqfKFOQOeh0aesddX3ZEbbin4cN85RNSVRu",
-	"zF863nXcov4jclyL89dico8dI3Gw48/WfXo5HqUgI8T3i49VN5/ZHOOp6qd/PlcQPpivK4DXL+ddfhGm",
-	"iCFQLYiLSCdmF68E5Ytm8r5vHdFyPWvSHYJvguAeU3vpEhnY4+UW8a0bPoLbkiTkDYpDeMD9y7c/o9nj",
-	"U97In3pBbwQHAiumsH6BpcU7d2MtLtSFcOvw47DG2YDo0HY6ftQrll1O61K5Q0LFW1fRdaNQ0dzUjAdl",
-	"7EPzCi1LoFJd+5Le7g476cx4dBgm3Bd1qBOhTcHcCCgGL1f0JP7bLm7EP6+37q6q811V5+tVdf6sKnMT",
-	"kGNZlfcTyQ7X+KL6tP4i+vQbwRO8bYFrL/m10PLldGt8RNCqfOVTxXBh60kLiUJCyAfUZKfrFQZdCS2m",
-	"giGdw2TsLtuU6nRZldOP+B8MBr1swi5tXqSpNbNtum9t/ezRrQZQ3NU8/wZqnn95E96NxNHOaiWUdRAa",
-	"euuR/pvT4msN9QvwtCOTXXO1rHQmLoI45qam2+BJsi1u9SS9ERnYcdux/P08fxSDG1z8c/8A1Twi/j7L",
-	"Y7NpZ5/KMeUeF6a0Wiy1zfEaTSBdd0xoagk/serAthfLtpV/mXcOhOYSaLYmMwBOxMwsutlXXGSnKp3j",
-	"hPGHtw1cpRQpKAVZEiZ32wRaHVWO9kC9AU8IOAJcz0KUIHMqrwmsZQmbAe1mNa3Bra0+7tT3od5t+k0b",
-	"2J083EYqoSm0rgVG1eTgiu5GULgjTlBUZZ94//wk192+qsT8YZGn4/brCSvwmRunXChIBc9UdDAsHbbt",
-	"2GLV/GAtCmzKbH9SPmd1flvrbOhFmBn5t/o9WG/spsZhndnPSlqQRZMmw2rDXG9gVc8l5pH6iS6h+7aR",
-	"h7AUjF/n+gtSTOjAImGGiyzuguU5+mbjckcLiAYRmwA59q0C7IZq/wAgTDWIrt+NtyknSLautChLc/50",
-	"UvG63xCajm3rA/1r07ZPXC4QHPl6JkCFYraD/MJi1qbxXFJFHBykoGdOQl+4eOw+zOYwJorx1FXjG8q/",
-	"wAo4Nq3CI7DlkHaFvPD4t85Z53B06DdKdINEsGUXhhYcEyu/CiHwqlpe137wCc2ebbE6EK8asdL+Pb2g",
-	"TCdzIe2NmWChiIgHtT37f1CmXXkSpwNr4cyWrtSEZShunCCJrQqDWV39YJ8ugRWRqCsz1Y9C7uSwbWyr",
-	"WhCzMFJxzfxzO6wz72XMr8/7eSc930nPd9LznfR8Jz3fSc930vOd9PyppecvE4FJksTzaf+8Jva4hoy+",
-	"SQn/G3q/8jkfnDRCfy3yo5JgRHRzjjdGZmig+dSljkcXejRRsg3xDtPQp2Y6xkmZU6xBt9L+oTGWnwsK",
-	"0fj8xzYHkuE1psGTx+T454Nnjx7//vjZd4b7LG0tnLDtfV8YSul1Dg9cBFud4MSHsgGnmGgZI9mo135S",
-	"H+Vgpfk5y4Eog6yX2PwQziE3orz1dRKjjPTVoxOg+QuHHMuVQOkfRLbuEI5Z/xRR0SaZxmHOOJWRZOh9",
-	"QukhWQssiOCy+/c0qMtbjZmIxwn0N2zbXg3UAYuS9yZ62RoX4OrYuLF38ZGZPfXoJC6R+hdl2QQhcmTW",
-	"sKevJpK+m6XXHRxsa6QKd/6+1ah3j/jowcNjO/ZZTAkWJbYUt0pMowXwxLGFZCaytS8Y7OoytLisTZg/",
-	"zGRtNnpw5T7cMbivHhg2ixhd6ZapJ1qwKCju1aRY/TKM06Zq38g3r08d7UpSN46Z7A7X5xpB0MV9IclC",
-	"iqp8YEvT8jWqxEVJ+dqbwYysiKWoMOc0xnnfLqeuE6X2+OzulZRCfQUf7Xd/t2jB9KqujFJm6yjFsxh2",
-	"q/1sx3hTy2Jb1jufwzNSd2egyk5/E/0uu0DH2vRX2ozGkeoXnVoXd4+r/kdcCW+lOGdGcY5y2H4UVsMQ",
-	"JltvBhmwLLwaOqk2/N3Q5qfv6MVJqyLJbjx1lTjB88ZS6RJQIKultEheEnNfSkGzlCp8P+IKlH1iiVWv",
-	"jiJ2BwQT80v1I33NBT7ZKljiuDvJk+1IbzchJoBRNpHml5Uum2jTA/dcp4WNO1PAn8UU8IM/fIpQzKvd",
-	"OZxB0cAd2BS90Cse5VJT9BIOR7wFB+KtbXmrvrve8G0XXuPCdC4IyEtCSZozdFAIrrSsUn3KKZpAO0nH",
-	"O+49b9gdFqVe+CZxK3zESO6GOuUUC03XhtGoSDWHWAk9AC+xqWqxAKU7nHgOcMpdK8abotaYwz2xcZ/m",
-	"ujYcfWJbFnRN5lj4SpA/QAoyM1pEmLMEDYpKszx3/kQzDRHzU041ycEw/dfMCHRmOG9zqn3krlilx8JA",
-	"bQqbUXagPv1P9is+WnDL93YjNG/Zzz4aevxl8j4nLBuE/OjQ5RM7OsQUMY0nsQf7Z3MvFYwnUSIzN77z",
-	"yHdpi9w3Mp4noAeNT9Lt+ik3wrQWBBk91dcjh64boHcW7enoUE1rIzreAr/WD7G3rAuRGJURi2GNFkwv",
-	"qxlmXvZvXKcLUb93nWYUCsHxWzalJZuqEtLp+aMt8sEN+BWJsKu7m/vPY8QP6cCclnrjsahQd+8H7uVb",
-	"SN/6deds3RqidJch9S5D6l0OzbsMqXe7e5ch9S5/6F3+0P+p+UMnGyVEl3Nja0a/1ktjrNFPm0qrNQMP",
-	"m7Vy//XdkkxPCDnBOpbU3AFwDpLmJKXKCkbcRsoVbLHURFVpCpDtn/KkBUkqCjfx/ea/Vs09rfb2ngDZ",
-	"e9DtY+0WAeft90VRFT/ZMsvfk9PR6ag3koRCnIPLBBbW9bO9tg77v+pxf+mVCEUrDBpXfCVCoqr5nKXM",
-	"ojwXRhlYiE58Hxf4BaQBziaaIEzbpKuIT4yLdNE57fKDbaG7f79fofDNQYdc7pKafPpqN5tqot6UB24c",
-	"u8cQ71jG52AZX5xp/Inyr92lWvvKFhQ6Ulu5VG8gSdUV42LF5J2M1FRkDCsc4g1X1zZ8/8HwcQXy3F9+",
-	"TcG+/ekUs50vhdLTkbma2sX8wo/mfqALO4K7XErJzjFT4ofL/w4AAP//80cbi5XqAAA=",
+	"H4sIAAAAAAAC/+y9e3fcNpI4+lVw+7fnJPY2JfmR7MR7cvYqfmS0Ezs+sTMzu3FugibR3RixAQ4ASt3J",
+	"9Xf/nSo8CJIgmy3Jr4z+SawmHoVCoVBVqMfvs1xuKimYMHr26PdZRRXdMMMU/kXzXNbCZLyAvwqmc8Ur",
+	"w6WYPfLfiDaKi9VsPuPwa0XNejafCbphs0dx//lMsX/WXLFi9sioms1nOl+zDYWBza6C1mGkbbaSmRvi",
+	"1A5x9mT2duQDLQrFtO5D+b0od4SLvKwLRoyiQtMcPmlyyc2amDXXxHUmXBApGJFLYtatxmTJWVnoI7/I",
+	"f9ZM7aJVusmHl/S2ATFTsmR9OB/LzYIL5qFiAaiwIcRIUrAlNlpTQ2AGgNU3NJJoRlW+Jkup9oBqgYjh",
+	"ZaLezB79NNNMFEzhbuWMX+A/l4qx31hmqFoxM/t5nlrc0jCVGb5JLO3MYV8xXZdGE2yLa1zxCyYI9Doi",
+	"z2ttyIIRKsgPzx6TBw8efAUL2VBjWOGIbHBVzezxmmz32aNZQQ3zn/u0RsuVVFQUWWj/w7PHOP8rt8Cp",
+	"rajWLH1YTuELOXsytADfMUFCXBi2wn1oUT/0SByK5ucFW0rFJu6JbXyjmxLP/0F3JacmX1eSC5PYF4Jf",
+	"if2c5GFR9zEeFgBota8AUwoG/ekk++rn3+/N7528/T8/nWb/6/784sHbict/HMbdg4Fkw7xWiol8l60U",
+	"o3ha1lT08fGDowe9lnVZkDW9wM2nG2T1ri+BvpZ1XtCyBjrhuZKn5UpqQh0ZFWxJ69IQPzGpRcm0xtEc",
+	"tROuSaXkBS9YMSdckMs1z9ckp9oOge3IJS9LoMFas2KI1tKrGzlMb2OUAFxXwgcu6ONFRrOuPZhgW+QG",
+	"WV5KzTIj91xP/sahoiDxhdLcVfqwy4q8XjOCk8MHe9ki7gTQdFnuiMF9LQjVhBJ/Nc0JX5KdrMklbk7J",
+	"z7G/Ww1gbUMAabg5rXsUDu8Q+nrISCBvIWXJqEDk+XPXR5lY8lWtmCaXa2bW7s5TTFdSaEbk4h8sN7Dt",
+	"//3q+xdEKvKcaU1X7CXNzwkTuSyG99hNmrrB/6ElbPhGryqan6ev65JveALk53TLN/WGiHqzYAr2y98P",
+	"RhLFTK3EEEB2xD10tqHb/qSvVS1y3Nxm2pagBqTEdVXS3RE5W5IN3X59MnfgaELLklRMFFysiNmKQSEN",
+	"5t4PXqZkLYoJMoyBDYtuTV2xnC85K0gYZQQSN80+eLg4DJ5GsorA4WIPOFxMA0ewbYJm4OjCF1LRFYtI",
+	"5oj86DgXfjXynInA4Mhih58qxS64rHXoNAAjTj0uXgtpWFYptuQJGnvl0AHcw7Zx7HXjBJxcCkO5YAXh",
+	"wgItDbOcaBCmaMJxZaZ/RS+oZl8+nL3d93Xi7i9ld9dHd3zSbmOjzB7JxL0IX92BTYtNrf4TlL94bs1X",
+	"mf25t5F89RqukiUv8Zr5B+yfR0OtkQm0EOEvHs1XgppasUdvxF34i2TklaGioKqAXzb2p+d1afgrvoKf",
+	"SvvTd3LF81d8NYDMAGtSm8JuG/s/GC/Njs02qTR8J+V5XcULylta6WJHzp4MbbId81DCPA2qbKxVvN56",
+	"TePQHmYbNnIAyEHcVRQanrOdYgAtzZf4v+0S6Yku1W/wv6oqUzgFAnYXLRoFnLHgB/cb/ARHnlmdAEbh",
+	"OQWkHuP1+ej3CKB/U2w5ezT7P8eNpeTYftXHblyY8e18dlpsuNZcimeMXWmqSsmKKcMt0OyC40ZnS8ay",
+	"iqlssTOpMwFy4ZqJntWikrIkXJNlXZZzFHvWjCwZIxVTBMYitNVeMFZoJ0ExywqlKqzEhLDgAKW8ZBrY",
+	"/Q5OW7h6o3FWStbV0WzeO9rz2fhK4C7ZcIEySAtOzz38vdFd5dyx85WSl7pBBnwiawnS5MZe1CBwk0Up",
+	"83NyKZVZd4WNNNRwRS7ZHoBb9iLGyOdCmrCCO80SHLN+I8xWAGYvaMlBFfYfnJJgiGDmUqpzuDSNzGWZ",
+	"hg3WmOW0ojk3uwEIe2JdvGR/43VxOjKd5r8NIOP6U7yN74qf2gTT7ER33TFgDTuwIrZlB31Yl4zp9rZQ",
+	"f379YekDDKe8OcLu+OsbOOzOVKrTeHV6E9PeOumbI/s3bKMTUlHAA1WK7mZOhMtQFOtP86N2WKjoigsE",
+	"em6P0oaewxGnQqIWAyhDDmCFOate4aCN9dJJhE7lOuqZOIZlGytZUONOtFOZ8H6/ZIoRYMK1YUU05hDt",
+	"BJT6yaZShu9IZGWQDxqJqnqznV1CuPmLpemZgjL6TLiwlzE2nVsT4De0pCJnN0GYCzcU/DtQ2hjoz7ng",
+	"CMSfZVncEuKjnxoUXpkQ1xaVwWB0FHb65ikPRk3SHHzoUts3cJfeBJXBOP1NwOHJmlEQQwpq6NGsi7q0",
+	"WIod/4z9AMycqYTu+j3+g5YEPoN4DdewHRZsUlwTromMXpCKRrawM0EDwIqRZGOtNwSsLgdB+biZvE85",
+	"0GASuTy1BiMn3LhFwNIbc/DpQqqr0UuHEARpjNyEwqjBrAUrb+8sNq2rzOEnYSizDToDNe+KfX0pxlB3",
+	"+BSuWlh4Zeg7wII2NAL+GlhoD3TTWJCbiubmMbsiBjprSh4qlDit8dFaMYFvOaklt/PH5w1Q1Vg+rJTX",
+	"Y9HQfsgUBvPhpzCJHTycBcs90uJsDkZatWHF5NGd8oB3glUtBhYWC48T7ohoiX2wHAObem2koAHjewch",
+	"jiB4eRPK6prqdR99YMp6cJ+8+vPpF/fu/3L/iy8BjkrJlaIbVIw0+dxZEIg2u5LdSd7QeO+mR//yobeV",
+	"t8fde2QQ4DD2JNQyuCosxoh9GQLonjBDecmKF7Jgrww19U1IXWOPlrjF4TtotXgPLRictJzWq7UhYDeS",
+	"+EBCxS4p9niWUTHn7DEmFDju9JIxBUvU8QDpF+bXfINmK2EsrAAbsDdJllThA5egQmqWS1HoI/K/TMnm",
+	"chWyYLAkIVu902eYiwXaGsNCxtRSbERyKQTLQbZHRQ9nKlh6+JJqk+Vys+HGjPMJaOmYxbKs9doOXzGl",
+	"uQYaINpIRVfMvncVfLlkioncvarhPLY3t0ylZMWKKWLnJiVdjQA4DSxaFH7Nfvj0mEKaTDFa7OC/jlTb",
+	"I/9tvettFfawb3vqgnn5XCfJT9bm4G0LO0V4ew/Ti3C2qSw2RbwrswUwElrsUnhq3v486AN4mhMK3yqp",
+	"TGPqOraNmSjwtEdzh0fI+QyOYKa5yFk2Sg14JqEZMW3CuKQ6RRy9c5pceYSfbI99CE1RtLx5O1G07IEj",
+	"O4SlDiPzG9nlKz2K7XLQAXobQs+UC8ffLJZwNN4tc6eLAEfkRtuTMe+hDB/n/QmHS0rtVC1uwiitlFRJ",
+	"Y5O3U2YXTGme4hkvXQviWnhGV3V/t9AiVcLc+Dpci2JAgYdn38nWCTv0661oLvC2caJDWHa9idW5eSft",
+	"Ywv5/rER9k5lYAUu2KJexZo1WSq5IZQU2BE38Ok/a34h7SbdhIDB4vEmYy+G4ikYXUTO9qOwNdVUIZa5",
+	"4b3gXTBj2X1ruDnZSHwCzJkwZMmVtlrvM8aeasM31NzIS4wbauD6AHN/aDK3jyaWg3ORwwUKh7Vhech3",
+	"2pbbMZxHS0mZ0vZd/y3tpQQIjdMDzDoCG/1tQKAuSFpf+ZifQboEF7Zr3r4Y/Aom0WBnIWFQpK8XFqpv",
+	"6E3w1ILrIM1Mk4dCcycZuecvaUg8lpchFnSKBpqAYupZlbXJ5cYpmdDVTtnC083Yog/gVc3UezkUjjt1",
+	"sX59HuvgFCg+M4RtK6TsnTPPuvlBZ7qJtQe6OGTxMPne1R+21x3Ss0u9nurbnqQZrLkpgYrj+5EuZG0I",
+	"jQWjtMltTIV2DGaSKj2oQWPHjOYWnRly1r3H17ay01mf0tKK+QvGBJEL54u0iFQsii6MJhi5rOyZNmo1",
+	"cFVK5kxrVmTj74sNaL6dFUHMCJ4QcAQ4zOLU+ysCa6Sh5R5AsU0K3GCh52IA6mnTj21gd/J4G+H6DJeb",
+	"kWgnKplhQyiciJMLptCW+U73z09y1e0bNAI5sxHqnW1NMjkYclDQoGB2nvMKD3t2znbpyB4uoicyNKK0",
+	"OhLoSKSI7AaXa6lhn1ZcG5TRztnOMW47gqce77xb9OS2qWLbyxiUv7DdU5hlNyjB7eNW0CjeQg0bFzGI",
+	"FIMaEw2/o9rYJ04uCjQR665RAKYYtjgNankw8l+9gtcfO5dCM6FrHbQ9XVfW5pFaA74UD871gm3DXHIZ",
+	"jR1USiNJrdm+kYewFI3/Q2yaU/3HYRgusTj02Ifrb5dEZQuIBhFjgLzyrSLsxh7mA4Bw3SDaEo47FEmL",
+	"kjayqoDtmKwWod8Qml7Z1qfmx6Ztn7ioaa6zQjJrM3TtHeSX7pCh+WJNNXFw+Kd/NPFbd8NrWcFeQav4",
+	"COzhTQPPbc5i1Dc+NUaCFv0miW6QCPbswtCCB97+uvzoxh0HuhMkfQhI4W1a0QenN8T9ifUf7Y55Nfny",
+	"Svw6ITYnllNyjfdk/+JB8K1FMFIlb0JATowKp5sKgoB6d2eQQ+ImbEtzU+7gdjdrtrNeLLpeWCtp/8na",
+	"yGqP6fx0dEbnhJB0URv1iniFQ0XLS12aVlrbY9rvyGspW/NE+3IPGUkIpuhQp6SSsOvcBTb56BdPSS0g",
+	"nexW7jy4wDw/030LOfkfWZOcCu+WFG4EqZDN4vULM3AdzcmtgNdgiJVsw6w4jV/u3u0u/O5dt+dckyW7",
+	"9NGAd+/20XH3LiqHL6U2rcN1E8owVeYswdu7DgddnnK091nYjTxlJ192BveT4plCP1K//IiWv1Wyrm7C",
+	"HuCc0Sbzty4ME+3ffpopCPm+MQO18QC/MAqCUtdNfO4pzXqau57uRewogb+bQJ3ZTqGdGFZwFthPOzju",
+	"obbFFN3YhSsplzewWl5sUyFEBdumVuq2A1Xbz0AP3KG7YfJ9FQBMRBEydV7io45cdhga2TDgNHrNq5Tf",
+	"TxMt/f99/l+PIEqaZr+dZF/9+/HPvz98e+du78f7b7/++v9v//Tg7dd3/uvfUvK+NnyR9lL5M9UYCeAu",
+	"nq04E9bxEGzRqBzvnPApl+8b7g6JwWZ6zEdLmsSuUhvCQ0AE0hyKwyAgg259I1zK6sxTmVRr/v28yQ4+",
+	"1XJp/Lo6ryF2mKGXJFC3yt0NiG12IKJYpZjGSza2zmj7VS7j+HF3GPVOG7bpGzht11/GHJiTB1ei6STb",
+	"SMF2Q4aV5/gx1dte9AOdUeQa6tvVolrwd8BqzzNli6+LX9ztiDO/DNHsN7D53XE7tu04ch5tc6ysCCV5",
+	"yZmwyrxRdW7eCIpKcse1sEMWXvUfNps89k3SdpqEGcUN9UZQPD1BdU4+yCcfCZ8x5q0nul6tmDYddWHJ",
+	"2BvhWnFBasENzrWB/crshvmHxCPbckN3ZAkR4EaS35iSZFGbtgCNAb7agBHGGtphGiKXbwQ1pGRUG/Kc",
+	"gzsADHe1CKwVE0xznaXvlm/tV7xi3PLX7rqBf7vOngW/7zvRw86LQcjPnjjl8uwJahCNib0H+3szQH4y",
+	"L9H9s2hPR4dqWhsx9c3aRdyCozV6lM9W3KzrxVEuN8deqT5eyaBgHxeUbaTAb8Uxrfixrlh+fHFvj4R6",
+	"DX5FEuyqzWRvQsb4mIKJ9vsabrw/a9x03kyivEUymJZ3IeCoMdVMM2n1DEUB2/tEq4PNGvGekiWuIX6+",
+	"5KBvOF80d+noG7dAuoFT4HXnDIZ8/7eR5LNvn74mx+6g6s8QJ27oKIY8YQazH9oP1ED7NpWWzcXwRrwR",
+	"T9iSCw7fH70REM10vKCa5/q41ky5kL2jlSSPiBvyCTX0jejd8IPZ7qLIUFLVi5LnYIpM0anNYNQf4c2b",
+	"n4A/vHnzc++1sy83uamSLNpOkAFZy9pk3jdSsUuqigToOqTowJGx9+is9sjI2tq23PjEjZ++qWlV6ayU",
+	"OS0zbahh6eVXVQnLj8hQE+yEAWjoxO3vQK49NLi/L6R771X00uf3qTXT5NcNrX7iwvxMsjf1yckDRk6r",
+	"6jsYExwd2K/uqgGa3FVs8vmOokCbwVLGUly4lafZ1iiaQbIWnVy+YbTC3Uc5bYOm+bIk2C3GSYi2wKGa",
+	"BXh8DG+AhePgMFhc3Cvby+faSy8BP+EWYhu4nJoXr6vuVxTQeuXt2hMUS2uzzuBsJ1elgcT9zoQUXCu4",
+	"kv0zJNjg4RC4bGULRvI1y89ZgYmT2KYyu3mru1y2BBzPOri2CcZsDCRmwUHbMiQeqwrqREAqdt10JJoZ",
+	"43Ow/MDO2e61bJLoHJJ/BDwLbMqvDGhm6KAipUayCBBrfGzdGN3Nj1yoaVWRVSkX7nQHsngU6ML3GT7I",
+	"VkC6gUOcIoqAhhF6r6hKIAI7DKHgCguF8a5F+qnltQz0E7OttOzucaDH4OWSvE4wrKR1a/SY+kCgBzTO",
+	"wFc2uR0MvsB+wBnq+tL4mewzjc0lQDBJrSPcRYmySOPWgiebqtZbhliNgZamEqZEc6t7MNoYicWHNdU+",
+	"0V4RxxxMumj3uiMDFXn5FtX9RnLC+JiSXdAh/A9nhzqL/CGipIMh95NnbN3DMA95wGz+X58jyieG8tmg",
+	"ZvODMjvNZ84zMbUdUqCUUbCSrezCbeNOgo/PdLRBAMf3yyX6PWUp1wqqtcw5nveIl7s5GAihdwlxjlOT",
+	"R0iRcQQ2Pj/iwOSFjM+mWB0CpGAc9TDqx8aHy+hvtv/9pUnE7MTbvWJon3c0h2jeJEqz29hXg+azJEsa",
+	"0hBarZwDxIL1NOoUiRIuEma5vvFPs5K5UKOuD11aqmBIhq98t0htIJ/b6NE70St05DkXLoKQa+79mq4u",
+	"pGEZmukztNgklweNnmkUBp9B0zT7aaGK2EyuvEhzH5z2nO2ygpd1erfdvH95AtO+CPqTrhfokMiFfQtd",
+	"YObhpO/IyNTWvWh0wd/ZBX9Hb2y902gJmsLESkrTmeMToaoOPxk7TAkCTBFHf9cGUTrCXlD3ecJKk8pD",
+	"EelkqNUCwzS0zxoiq0HvMBV+7DHxK4JimPPakZJraQAdXwXaitADEAMcA2PsrWjgDNCq4sW2o8PbUQce",
+	"smGKQwR1K/EnHmdnYbA9GIj09ZQ3pWLe5mC3NLozbQrmTjaqKZjBhEJNp5ghxFNx7QsI9BEFpI1Zrvc6",
+	"mzBa/oXt/gptcTmzt/PZ9VT+FK7diHtw/TJsbxLP+JRhVcCWBe9AlNMKTMe0zJxhZIg0lbxwpInNvR3l",
+	"PbO6tPr9+unpdy8d+KB7lowqayobXRW2qz6ZVSlGjVSjifastOp1ZyuIRZsfkkPFxpTLNXPJoCNZDriY",
+	"Iy57vBpDWTOeN64s0y+qe00lzqZnlzhi22NVMO01GjF27ljz6AXlpVdFPbQDr5+4uMaeejBXiAe4tlUw",
+	"Mu5mN8pueqc7fToa6trDk+K5RtJVu9SdLoql5amJ7nSg4SKpwkv4gjnjdJ85iXqDKTQzXfI8bbYQCw3E",
+	"IazNFxoTbDwgjMKINR94QhA1j8aCZnrCY2kHyGiOJDLRpDSCu4V0L2q14P+sGeEFE5gYSuGp7BxUOJc+",
+	"u17/OgXZoT+XGxj7RMNfR8aAoYakCwRiXMCILcw9cJ8EhdMvNJjG4YfIMHjAQ1U8Y+9KHHlkcvThqNk6",
+	"e6zbluK48k2f/wFh2Czp+8vueLOFS6M4MEeyjM7gbXE6fFNA7wPuiOZKQHDjy2COpEpLLRPD1OKSClsV",
+	"A/pZHLremlmbAfS6lApjxzRLPllznS2V/I2lNdklbFTCmdyhEsVF7J3KTNNlosEq09Q78viN4Rgk7SFJ",
+	"LvpI2g+JAyccqTwynaNHgDdwUWHJ2lbwaHkvpA9H1EIf2/Gbw+Fg7nlplfRyQfPztEAFMJ02jzQtU5yR",
+	"xHf2u+Cshg3tRe89oa1L0lQx1bgR9GOarygcfVokX7Ac0hClpaQCsd+Oqi34itsyKLVmUZ0NN5CtH2Wp",
+	"yNUqsc9gDWrOluRkHlXycbtR8Auu+aJk2OLe3OVr0HhrBXNr6ALLY8KsNTa/P6H5uhaFYoVZa4tYLUkQ",
+	"YFGVC7bvBTOXjAlygu3ufUU+R6u/5hfsDmDRySKzR/e+Qq8k+8dJ6rJz9Y7G+EqBjMVnx0rTMT572DHg",
+	"knKjplNg2SJ1wyxs5DTZrlPOErZ0XG//WdpQQVcs/Zq72QOT7Yu7aaOY23gRha2wpI2SO8JNen5mKPCn",
+	"Ac9EYH8WDOdatIEDZCTRcgP01BTRsJP64Wz6OnsPB7j8R3xiqXwWsY7C/H4NxPYuT60aH8Je0A1roxXz",
+	"r6GfMG+SLziGeETOfIIBTAgcUhVa3MBcNindppKwhZjmkguDSlRtltmfSL6miuYGc2YMgJstvnyYSILc",
+	"TnMpDgP8veNdMUxrl0S9GiB7L024vuCrKbINB1Z/p/EEjk5lamJ82kxOazxH7/o0jQ89VQCFUbJBcqtb",
+	"5EYjTn0twhMjA16TFMN6DqLHg1f23imzVmnyoDXs0I8/fOekDCwW0k830xx3J3EoZhRnF6wY3CQY85p7",
+	"ocpJu3Ad6D/sK0ujAQSxzJ/llCLwTc3L4q9NZEMnQ5SiIl8n3zgW0PGXpqJVWLI9x8nsJmsqBCuTw9k7",
+	"8xd/tyZu/3/IqfNsuJjYtpu4yi63s7gG8DaYHig/IaCXmxImiLHadvUOzmHgNk5wnianRENl/ZT3Put6",
+	"nDa4H3onL+McM5gRleeMKCrOnQbHmNKEG7JkJl8z7bPuQH6bvqFrwbTJoPOA9wkV5yE2GAeGM1DYcK85",
+	"1jZSNgEUkP8ROV2gVuHPs2KuLKXPfZWscUR5iUlspie2LdhGolaDisXSJkiQinCBb4Z2yaSQl6KUdMjp",
+	"5oD5zoW8DEVuOqhPDw6IyzBid+8UBdeGi9z09hBQh0PgVTA8DysynxJ3UiKtkEA3eud2aeggg6gdcoL9",
+	"0edxbW9ge+19CFM8yif8xCapqrL4wfoTG6xnJ5VL9tlk+iXf2krMa0ZazvWoxfFNXdq0ATZJtH1cqCug",
+	"jzmBceDVg9hZbR9bMdMmG12hEtM+vcP1iKa5+NkOQ+7H08cZ94e0MZuYeUUbuqlSgUXQ4rVvQHjnPQPV",
+	"mxg7R+SJ1Sx1yH6MQ5CQhp+E6Zxsg7wQ/mEMzdchB/UEVj89S67nxjoqXun+nQcObO8bgNslyrV5cucE",
+	"o1ouubYFeNkFa8cyeTA8M/SxTe3lqVoISymHV865Ato9cDhuePJIQtZB/IECu5a1OqSkkT3Pr7BXiih7",
+	"GYh7VSttXHkoLuMLq+dUSMFzTAUSlfwNILtivlPeAydkTRmuj4UnNHG4knmPg1ucw+JgJuT5rIW4/oNE",
+	"9BU21VKH/dNg1VgwNK6Y0Y6zgSuqK8Dg7IRcaKZCka5W6KdUrTdW5JDJZ/ssPO8cSEbo2j6g+D2Dby+c",
+	"WQCOIDnnNg+vQ5slaG4teVhr1ICUwQ1ZSabdetrJGfRP0OcIExQUbPvzka9NimPYJ0pYtn2P7w916l/n",
+	"3Ws4tH0MbQk+RzY/t9zo7aSnVeUmTXECHXY4lZ17EMGJV9bMP3NFyA3jx6ONkNuoWw3ep0Bo7AIf5VmF",
+	"93CPMEKi806dJTCaWorCFq7ERjL6lYsEGN9xwZrKuYkLIk9eCbgxeF4H+ulcgSA3PR8MoyW+xKcYmjbu",
+	"aeK6Q3U2GFGCa/RzDG9jk6N9gHGEBo3CQsUuFOwF6o6EicdYKdwhsp9xHaUqJ0QV6LDcycGeYhzAuH3N",
+	"pfYFsLcUY+huFM1Zq++Em2go0CuXKXnz6Zbl6I5I4Ls73gRmj7lLkqoKrqnWbLMoEz6fT8LHqPoObDFY",
+	"euD/h1WndJ4gB/siercP7HiwwNoeqSduAjFlEHJwtW1u+t/oPpdy1Qbk/RrSRs94TDKp052sIdB/0iUV",
+	"5ajjgbus9vnlnAlRw8Xa85u2z4qXMtTWMd5YwEXTTdlXxoopLgt37ll1ND3K9nU/gs7bsW1+2T5gHnpp",
+	"1nY9A8+StsRBIoxd8G2jhGDSLJescu5BgK9Rfk03FP4SV0wYsh0AMtJLtd/8NF3o26pNJXXyWdUWPFqh",
+	"ku7hvZRNAuUGgxdoCHEeqIPkvffcTS2IMLIcoIr0CPBlwgD46bACeS2aj5IC5IoVNmdg8F0KUU1dIvTF",
+	"sOEuRFrIqUvxvJtUbO+S8dV6IAux/dZae/cI+KPmztQ+iS84jnutwpGiw38AJyav6KR4LCcZjVJSxUkG",
+	"etkrrQQXcgAguqUvCorWmRC92qnYQA1NW32b+o7jVu/hSo1zlDEHvP1/aLIbUSvG2kf8IZ//fDBEhRoX",
+	"f2YoGa5SOJ/ZanqpEazDIH63UKQfMIacBK2PIHzu9Z6mgPXUWRx7FKHe+7QP0F+8azueQWtKbESDPmZd",
+	"EEw/LGmKe3yzwd1FuNASHCS1krgYzGAtmoH6/lxDqvNQ9050a/wvohwjPhk6TdataaNif1F/XzulaIGH",
+	"11iU3WkklnSvIdi28lA3WblH1r/opFQ5rBL9YO65+axXADsh3Gi+qUrrXXHR5C+Pe80x25KPpnclpJ17",
+	"WvfZvCkLfZgQ0xkmPBEeHZoy5Aa9Od+jO2I/XHTcBzGqKJPYUsM2lVRU7ciCiqgUThAVFVlLfegeLagA",
+	"yXGNipsd4REmTHe9klvlKhpcT46EVbiBDrrI/eQjGMSyNCmRnzHlC8ykXyUOkMlhsCPyTCriK/dFQwfz",
+	"GzZVrKQ7398aFpf4/DbezUU1Nm6RzO/4BYCsMXJ+pAJrqLaEOdyvt1sNjPjoBV0WJddrNhDXWXDFBoSD",
+	"uGRlZ1h0fKVRaSlcr1StggJHUZy8Q2FUPjEZKJ9y3j7zDvW6CwnsDdWklMD68G1YG7rDWoFiqEasNsA/",
+	"B4y1r7G8kG1i/VnC2nw9zuQOVhg5DqvKjOKDpXCD0gGNcMu6hTXnTUpSbUhlebq+9GXkGnJ2D9J8iY/Q",
+	"0A62JbS12UvLocdVfGbO1kkj0es1c1zGZ6qAxoRFhbVbB7OvgmHycKN22aoeij8Mbci3P549mYhlo+hy",
+	"yfOBEe1Hwrbg87Dy0Xp+ZNTzQ21+Q1cttXJiBa3XDoCEnjn4ivcan65W0h2X6JKPH7USqQQHE//CPdWE",
+	"2Ybj28DQ5ygN7vYw49dXQLG73vAwEs3FqmRdRHd8M0BTyHxSkb1P+9A6pCBpyhnhORjWVDLNhJk2tnb+",
+	"ruOjuiVNB9x3OAj2MMsU8MMM01Zg6Co9Yme3xgkQRpl397CF9BSuuitL0WGv8sa41tu3rjUJM1i4gCbK",
+	"DafR3Q1DATddMeFqzrYDoSeHYy6XcDYv9qSn+NuaiSj1wbwlWiyjbBU8hPdhVq7DReoGoJJeEZ6S3hw4",
+	"Q5fDOdt9pkmLGpIVG+Ze+b5KrijEANodM29QGvJgcF5fXAfKQCx4F33bnTX508eqx1c0N1kOBLnEfDhX",
+	"nNOTJqHEDUqiQRub4AgwF/LKs0PXg1QBvLSGclsMFEgbD1uUrapv/XpufebAtXeAlU6dOVCtaE95BX6g",
+	"mGCXtMxQwixLVgyL3CnuhseVVLARnVwiNsTHDdoqdJeOkfGAwBkp6nK4npfNlWPnaJnLQ0+EzOJdWVBx",
+	"cG9F6K0ioQj1bDyZYhvKRdJi8qJr6SnZ0pAFW0oXXDZKAyNq7IS0OgOJdPbT3WBanWEjeI9UEsjpg5w8",
+	"XsPJfxNHzFBe6lBW1Sd5i0MfwcWka1G7pDqoHI23nE8Xx7T/zSe8srOU/JzFVfTQNxFybvkWycd2/46f",
+	"DQRjd9ObYDPC00Avw8y8iWTsZ/joE4uNXM1LCQJvNmQSa4trwaz2mbYhEujWhDmdEa4lU65oKLSEsVlm",
+	"ZMLA04NjDBUa40CuhAQ9WPLFAjeYZvCHJo8i2nUpphWkLvwjXiCx5Owsz6P5Fvch+7H97lNa+KztnRoJ",
+	"iXE9ve6vnu5jWLnuITGm+iVxYur+VBlX8XLgQjCVeV/DbupDwVQMHCaJK+rcSsbxwWDeG+Sd5BFv5+Ao",
+	"0q9Yb978VGIu2++ixEPnbHdsX2CsmqnDVsbQ29LMdg1RmrzObt+oA0j69atc2QWsbgTOD+m/MZ9VUpbZ",
+	"gMPbWT+DY/cMnHNIMkzg7pDLRghJ1Kkjn6O/RfBovlzvfDHiqmKCFXeOCDkVNt7WOze360N0JofK6yPz",
+	"b3HWorZJVd2L79EbkQ5cRKVVXZO/+WHGuZpmorj2VHaQ8YnMVgzFwlwmqjb243gmuxt3wykaorJQpKUU",
+	"xWVT8WmgoEfJtJGucBNcUCvFsE6h802ZowEfFNyAGBPn3MW0SqQzlBXj4BYGElLMupNTxQhdeB7eSdnA",
+	"lMnMWjENr01pWNteOBrSKpN/1lLZTDgwwqgHzV43nHHPmykwBatUY2bw3UlFd+BmHWpj2inJGQaUYoGd",
+	"ENnom1KlcLRIFMdpmLCCcbMB6WMgl9fEKYww7sZDlZkycAzxMLK7RN54q8A0KRJv1zRLqX4ObwhOl8Kd",
+	"AJIkSGvGGPbPTCCvgjFdD+StRoftb4e7cUMKXojPQnM2rL8VtRrI6xhAQBsootjriHZlRrZ/xRorUrAI",
+	"GF7G6oJNa+ELQQ8YOjAoKrtU3LARmDpogeYeDNwAH3jno4Jw1ABYLQwvm2KJ6OevuDFs1MdOj51u3WJZ",
+	"ndqYKULdHCDDtRjtFVzmDjpeiafL1HPX8AkcnDJVAzCcvwbLqZN4xTSqk/DbdzFKoDhOgLfHyn3e8key",
+	"9RY68SwyZcq6ll9S5Mh/oF9SP7Xf1OXhOlCErjXrr3PyBrRwO4D7KYhvnOoGXrDSGSYWU3zh0mnroTs6",
+	"41mE+MIK/bP23lzp7DrdGG7e5K6nawqnzahjxYFpvzLw0dSInNcoc1Pt4gWxs7NM/QMfQ+NX83YLWhSN",
+	"MN2rqZ3cwW3Gh3j42ROdqKgbfgurmho90KsujFOn9uGvQ6/QNl5yIFy/Q9sQ2b/vkLWSLzT1AzG9wC8u",
+	"TcUHqWD4izXc9tmehfWgqI3uYUDEJNbamjyaKkqrMCGjguuWyJ+AWmJeK252mCnUm0L5L8kM7N+GZ8s1",
+	"oyAwNJXqyOummp3L/tE8ctbaCz3fSlqiTyQo6fjoYeAckKdbuqlK5vjT158t/oM9+NPD4uTBvf9Y/Onk",
+	"i5OcPfziq5MT+tVDeu+rB/fY/T998fCE3Vt++dXifnH/4f3Fw/sPv/ziq/zBw3uLh19+9R+fwSEAkC2g",
+	"M5+rafZ3dATNTl+eZa8B2AYntOLwMoyV3YCMfc04miO7AWNiOXvkf/p/PaeDYojN8P7XmUsFM1sbU+lH",
+	"x8eXl5dHcZfjFRpXMyPrfH3s5+mX8n95FsL1rR8E7qiNxAZSOJo1pHCK3354+uo1OX15djSLfEdmJ0cn",
+	"R/dgfFkxQSs+ezR7gD/h6Vnjvh87Yps9+v3tfHa8ZrQ0a/fHhhnFc/9JMVrs3L/1JV2B0OoK6cFPF/eP",
+	"feTv8e/OyPwWZlil8qnaJARR5Hm/vpx7KcZ4MptkoBXSoV1lk3lwYnU2IFFgbLi12+rZfBYQB+XZfe77",
+	"s4Zp+eSnNhv8o58SZW2XfIUhEZeRD1vw77cHi3BN/vvV9y+IVOS5dU54GWIxXHVFGO2fNVO7hngsFLM4",
+	"jbl3cnNR2i6oI+Hg9naeCD5IFurDmWHPI6qNXoc8VzKqZjEkDY8FvnmSffXz71/86W3Cp+Pn+cyjA6nq",
+	"/snJjZVfDBkg3s5bo3i8XGEgGOrhDYLYDgm5NqDd4Xoc4jktgW7AsNS8Bz88uffJLujMZaQB1mdZ9Nv5",
+	"7ItPeIfOBBwcWhJsGSWvTOm0Nm+OawnXc73ZULXDyzcqnxeLWW8HWW47bax7ch3mw8za3ktuXTQTT7Qa",
+	"X3q8E7NGh0r4qVJcghCBWnjBcsUoXvloVpgTo2qR27c/OwUT+M/np3/HR9/np38nX0PyUs/bMZA6Mb01",
+	"q7eZ+LfM9F+P9De708DURjn6h2KT8379do+k6F03Rr2RPvMrIm1Dt18PoWxrBYPUJbOh29YN0zd+fDp3",
+	"3nWvmk4KhD4VubrkKLX7Om/txwwI+KE5OCdQHVVSRt3Th/20tSAjq2y8hvPp6IwO3zql5x36npLIM4Ol",
+	"U/fUmO6kuGyhwxv25aTq5T1kJCH4OaW47GXat7v7ye5uXw8ilTQ2PLncRfeJv6taQDZlv7gIpt/EU/ER",
+	"+R9Zo7Zqa2WyVNgXzsB1NKez8TQYwogDYQJ27t7tLvzuXbfnXJMlu0QOSgU27KLj7t2j2Scvkm5Dym9K",
+	"hBSZwFKOF4xEpsZbGfWjllG/OHnwya7mlUvx+drFS/JyR34UIVfc9UTwwHNqEWXvG+U/PR+VRoqOxHf/",
+	"IHscJ9PQg+L6d1wbawVhLsWJNwO3+jd5Olz4QpgnZEKVy8AlH7nY9MOTolwlIQp57d/lzhmr0ovZSG2c",
+	"B2hnYTRXUmuiGD7M4Y3Z0wziTDBWcO+LiylSC+2OWyPETmnvkn99eIbzDrXY9D4HMr3AmMAY66kzYhpv",
+	"B/+tWTuowM1fGS/2Gx+j9oTDu7hpNKv4U1xGOlSsdvml501xOiBxzJHnk1bpuS/SBp9cNUSL+3mvhFuS",
+	"lKPn4292Z0+m6LWtNUW1o1K6bQtfoypuT+h7pxa/pmdSLkzvzbuWoHpwfEML4pPxfhS84eHJw/cHQbwL",
+	"L6Qhz4Ddf8IcaujIR4xojNkcx5mNx7iOGGM7rfcNWTUZSNtuwHO4m7kIJjcvV9gHOXeK7WhPBUg/LvmH",
+	"xlyb1rl85ZyrXGaiAStbtMunTV7Xj4UJ9cxWz20xoTiDC/oOoCHNInlOqLECxr2Tk5N2tuB7JydDNquS",
+	"b7g50Ij22jueQfnLCJgj8qNmjVuafUCFS4QXUWC+Yhdc1jp0GgAMhkjBdWMGs+EE3oO5Mnrpu/RhKRth",
+	"TRmiJXGMtavnXdEVF+44oGvhhp7jYRCYpNqnb/eIdY7yiOsQy+92xz9oH5yNmppW3JYlNTQXOevCpDit",
+	"5mCpTiqLYePI6wFO0XVz+pe/FD/wdfQuVer3qgObqQTnb0utGb5LuaKME8RxV/C0LYjbH8dFcGCFc1eF",
+	"CcsC7UgICKFlw5XSMjbMMFW67tdkTV1pTR3Kj0WiBoiSUlwXvbcM41aKvpYU3SWoEY5w7Dx3psjMKd7Q",
+	"Ykm9bHOEYiYnf9dz5R2F3qP0jKzFL/LDspdbWfm9y8oxeU9yNu+lePyXl40XzeG5omzcT2Z5KxLfisQ3",
+	"LxIn6Mzde/Zt5Ph3JOBYDO7dF99Ayz+QO2qT6gDj9Bw7kLbmno9va0cPJO47H4s1fNmNlY2+aZ6OW9Qv",
+	"m4lrcR7yWM54YsAzdvwz9gNazFkq7O17nzw8zsrkiz756uhYIpT7gqEhotbOBA00kKwM6dlgFw+C8nEz",
+	"eZ9Pl7JFE4f47Nwi+DoI7nG8p/aEu+PlFvGpu5dEdyjJyAs0A+ABd+LrH9K55F1qou96QS+kYIRtXa5x",
+	"S4u3Tt0t0cEixWd5WaGq6+KT06JD27X7d7MFHbpSUi7HhIqX2GCPUNHc1IN57mlVMar0lS/paTpgPOPZ",
+	"kzgzWitNIa56ABTAy4H+2v8+xVn7j+sT3b6BebFN5uth20Qcqnf5QUr9TJOK7gbTfAVS7RgimDovmd3S",
+	"jl8n2TDg7nrNq/df110bvvB1uDvlpaleA6ShAueZ+CYcZlsTB7heINIPWAYdNtNjPlrSFEHiZWpDsHiH",
+	"y0z6vhXpJuzJsipvuVIdrvFBtWzzQezIL6TI8LYNzngdtHw4vR/j6+fRs00oEiykwecaqVBIiPmAPpp0",
+	"vbJBh814MJdMfJCM3WXrKpYf/47/wPDbt42rmUuMmzNlEqp8L6rKVxfoZ9N1PHQRKzRy2ZUBfLktsNDF",
+	"SdJ6jpg6qq7jeHF70GAcTQFjD5IPSQh9j8h3WJErLznsuuNp8eD5mvLGWKhZPKqe20xZsKmo+IN7n3LB",
+	"FEbV2hyRU+sDahfJNdICVfmagycdalpr6nAYDRzVzuaCcOPzhFps6v/EDrLE1Qtma8PjYOD26nIW+klg",
+	"/vSL4GOLKFDHpstNchlDG/Qvh8/3adkY922NFvdxeba+Z36ZPA5eGA+icJOgaU7cN7uvjhBtsivM+lGp",
+	"2nlN0xHa/pTf9oY42qgKwy7YmFfcK6MY3eg4ZMf2IFQTMN4ylb2CE/4Ufz0iT2m+jlie3Q2XlFTDqSe/",
+	"Roz+VzsaluuIfp6TpSxLeen361ccxjeOnxLjU/yfbZd/WjgYBjK9eKjIry5GM+sDBq+OUTe3dJdMY8lL",
+	"42u1NFWEGlJBJwdrbH4j3gjgRbxo3y9uQJtunyu3OeRv3nj1K/7wa/PO46n8V0hPnSHSs7Mnv3q2z7Xd",
+	"5bknBocCHEY3bJ+6dMT4O/lcKodwi1ZqLGB3kEErBvGtTbI/jSQBLQW7bNC09l8Ig6mC/Q1Zu/rMpkmz",
+	"ub9kbXxy7jmhS1iWPYz+PlPM1TRpnrsGFoxvobresJCtl+2INrKqMAOQG9ENv6RlqYmRkiypIgu25qLo",
+	"IMoFSrh0iRpor4RcGkWK+EIuOwcCXKbzplRng43U+7M9WfbY7LvGfsAtmLKnXDuoxjZrQMf1193E662n",
+	"gH9vb3K7fG1TWqGnoaeR+H0aYqPlMmSzY9uqlAWbPVrSUrM0fDheC77waut18YV7oWkXuE6c72Q9qn49",
+	"9F3plf/Z6Hrb8ZgXsrwAlEvhxUnN2l5OU5bbhLYnFvyOYIdsLgi5st5b/VU07G36QrouxMPriTPY3siC",
+	"Qqp1rK3ofUPsH2GBiiwVY7/1F4seMZOX2fh93MAC99uMDNsae31n9pS3pZKu3SERv2u7xScUKzZTQ/E3",
+	"uMnxAwZlO6vZ4h8ttoyVcrW/p+bhpv48vt/uzMmvZit+Rdb4K9j/fiWfm969emfAZLFnoe/28f9dTj5R",
+	"zn6XILi1B5uNF4fjB/gv3uuOTHFIeJfzvyuPhFf1wla0wSq0eDpom1uF69nL5lazObYus2NvCK9sixtN",
+	"vWHHJKrJkhlnsbMwAZt4znMlsZJvCC/dacM2/UTatusvY15JSbu0LcWTbaRIJcD7Hr8+x4+p3jacf6Az",
+	"JlYY6tsx1bbh74DVnmeK+fa6+D36OMwC19NW26v19SC936BLvRzOg6ufeLygQjeWP/9rxZga+vn4d/if",
+	"c3N3DVqmuoGfj39v/dkewBcCytptwOjXSrHnmut1bQp5GU1lM+SNHmzb4kYP9gtZMDtuO0Fl7MTvSwLZ",
+	"PNkeiM55DmbYdLCR39ymnVUtuXZlMnJagxGzroiRyUq5oWNGc3sObQ23vXXBbStfY+KCEVpiekSyYEwQ",
+	"uYBFt4sHEqrRdhvshNbYnC4h08BVKZkzrVmRjQdeNaD5dk15yCE8IeAIcJiFaKu6Xg1Yy6HGATWdTDMB",
+	"3OBYw8UA1NOmH9vA7uTxNlpLhKUCYqx5sGSGDaFwIk7Qcs7f8f75Sa66ffVQjeHH9ivkd+/kWk8OhjYX",
+	"UIT7DGvoXm3WiyJ/4nkjLkDXr8wX1WHzZfpdUWH7Vl/0qvlPTm2fLiSYKuaD1Rj3cCtoFG+hho2LGESK",
+	"QeHAA+IMmKt+cGbquJBNVFITphip2DiU3RlG/mvI7dwbO5dCM6FrHRJAuzc8VqTWgP7qg3O9YNswl1xG",
+	"Y4dHQiNJrdm+kYewFI3/g9c/ohpxsYs6DJdY3CUvS7RKpKW/FhANIsYAeeVbRdiNHUoGAOG6QXSrMHqy",
+	"KKMzUWbUZLUI/YbQ9Mq2PjU/Nm37xOWeIPEgFpLp+AHXQX7prYagesALnoPDByD4AhtJmIEH2RLT2Rjl",
+	"Azd6Ba3iI7CHN3VF7Zjrtc5Z53B06DdJdINEsGcXhhacEu4/ybiBvRaim84v42/KSKpshHv797EtBsmK",
+	"SCruWiya6LJooE44WXSthPLoWCxbz6P681Qg1bfvYZ+DaZ5+NVrWZSmY1sHOb7UUZ5gnJV0NRJs9cSu7",
+	"GZn+QFl8igyeqNbaEcbrKvNa1ij9OrkEaszDavV+SQZFGFuQBxsCbHXlBKZuIZkj8r+tqlRIBs5yFfVO",
+	"e7lxsYBj3CxkTNrDRhEF4VNiv9puovSzfaQZKzIZSQLLstZrO3zFlHZ+QtpIRVfMWWVdLq+cWRpvGFIo",
+	"1t2jw2EAp4HVKpThlfHUmEKaDBWszJbkSNW72vW2CnvA8PhE6IPedJL8ZG0O3rZGLOXtPRyq1NR7Jdo7",
+	"USoR50hFkWg2n65/oCR0jKsBPM0JVjtpm0xsGYBQn+Douhc5wWZdybVXRcX7YHTOaXLlEX4yrBao+W9D",
+	"NQAhBnZv5tMpCO8IGH2Jontkh7A07wondiO7fKVHsV0OOkBvQ+iZYlH0F0x8LcaOfVj1zV2B3VsNM6B5",
+	"c9ttEOQ1BZ0isRM9SeeScgMRRpkr6Lc0TO11XfwbhV20DpOhTJ11/XeeFPaEunFcme22xPSZDjKTCfXY",
+	"+p52MNUzqSYFPb6OvSFgYa5Onp0aiC8YET++CMJb8+itefTWPHprHr01j96aR2/No7fm0Vvz6Dsxj36Y",
+	"lC4kyzwP9fUPUtUPyK3W8wdK/ZKwDlsvc2prfY9GRBhGS1wQL1GmqKQeTH2GZYO1rFUOl3aBYkZVUi4I",
+	"uKj5SlBkQTX78qGPMfb5x13hYOA10ODBffLqz6df3Lv/y/0vviRrF8Pabvu5q6BF0Fn0jkt+EapR+iwY",
+	"DPOiuSQY1Ct9Qaqw4smSl4xoQJZNo/aEXbBSVkzZMEkCOlhfK4SCyo8dcixXYtp8I4tdh3DQRQ9R0SaZ",
+	"JtaWC6p2iQDZfr6oLpKNhGPstqivOL69UdN6OsS4v2H79iqZoGugcvIYvewNKUaAw9iTEnIxWnp0ElfN",
+	"+YOybIIQOTJr2NNHEwTXtbu5g4NthTT+/H2qdiyP+OTBw2M7D8FT3GjiKG6bQaMVE5ljC9lCFrvMGXns",
+	"OG0uW6idqsUwk326ZXkNZwkhccfgc32HcFtcGUTN2MJVsEW9WqGts2etAX7PcDwuxQdinE/sesf45tWp",
+	"ww4enNmvm26lO1yfa0Q2ZIggw0rnd3A/qNihZrypqNh56x/Iipu6tDi0KaJullOHSvE9PuvVsWFN7qVr",
+	"EesrNjq787tFC76AyMrX0hbFQAZGsxXT00vaoV9vRcOCR0uU2/UmVufmnfRm4HbZbkJj8ayYysxW2BPV",
+	"Ok1o2KHEHt3bbI3/IlfCS5eJNM1h+wkcGoZwtPdmUBHLwquh8wCbDkxmELLtLDudV8GCbTvma/ub8lkb",
+	"BGOFdmJkYPnec+TMJhJm6jRHDTGZUJi0ilZSBW+VJl+HabnCYDQbsOuiSVUTJQzncm7jy/ohw0IaYDxs",
+	"ybfz6HpjRScxsktEwQUm5cVIyygf8N4ivRaDz6R63X6AHH3o+T5KstdGu2bCRIttjDyhqtr0MMdErqdU",
+	"V7PNsGUqK1NFoeE52ym2ms1nNF/i/7ZLpuD/S/XbzF6Q0wq3w04RzEE/OfSvY8SBU7CSnuZxQJsKJ/3z",
+	"7yOZyIfhqKrDoKiqBAxVlYTgVcVysNNrQh11utPk7Sru8mgeQZCQMUJwaPOhRWYHGyGAdE5GqyTN3u75",
+	"2s+UZYnXpzymSLXWPgBAa7fMgmy4s5ENQR8aHJhMOw2Ci9juwEC3e2Cg2yvBMCG9+B8vf/i/bM64jykr",
+	"+X7/qo334YubzptJ+pkQdyFzeVMEetozWa8EdZx1Z1T8voFy2Leb9KE3aUyr1WSJa0gIkn+khL23hZ9v",
+	"Cz9/goWfrfpin3LiM1trn56nd2C9kbGtB/1AL1+30sVMM85tM/eCce3nDcgGtTMsmPt9ur8GKFRVlaRF",
+	"TrWBP1xw8zt++jDbs8QDNoIZhU3EcIIl+GjvCwWOeyAzhmzDbkJdLzZc6w9dP49kpMl4euoUkBY2bt+U",
+	"/yhvyt/4w6cJJYpedg+nlarwTE6wd9FLsxVJc9cxLRxpZ0vGJsVALRlq5DFATbH7YOiq6M7Zu/alxYuy",
+	"z8GfhGsMeZp35kDjHgyLwidZ8xVIn0vGMJcf8jOzpnYcyOSnjW/s/N7tCJqwC57bB/tN4zSIgAwU7fIY",
+	"esbYzQZRISQO9RkYwGEVKcujaUKPuthr0OXQ28JIG4Vhc9g2Z9ZqIpVLaoewjCCvtxXpWIvxlaAYbz2r",
+	"23AGT1In+nZX6e2oKyUvo1R/8AkT5GmysaYMGvL4kkupzLobwpGGesMD+Q8DHMMEwH8upAkruNMswfl6",
+	"vBHwnGEkQQ4Zp8UMUYn2/IYnnzRsGJKR04rm3OxGolZappWrhwntCZC58cCYFsE0O9FddwzY1JJfyKha",
+	"2xKY3SAvur1B/2gFuWJ+M5UGUhflAswPw54Dp3nOqpDlW+TUMIFvtU7BIV6KxWeTDTxYc1EwYK9MmD57",
+	"1UfkMaAsr/FNqP3ysabK3oLY1NXF2Pi/7RtOm/WDlcSGkLS66PCDtOmz5aWw4Z/usqSKNe7y+PCjaFky",
+	"G7/VisjrX0zN8spdyO+HRqM50bJxkFXsH8z2k0sihZu7cZkFayziaM1sd+fsb6NWgpoC2Vdxk9yN0DxN",
+	"3X/ocZq44dva2De4yx+lSuZ25MNoZs4QPtm1IELptwD3RA8DP80UFv99bXK5YX0lLdBaD3tzf1FZscf1",
+	"dHv9ket2Lu/g7f30R9TwLN+Sy56q1xz6K2h8On2TgcjFtOEbaoY1vqeugZ6sVIDGF2sUizh3tIs1ujcn",
+	"D/DquHfiIh/m6Ipc2LAl1k52gQJcOKRRXQd7w7kuBavMute+r2U6kRAatFfgPK9Qo9QYBcaNDpy4ZGJl",
+	"4M25NLwqeaOjxDhxAC1bEkdHYRhQL58x5nF9w9ql38G0JA/ghyZzu209j49uMNjkF5R4VUMhYCM5IVol",
+	"NEqA0Pjo43UENoonnn4+NdWu61wXtqsTYONXcKj9srXBt4rNH0uxGdpnS4lUrZixXlMoEBWspDs9oNjg",
+	"u+xwUtCIpF7aljca/d4bvh0E3/iGuSBeVlaE+ioLuRTaqDo3bwTFaLpoYUf9AHkfIzjslfvYN0kHdCbi",
+	"Ld1QbwTFqyfE2CUfuZOM6Blj/grS9Wplk2N3NviNcK24ILVARW1JNjxXMrOZej2zOrItN3SHxSeIkeQ3",
+	"piRZ1KatQ6IPlTYQrWkj8t3d+EZQQ0pGtSHPOfgGP2NXtVytmGCa6ywd0PKt/Yql8/wN7EKQ4N+us6/J",
+	"9b5r/XnYeTEI+dkTgJviQ1HJdVRvqwf7e4tU/mRuu/5ZtKejQzWtjZh6LzrvQrC60BX8vuJmXS+Ocrk5",
+	"9n57xysZfPiOC8o2UuC34phW/FhXLD++uLfnhr0GvyIJdnV7Qf+BLuiIDuC0hI23ZrvO3g/cy/blZayo",
+	"oOUL3CotiZcaPSc6BMBXikvFzQ6NIAULMj5K/nNiVI1Wy8In6WE24v/56d+PyNkS/k++JifzEPsE10tq",
+	"zgE9p+9Ttdf3+3UAaeDZwUhScA2lihDEDd1+PQTgVugRj9YDnUv/uF6cnSCl/p65Ow81OdiPvqFSE7al",
+	"Odh8qY688NA+573wOm4fstqTBO90dEaH77Z2OqXKvqvX266K2tVVbZ6cPf6JnUw5qcexiQ9iPWQkIbia",
+	"++ft7n6yu5uoplRJONOcluUu4t7+OmgB2ThocBGnZetbyf5H1lhuEC7U2rDA36TCt5hw4XAdzcmXnRS4",
+	"rGQbW2jQTnf3bnfhd++6PeeaLNklclAqsGEXHXfvHt26jN66jH6CLqO29LQ/kbVoqhWPn86JT9JOuDn+",
+	"HSqcjVSfxswhHZt3Ye3g1qxe7hoG3i6Lyk0Qp/oR7twcETCrK4aGWM0umKIlyalmwUmHa7LB0tG6znPG",
+	"ikdvRNaCpHkj+LxTapK8qU9OHjBycqfbx9otIs7b74uiKn7CqGXyNXkzezPrjaTYRkLsID4oY/OiZugP",
+	"hb32Dvv/hHG/V72tAysMGlfWtKoYXGu6Xi55zi3KMX84XclOqigh8QuGVmwYcFRNuPFlPrm2KbbsrsBl",
+	"jYCkhO7+/X7WbOE+2fu0Qy7pRJpAeKN5NBsrDVheTrKvfv732fxfOUzqqmEn1+WBo2O/nd+yjA/AMj44",
+	"07gNKvq4JcR3WQL/XS8ofop8IQ15BofhmpKUC1POU3anARlpkmxkQqV0OsBoHONwXgjedSE8lQT7/HO6",
+	"fb0V3/Gl90vatBhiIk6RG8zt0kwV1RXhRhOIw989gew1NoVQKeW5844Tnxlvre/HH6WtcI+9BNqOQLoV",
+	"BW5FgaFz7NMDd/WW4dDbHkkPX9O3V9DtFfT+r6Bby8u/huUlqCpJBjYqMWC1jD1VMWzJia4AH7iiTfbK",
+	"C1sNyDHAOdxQXBMLupFRWnrrB88KaOE/2FztxbyVdRDmdmlmfYVmumEuBsC9QyfCtiJt7EZUqptThPap",
+	"ak30G98wUCNZSSvNiv1zhCnOlolk3LAJ/f1LT4Z13LgOmzBmeXGVTD4ZGaufm8elBg/VVZZSzUNExZcn",
+	"R+SJPZXY4suTIfnKofD2efWTE/kcf+l7U8+JFDnry3iYic0yMORxQ2f2VuK7lfhuJb5bie+mJb6/uWuq",
+	"Y8LpBqJEbMrLf9YBEfg5jsjyWmGo9U+AVv7LOYN//wy8H+shuvu7VuXs0WxtTPXo+LiUOS3XUptjTADY",
+	"fNOdj3Cn0JUdwV1IleIXGCbx89v/OwDpT7FtJ48BAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
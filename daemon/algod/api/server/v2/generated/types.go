@@ -252,6 +252,25 @@ type BuildVersion struct {
 	Minor       uint64 `json:"minor"`
 }
 
+// CatchupPeerStats defines model for CatchupPeerStats.
+type CatchupPeerStats struct {
+
+	// The rank of the peers tried first, lower is better. Absent when there are no peers.
+	BestRank *uint64 `json:"best-rank,omitempty"`
+
+	// The number of peers demoted after failed or invalid block downloads.
+	FailingPeers uint64 `json:"failing-peers"`
+
+	// The number of peers known to the catchup service.
+	Peers uint64 `json:"peers"`
+
+	// The number of distinct ranks the peers are grouped by.
+	RankGroups uint64 `json:"rank-groups"`
+
+	// The number of block requests for which a peer was ranked.
+	RankedRequests uint64 `json:"ranked-requests"`
+}
+
 // DryrunRequest defines model for DryrunRequest.
 type DryrunRequest struct {
 	Accounts []Account     `json:"accounts"`
@@ -313,6 +332,34 @@ type DryrunTxnResult struct {
 	Logs             *[][]byte            `json:"logs,omitempty"`
 }
 
+// EquivocationEvidence defines model for EquivocationEvidence.
+type EquivocationEvidence struct {
+
+	// The address of the account whose participation key signed both votes.
+	Address string `json:"address"`
+
+	// Unix timestamp, in seconds, of the time the node detected the equivocation.
+	Detected uint64 `json:"detected"`
+
+	// The period of the votes.
+	Period uint64 `json:"period"`
+
+	// The digests of the two blocks the account voted for.
+	Proposals [][]byte `json:"proposals"`
+
+	// The round of the votes.
+	Round uint64 `json:"round"`
+
+	// The step of the votes.
+	Step uint64 `json:"step"`
+
+	// The msgpack encoding of the pair of votes, with the credential and the signatures of the account, which any node can verify.
+	Votes []byte `json:"votes"`
+
+	// The weight of the votes of the account in the step.
+	Weight uint64 `json:"weight"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Data    *string `json:"data,omitempty"`
@@ -340,6 +387,139 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// FeeEstimate defines model for FeeEstimate.
+type FeeEstimate struct {
+
+	// The estimated fee per byte, in micro-Algos.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// The number of rounds within which the transaction is expected to be committed.
+	Rounds uint64 `json:"rounds"`
+}
+
+// MiniAssetHolding defines model for MiniAssetHolding.
+type MiniAssetHolding struct {
+
+	// The account holding the asset.
+	Address string `json:"address"`
+
+	// \[a\] number of units held.
+	Amount uint64 `json:"amount"`
+
+	// \[f\] whether or not the holding is frozen.
+	IsFrozen bool `json:"is-frozen"`
+}
+
+// NetworkBan defines model for NetworkBan.
+type NetworkBan struct {
+
+	// The banned host or host:port address.
+	Address string `json:"address"`
+
+	// Unix timestamp, in seconds, of the time the ban expires.
+	Expires uint64 `json:"expires"`
+}
+
+// NetworkPeer defines model for NetworkPeer.
+type NetworkPeer struct {
+
+	// The address of the peer. For outbound connections this is the relay address, and for inbound connections this is the public address the peer advertised, if any.
+	Address string `json:"address"`
+
+	// Unix timestamp, in seconds, of the time the connection was established.
+	ConnectedSince uint64 `json:"connected-since"`
+
+	// Whether the connection was initiated by the peer or by this node.
+	Direction string `json:"direction"`
+
+	// Identifies the connection for as long as it stays open.
+	Id uint64 `json:"id"`
+
+	// The instance name the peer reported.
+	InstanceName *string `json:"instance-name,omitempty"`
+
+	// The round trip time, in nanoseconds, of the last ping answered by the peer. Absent if no ping was answered recently.
+	PingRoundTripTime *uint64 `json:"ping-round-trip-time,omitempty"`
+
+	// The host of the remote end of the connection.
+	RemoteHost *string `json:"remote-host,omitempty"`
+
+	// The telemetry GUID the peer reported.
+	TelemetryGuid *string `json:"telemetry-guid,omitempty"`
+
+	// The traffic exchanged with the peer, per message tag.
+	Traffic []NetworkPeerTraffic `json:"traffic"`
+
+	// The negotiated version of the network protocol.
+	Version string `json:"version"`
+}
+
+// NetworkPeerTraffic defines model for NetworkPeerTraffic.
+type NetworkPeerTraffic struct {
+
+	// The number of bytes received from the peer.
+	BytesReceived uint64 `json:"bytes-received"`
+
+	// The number of bytes sent to the peer.
+	BytesSent uint64 `json:"bytes-sent"`
+
+	// The number of messages received from the peer.
+	MessagesReceived uint64 `json:"messages-received"`
+
+	// The number of messages sent to the peer.
+	MessagesSent uint64 `json:"messages-sent"`
+
+	// The message tag.
+	Tag string `json:"tag"`
+}
+
+// ParticipationKey defines model for ParticipationKey.
+type ParticipationKey struct {
+
+	// Address the key was generated for.
+	Address string `json:"address"`
+
+	// When registered, this is the first round it may be used.
+	EffectiveFirstValid *uint64 `json:"effective-first-valid,omitempty"`
+
+	// When registered, this is the last round it may be used.
+	EffectiveLastValid *uint64 `json:"effective-last-valid,omitempty"`
+
+	// The key's ParticipationID.
+	Id string `json:"id"`
+
+	// AccountParticipation describes the parameters used by this account in consensus protocol.
+	Key AccountParticipation `json:"key"`
+
+	// Round when this key was last used to propose a block.
+	LastBlockProposal *uint64 `json:"last-block-proposal,omitempty"`
+
+	// Round when this key was last used to generate a compact certificate signature.
+	LastCompactCertificate *uint64 `json:"last-compact-certificate,omitempty"`
+
+	// Round when this key was last used to vote.
+	LastVote *uint64 `json:"last-vote,omitempty"`
+}
+
+// ParticipationKeyExpiry defines model for ParticipationKeyExpiry.
+type ParticipationKeyExpiry struct {
+
+	// The online account.
+	Address string `json:"address"`
+
+	// Whether a participation key valid past vote-last-valid is installed on this node.
+	RenewalInstalled bool `json:"renewal-installed"`
+
+	// First valid round of the scheduled key registration renewing the participation key, if any.
+	RenewalScheduledRound *uint64 `json:"renewal-scheduled-round,omitempty"`
+
+	// Number of rounds left before the registered participation key expires.
+	RoundsRemaining uint64 `json:"rounds-remaining"`
+
+	// Last round for which the registered participation key is valid.
+	VoteLastValid uint64 `json:"vote-last-valid"`
+}
+
 // PendingTransactionResponse defines model for PendingTransactionResponse.
 type PendingTransactionResponse struct {
 
@@ -386,6 +566,47 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// PeriodTimeline defines model for PeriodTimeline.
+type PeriodTimeline struct {
+
+	// The time the node saw a quorum of cert votes.
+	CertThreshold *uint64 `json:"cert-threshold,omitempty"`
+
+	// The period.
+	Period uint64 `json:"period"`
+
+	// The time the node received the first proposal payload in the period. It is zero when the payload arrived before the node entered the round.
+	Proposal *uint64 `json:"proposal,omitempty"`
+
+	// The time the node saw a quorum of soft votes.
+	SoftThreshold *uint64 `json:"soft-threshold,omitempty"`
+
+	// The time the node entered the period.
+	Start uint64 `json:"start"`
+}
+
+// RoundTimeline defines model for RoundTimeline.
+type RoundTimeline struct {
+
+	// The time the node spent assembling its proposal. Absent when it didn't assemble any.
+	BlockAssembly *uint64 `json:"block-assembly,omitempty"`
+
+	// The time from entering the round to entering the next one. Absent while the round is in progress.
+	Duration *uint64 `json:"duration,omitempty"`
+
+	// The time the node spent writing the agreed block to the ledger. Absent until the block is written.
+	LedgerWrite *uint64 `json:"ledger-write,omitempty"`
+
+	// The periods of the round, in the order the node entered them.
+	Periods []PeriodTimeline `json:"periods"`
+
+	// The round.
+	Round uint64 `json:"round"`
+
+	// Unix timestamp, in nanoseconds, of the time the node entered the round.
+	Start uint64 `json:"start"`
+}
+
 // StateDelta defines model for StateDelta.
 type StateDelta []EvalDeltaKeyValue
 
@@ -413,6 +634,16 @@ type TealValue struct {
 	Uint uint64 `json:"uint"`
 }
 
+// TransactionGroupResult defines model for TransactionGroupResult.
+type TransactionGroupResult struct {
+
+	// The reason the group was rejected. Absent if the group was added to the transaction pool.
+	Error *string `json:"error,omitempty"`
+
+	// The IDs of the transactions of the group.
+	TxIds []string `json:"tx-ids"`
+}
+
 // Version defines model for Version.
 type Version struct {
 	Build          BuildVersion `json:"build"`
@@ -490,9 +721,53 @@ type TxType string
 // AccountResponse defines model for AccountResponse.
 type AccountResponse Account
 
+// AdmissionFeeResponse defines model for AdmissionFeeResponse.
+type AdmissionFeeResponse struct {
+
+	// Set when the transaction pool is full, to the fee per byte a transaction needs to exceed in order to evict the lowest paying pending transaction group.
+	EvictionFeePerByte *uint64 `json:"eviction-fee-per-byte,omitempty"`
+
+	// The minimum fee per byte required by the transaction pool, which grows when the pool holds more than a block worth of transactions.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// The minimum transaction fee (not per byte) required for the
+	// txn to validate for the current network protocol.
+	MinFee uint64 `json:"min-fee"`
+
+	// The maximum number of transactions in the transaction pool.
+	PoolCapacity uint64 `json:"pool-capacity"`
+
+	// The number of transactions in the transaction pool.
+	PoolSize uint64 `json:"pool-size"`
+}
+
+// ApplicationAccountsResponse defines model for ApplicationAccountsResponse.
+type ApplicationAccountsResponse struct {
+
+	// The addresses of the accounts.
+	Accounts []string `json:"accounts"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round at which the results were computed.
+	Round uint64 `json:"round"`
+}
+
 // ApplicationResponse defines model for ApplicationResponse.
 type ApplicationResponse Application
 
+// AssetBalancesResponse defines model for AssetBalancesResponse.
+type AssetBalancesResponse struct {
+	Balances []MiniAssetHolding `json:"balances"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round at which the results were computed.
+	Round uint64 `json:"round"`
+}
+
 // AssetResponse defines model for AssetResponse.
 type AssetResponse Asset
 
@@ -520,6 +795,19 @@ type CatchpointStartResponse struct {
 	CatchupMessage string `json:"catchup-message"`
 }
 
+// CompactCertResponse defines model for CompactCertResponse.
+type CompactCertResponse struct {
+
+	// The msgpack encoding of the compact certificate.
+	Cert []byte `json:"cert"`
+
+	// The round of the certified block header.
+	CertRound uint64 `json:"cert-round"`
+
+	// The round of the block which holds the compact certificate transaction.
+	ConfirmedRound uint64 `json:"confirmed-round"`
+}
+
 // CompileResponse defines model for CompileResponse.
 type CompileResponse struct {
 
@@ -530,6 +818,46 @@ type CompileResponse struct {
 	Result string `json:"result"`
 }
 
+// DetailedNodeStatusResponse defines model for DetailedNodeStatusResponse.
+type DetailedNodeStatusResponse struct {
+
+	// The catchpoint that is being caught up to, if any.
+	Catchpoint *string `json:"catchpoint,omitempty"`
+
+	// How the catchup service ranks the peers it fetches blocks from.
+	CatchupPeers CatchupPeerStats `json:"catchup-peers"`
+
+	// Time spent catching up so far, in nanoseconds. Zero when the node is not catching up.
+	CatchupTime uint64 `json:"catchup-time"`
+
+	// The number of peers connected to this node.
+	InboundPeers uint64 `json:"inbound-peers"`
+
+	// The last round flushed to persistent storage. The difference with last-round is the ledger commit lag.
+	LastCommittedRound uint64 `json:"last-committed-round"`
+
+	// The last round added to the ledger.
+	LastRound uint64 `json:"last-round"`
+
+	// Why the node is not ready to serve requests.
+	NotReadyReason *string `json:"not-ready-reason,omitempty"`
+
+	// The number of peers this node is connected to.
+	OutboundPeers uint64 `json:"outbound-peers"`
+
+	// The number of transactions in the transaction pool.
+	PendingTransactions uint64 `json:"pending-transactions"`
+
+	// Whether the node is ready to serve requests, as reported by the /ready endpoint.
+	Ready bool `json:"ready"`
+
+	// Time since the last round was added to the ledger, in nanoseconds.
+	TimeSinceLastRound uint64 `json:"time-since-last-round"`
+
+	// The maximal number of transactions in the transaction pool.
+	TransactionPoolSize uint64 `json:"transaction-pool-size"`
+}
+
 // DryrunResponse defines model for DryrunResponse.
 type DryrunResponse struct {
 	Error string `json:"error"`
@@ -539,6 +867,42 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// EquivocationsResponse defines model for EquivocationsResponse.
+type EquivocationsResponse struct {
+	Equivocations []EquivocationEvidence `json:"equivocations"`
+}
+
+// FeeEstimateResponse defines model for FeeEstimateResponse.
+type FeeEstimateResponse struct {
+
+	// The fee estimates, ordered by increasing number of rounds.
+	Estimates []FeeEstimate `json:"estimates"`
+
+	// The round of the latest block the estimates are based on.
+	LastRound uint64 `json:"last-round"`
+
+	// The minimum transaction fee (not per byte) required for the
+	// txn to validate for the current network protocol.
+	MinFee uint64 `json:"min-fee"`
+}
+
+// NetworkBanResponse defines model for NetworkBanResponse.
+type NetworkBanResponse struct {
+
+	// The number of connected peers which got disconnected by the ban.
+	DisconnectedPeers uint64 `json:"disconnected-peers"`
+}
+
+// NetworkBansResponse defines model for NetworkBansResponse.
+type NetworkBansResponse struct {
+	Bans []NetworkBan `json:"bans"`
+}
+
+// NetworkPeersResponse defines model for NetworkPeersResponse.
+type NetworkPeersResponse struct {
+	Peers []NetworkPeer `json:"peers"`
+}
+
 // NodeStatusResponse defines model for NodeStatusResponse.
 type NodeStatusResponse struct {
 
@@ -563,6 +927,9 @@ type NodeStatusResponse struct {
 	// CatchupTime in nanoseconds
 	CatchupTime uint64 `json:"catchup-time"`
 
+	// Online accounts with participation keys on this node whose registered key expires within the configured number of rounds
+	ExpiringParticipationKeys *[]ParticipationKeyExpiry `json:"expiring-participation-keys,omitempty"`
+
 	// The last catchpoint seen by the node
 	LastCatchpoint *string `json:"last-catchpoint,omitempty"`
 
@@ -588,6 +955,12 @@ type NodeStatusResponse struct {
 	TimeSinceLastRound uint64 `json:"time-since-last-round"`
 }
 
+// ParticipationKeyResponse defines model for ParticipationKeyResponse.
+type ParticipationKeyResponse ParticipationKey
+
+// ParticipationKeysResponse defines model for ParticipationKeysResponse.
+type ParticipationKeysResponse []ParticipationKey
+
 // PendingTransactionsResponse defines model for PendingTransactionsResponse.
 type PendingTransactionsResponse struct {
 
@@ -598,6 +971,18 @@ type PendingTransactionsResponse struct {
 	TotalTransactions uint64 `json:"total-transactions"`
 }
 
+// PostParticipationResponse defines model for PostParticipationResponse.
+type PostParticipationResponse struct {
+
+	// encoding of the participation ID.
+	PartId string `json:"partId"`
+}
+
+// PostTransactionGroupsResponse defines model for PostTransactionGroupsResponse.
+type PostTransactionGroupsResponse struct {
+	Results []TransactionGroupResult `json:"results"`
+}
+
 // PostTransactionsResponse defines model for PostTransactionsResponse.
 type PostTransactionsResponse struct {
 
@@ -618,6 +1003,11 @@ type ProofResponse struct {
 	Stibhash []byte `json:"stibhash"`
 }
 
+// RoundTimelinesResponse defines model for RoundTimelinesResponse.
+type RoundTimelinesResponse struct {
+	Rounds []RoundTimeline `json:"rounds"`
+}
+
 // SupplyResponse defines model for SupplyResponse.
 type SupplyResponse struct {
 
@@ -658,6 +1048,16 @@ type TransactionParametersResponse struct {
 	MinFee uint64 `json:"min-fee"`
 }
 
+// TransactionsResponse defines model for TransactionsResponse.
+type TransactionsResponse struct {
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// The matching transactions, with the round in which they were committed.
+	Transactions []PendingTransactionResponse `json:"transactions"`
+}
+
 // VersionsResponse defines model for VersionsResponse.
 type VersionsResponse Version
 
@@ -678,6 +1078,26 @@ type GetPendingTransactionsByAddressParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// GetApplicationAccountsParams defines parameters for GetApplicationAccounts.
+type GetApplicationAccountsParams struct {
+
+	// Maximum number of results to return, at most 1000. Defaults to 100.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+}
+
+// GetAssetBalancesParams defines parameters for GetAssetBalances.
+type GetAssetBalancesParams struct {
+
+	// Maximum number of results to return, at most 1000. Defaults to 100.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+}
+
 // GetBlockParams defines parameters for GetBlock.
 type GetBlockParams struct {
 
@@ -692,9 +1112,60 @@ type GetProofParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+
+	// Replay the committed rounds starting at this round before streaming new events.
+	Round *uint64 `json:"round,omitempty"`
+
+	// Only send these types of events. Defaults to all of them.
+	Types *[]string `json:"types,omitempty"`
+
+	// Only send transactions involving one of these addresses.
+	Address *[]string `json:"address,omitempty"`
+
+	// Only send transactions calling or creating one of these applications.
+	ApplicationId *[]uint64 `json:"application-id,omitempty"`
+
+	// Only send transactions transferring, configuring, creating or freezing one of these assets.
+	AssetId *[]uint64 `json:"asset-id,omitempty"`
+}
+
 // TealDryrunJSONBody defines parameters for TealDryrun.
 type TealDryrunJSONBody DryrunRequest
 
+// SearchForTransactionsParams defines parameters for SearchForTransactions.
+type SearchForTransactionsParams struct {
+
+	// Only include transactions sent or received by this address.
+	Address *string `json:"address,omitempty"`
+	TxType  *string `json:"tx-type,omitempty"`
+
+	// Asset ID
+	AssetId *uint64 `json:"asset-id,omitempty"`
+
+	// Application ID
+	AppId *uint64 `json:"app-id,omitempty"`
+
+	// Specifies a prefix which must be contained in the note field.
+	NotePrefix *string `json:"note-prefix,omitempty"`
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
 // GetPendingTransactionsParams defines parameters for GetPendingTransactions.
 type GetPendingTransactionsParams struct {
 
@@ -712,5 +1183,22 @@ type PendingTransactionInformationParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// GetConfirmedTransactionParams defines parameters for GetConfirmedTransaction.
+type GetConfirmedTransactionParams struct {
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
+// WaitForTransactionParams defines parameters for WaitForTransaction.
+type WaitForTransactionParams struct {
+
+	// Maximum number of seconds to wait for, at most 60. Defaults to 60.
+	Timeout *uint64 `json:"timeout,omitempty"`
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
 // TealDryrunRequestBody defines body for TealDryrun for application/json ContentType.
 type TealDryrunJSONRequestBody TealDryrunJSONBody
@@ -24,29 +24,62 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/indexer"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 )
 
+// feeEstimateTargets are the numbers of rounds for which GetFeeEstimate estimates fees.
+var feeEstimateTargets = []uint64{1, 3, 10}
+
+// defaultHoldingsLimit and maxHoldingsLimit are the default and maximum numbers of
+// accounts returned by GetAssetBalances and GetApplicationAccounts.
+const (
+	defaultHoldingsLimit = 100
+	maxHoldingsLimit     = 1000
+)
+
+// maxTransactionWait is the longest time WaitForTransaction waits for a transaction.
+const maxTransactionWait = time.Minute
+
+// transactionWaitRecheckInterval is the delay after which WaitForTransaction looks up
+// again a transaction whose last valid round was committed while it was still pending.
+const transactionWaitRecheckInterval = 100 * time.Millisecond
+
 const maxTealSourceBytes = 1e5
 const maxTealDryrunBytes = 1e5
 
+// maxTransactionBatchGroups is the largest number of transaction groups RawTransactionBatch accepts.
+const maxTransactionBatchGroups = 1024
+
+// maxParticipationKeyBytes bounds the size of an uploaded participation key database.
+const maxParticipationKeyBytes = 50 * 1024 * 1024
+
+// maxPeerBanDuration is the longest ban BanNetworkPeer places on a peer.
+const maxPeerBanDuration = 7 * 24 * time.Hour
+
 // Handlers is an implementation to the V2 route handler interface defined by the generated code.
 type Handlers struct {
 	Node     NodeInterface
@@ -58,15 +91,145 @@ type Handlers struct {
 type NodeInterface interface {
 	Ledger() *data.Ledger
 	Status() (s node.StatusReport, err error)
+	DetailedStatus() (s node.DetailedStatusReport, err error)
 	GenesisID() string
 	GenesisHash() crypto.Digest
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
+	BroadcastSignedTxGroups(txgroups [][]transactions.SignedTxn) []error
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
+	GetConfirmedTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
+	AdmissionFee() pools.AdmissionFee
+	EstimateFees(targets []uint64) []pools.FeeEstimate
 	StartCatchup(catchpoint string) error
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
+	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
+	ListParticipationKeys() ([]account.ParticipationRecord, error)
+	GetParticipationKey(account.ParticipationID) (account.ParticipationRecord, error)
+	RemoveParticipationKey(account.ParticipationID) error
+	SubscribeEvents(filter node.EventFilter) (*node.EventSubscription, error)
+	BlockEvents(round basics.Round, filter node.EventFilter) ([]node.Event, error)
+	Indexer() (*indexer.Indexer, error)
+	PeersInfo() []network.PeerInfo
+	DisconnectPeer(id uint64) bool
+	BanPeer(addr string, duration time.Duration) int
+	UnbanPeer(addr string) bool
+	BannedPeers() []network.PeerBan
+	Equivocations() []agreement.EquivocationEvidence
+	AgreementTimeline(max int) []agreement.RoundTimeline
+}
+
+func convertParticipationRecord(record account.ParticipationRecord) private.ParticipationKey {
+	participationKey := private.ParticipationKey{
+		Id:      record.ParticipationID.String(),
+		Address: record.Account.String(),
+		Key: private.AccountParticipation{
+			VoteFirstValid:            uint64(record.FirstValid),
+			VoteLastValid:             uint64(record.LastValid),
+			VoteKeyDilution:           record.KeyDilution,
+			VoteParticipationKey:      record.VoteID[:],
+			SelectionParticipationKey: record.SelectionID[:],
+		},
+	}
+
+	// Only set once the key has been registered on chain.
+	if record.EffectiveFirst != 0 {
+		participationKey.EffectiveFirstValid = numOrNil(uint64(record.EffectiveFirst))
+	}
+	if record.EffectiveLast != 0 {
+		participationKey.EffectiveLastValid = numOrNil(uint64(record.EffectiveLast))
+	}
+
+	// Optional fields.
+	participationKey.LastVote = numOrNil(uint64(record.LastVote))
+	participationKey.LastBlockProposal = numOrNil(uint64(record.LastBlockProposal))
+	participationKey.LastCompactCertificate = numOrNil(uint64(record.LastCompactCertificate))
+
+	return participationKey
+}
+
+// GetParticipationKeys Return a list of participation keys
+// (GET /v2/participation)
+func (v2 *Handlers) GetParticipationKeys(ctx echo.Context) error {
+	partKeys, err := v2.Node.ListParticipationKeys()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingParticipationKeys, v2.Log)
+	}
+
+	response := make(private.ParticipationKeysResponse, 0, len(partKeys))
+	for _, record := range partKeys {
+		response = append(response, convertParticipationRecord(record))
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AddParticipationKey Add a participation key to the node
+// (POST /v2/participation)
+func (v2 *Handlers) AddParticipationKey(ctx echo.Context) error {
+	buf := new(bytes.Buffer)
+	ctx.Request().Body = http.MaxBytesReader(nil, ctx.Request().Body, maxParticipationKeyBytes)
+	_, err := buf.ReadFrom(ctx.Request().Body)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	partKeyBinary := buf.Bytes()
+
+	if len(partKeyBinary) == 0 {
+		return badRequest(ctx, nil, errParticipationKeyEmpty, v2.Log)
+	}
+
+	partID, err := v2.Node.InstallParticipationKey(partKeyBinary)
+	if err != nil {
+		if errors.Is(err, node.ErrInvalidParticipationKey) || errors.Is(err, account.ErrAlreadyInserted) {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := private.PostParticipationResponse{PartId: partID.String()}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// DeleteParticipationKeyByID Delete a given participation key by id
+// (DELETE /v2/participation/{participation-id})
+func (v2 *Handlers) DeleteParticipationKeyByID(ctx echo.Context, participationID string) error {
+	decodedParticipationID, err := account.ParseParticipationID(participationID)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseParticipationID, v2.Log)
+	}
+
+	err = v2.Node.RemoveParticipationKey(decodedParticipationID)
+	if err != nil {
+		if errors.Is(err, account.ErrParticipationIDNotFound) {
+			return notFound(ctx, err, errParticipationKeyNotFound, v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetParticipationKeyByID Get a participation key by id
+// (GET /v2/participation/{participation-id})
+func (v2 *Handlers) GetParticipationKeyByID(ctx echo.Context, participationID string) error {
+	decodedParticipationID, err := account.ParseParticipationID(participationID)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseParticipationID, v2.Log)
+	}
+
+	participationRecord, err := v2.Node.GetParticipationKey(decodedParticipationID)
+	if err != nil {
+		if errors.Is(err, account.ErrParticipationIDNotFound) {
+			return notFound(ctx, err, errParticipationKeyNotFound, v2.Log)
+		}
+		return internalError(ctx, err, errFailedRetrievingParticipationKeys, v2.Log)
+	}
+
+	response := private.ParticipationKeyResponse(convertParticipationRecord(participationRecord))
+	return ctx.JSON(http.StatusOK, response)
 }
 
 // RegisterParticipationKeys registers participation keys.
@@ -83,6 +246,98 @@ func (v2 *Handlers) ShutdownNode(ctx echo.Context, params private.ShutdownNodePa
 	return ctx.String(http.StatusNotImplemented, "Endpoint not implemented.")
 }
 
+// GetNetworkPeers lists the connected peers.
+// (GET /v2/network/peers)
+func (v2 *Handlers) GetNetworkPeers(ctx echo.Context) error {
+	peers := v2.Node.PeersInfo()
+	response := private.NetworkPeersResponse{
+		Peers: make([]private.NetworkPeer, 0, len(peers)),
+	}
+	for _, peer := range peers {
+		response.Peers = append(response.Peers, convertPeerInfo(peer))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// DisconnectNetworkPeer closes the connection to the given peer.
+// (DELETE /v2/network/peers/{peer-id})
+func (v2 *Handlers) DisconnectNetworkPeer(ctx echo.Context, peerID uint64) error {
+	if !v2.Node.DisconnectPeer(peerID) {
+		return notFound(ctx, nil, errPeerNotFound, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetNetworkBans lists the peer bans.
+// (GET /v2/network/bans)
+func (v2 *Handlers) GetNetworkBans(ctx echo.Context) error {
+	bans := v2.Node.BannedPeers()
+	response := private.NetworkBansResponse{
+		Bans: make([]private.NetworkBan, 0, len(bans)),
+	}
+	for _, ban := range bans {
+		response.Bans = append(response.Bans, private.NetworkBan{
+			Address: ban.Address,
+			Expires: uint64(ban.Until.Unix()),
+		})
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// BanNetworkPeer bans a peer address or host, and disconnects the peers matching it.
+// (POST /v2/network/bans)
+func (v2 *Handlers) BanNetworkPeer(ctx echo.Context, params private.BanNetworkPeerParams) error {
+	if params.Address == "" {
+		return badRequest(ctx, nil, errNoBanAddressSpecified, v2.Log)
+	}
+	if params.Duration == 0 || params.Duration > uint64(maxPeerBanDuration/time.Second) {
+		return badRequest(ctx, nil, fmt.Sprintf(errInvalidBanDuration, maxPeerBanDuration), v2.Log)
+	}
+	disconnected := v2.Node.BanPeer(params.Address, time.Duration(params.Duration)*time.Second)
+	return ctx.JSON(http.StatusOK, private.NetworkBanResponse{DisconnectedPeers: uint64(disconnected)})
+}
+
+// UnbanNetworkPeer lifts the ban on a peer address or host.
+// (DELETE /v2/network/bans)
+func (v2 *Handlers) UnbanNetworkPeer(ctx echo.Context, params private.UnbanNetworkPeerParams) error {
+	if !v2.Node.UnbanPeer(params.Address) {
+		return notFound(ctx, nil, errBanNotFound, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+func convertPeerInfo(peer network.PeerInfo) private.NetworkPeer {
+	direction := "inbound"
+	if peer.Outgoing {
+		direction = "outbound"
+	}
+	converted := private.NetworkPeer{
+		Id:             peer.ID,
+		Address:        peer.Address,
+		RemoteHost:     strOrNil(peer.RemoteHost),
+		Direction:      direction,
+		Version:        peer.Version,
+		TelemetryGuid:  strOrNil(peer.TelemetryGUID),
+		InstanceName:   strOrNil(peer.InstanceName),
+		ConnectedSince: uint64(peer.ConnectedSince.Unix()),
+		Traffic:        make([]private.NetworkPeerTraffic, 0, len(peer.Traffic)),
+	}
+	if peer.PingRoundTripTime > 0 {
+		converted.PingRoundTripTime = numOrNil(uint64(peer.PingRoundTripTime))
+	}
+	for tag, traffic := range peer.Traffic {
+		converted.Traffic = append(converted.Traffic, private.NetworkPeerTraffic{
+			Tag:              string(tag),
+			BytesReceived:    traffic.BytesReceived,
+			BytesSent:        traffic.BytesSent,
+			MessagesReceived: traffic.MessagesReceived,
+			MessagesSent:     traffic.MessagesSent,
+		})
+	}
+	sort.Slice(converted.Traffic, func(i, j int) bool { return converted.Traffic[i].Tag < converted.Traffic[j].Tag })
+	return converted
+}
+
 // AccountInformation gets account information for a given account.
 // (GET /v2/accounts/{address})
 func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params generated.AccountInformationParams) error {
@@ -241,6 +496,131 @@ func (v2 *Handlers) GetProof(ctx echo.Context, round uint64, txid string, params
 	return notFound(ctx, err, err.Error(), v2.Log)
 }
 
+// GetCompactCert gets the compact cert for a round.
+// (GET /v2/compactcert/{round})
+func (v2 *Handlers) GetCompactCert(ctx echo.Context, round uint64) error {
+	ledger := v2.Node.Ledger()
+	certRound := basics.Round(round)
+	latest := ledger.Latest()
+	if certRound >= latest {
+		return notFound(ctx, nil, errCompactCertNotFound, v2.Log)
+	}
+	hdr, err := ledger.BlockHdr(latest)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if hdr.CompactCert[protocol.CompactCertBasic].CompactCertNextRound <= certRound {
+		return notFound(ctx, nil, errCompactCertNotFound, v2.Log)
+	}
+
+	// a non-archival node doesn't have the blocks which confirmed the older certs anymore.
+	lookupError := func(err error) error {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return notFound(ctx, err, errCompactCertNotFound, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+	}
+
+	// the cert is in the first block which expects the next cert to be for a later round
+	lo, hi := certRound+1, latest
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		hdr, err = ledger.BlockHdr(mid)
+		if err != nil {
+			return lookupError(err)
+		}
+		if hdr.CompactCert[protocol.CompactCertBasic].CompactCertNextRound > certRound {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	block, err := ledger.Block(lo)
+	if err != nil {
+		return lookupError(err)
+	}
+	txns, err := block.DecodePaysetFlat()
+	if err != nil {
+		return internalError(ctx, err, "decoding transactions", v2.Log)
+	}
+	for _, txn := range txns {
+		if txn.Txn.Type == protocol.CompactCertTx && txn.Txn.CertRound == certRound {
+			return ctx.JSON(http.StatusOK, generated.CompactCertResponse{
+				CertRound:      round,
+				ConfirmedRound: uint64(lo),
+				Cert:           protocol.Encode(&txn.Txn.Cert),
+			})
+		}
+	}
+	return notFound(ctx, nil, errCompactCertNotFound, v2.Log)
+}
+
+// GetEquivocations gets the evidence of the vote equivocations detected by the node.
+// (GET /v2/agreement/equivocations)
+func (v2 *Handlers) GetEquivocations(ctx echo.Context) error {
+	evidence := v2.Node.Equivocations()
+	response := generated.EquivocationsResponse{Equivocations: make([]generated.EquivocationEvidence, len(evidence))}
+	for i, ev := range evidence {
+		response.Equivocations[i] = generated.EquivocationEvidence{
+			Address:   ev.Sender.String(),
+			Round:     uint64(ev.Round),
+			Period:    ev.Period,
+			Step:      ev.Step,
+			Weight:    ev.Weight,
+			Proposals: [][]byte{ev.Proposals[0][:], ev.Proposals[1][:]},
+			Detected:  uint64(ev.Detected.Unix()),
+			Votes:     ev.Votes,
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetAgreementTimeline gets the agreement timelines of the latest rounds.
+// (GET /v2/agreement/timeline)
+func (v2 *Handlers) GetAgreementTimeline(ctx echo.Context, params private.GetAgreementTimelineParams) error {
+	timelines := v2.Node.AgreementTimeline(int(nilToZero(params.Max)))
+	response := private.RoundTimelinesResponse{Rounds: make([]private.RoundTimeline, len(timelines))}
+	for i, timeline := range timelines {
+		response.Rounds[i] = convertRoundTimeline(timeline)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func convertRoundTimeline(timeline agreement.RoundTimeline) private.RoundTimeline {
+	converted := private.RoundTimeline{
+		Round:         uint64(timeline.Round),
+		Start:         uint64(timeline.Start.UnixNano()),
+		Duration:      numOrNil(uint64(timeline.Duration)),
+		BlockAssembly: numOrNil(uint64(timeline.BlockAssembly)),
+		LedgerWrite:   numOrNil(uint64(timeline.LedgerWrite)),
+		Periods:       make([]private.PeriodTimeline, len(timeline.Periods)),
+	}
+	// a milestone may be reached at the very start of a round, so only the missing ones are left out
+	milestone := func(d *time.Duration) *uint64 {
+		if d == nil {
+			return nil
+		}
+		var offset uint64
+		if *d > 0 {
+			offset = uint64(*d)
+		}
+		return &offset
+	}
+	for i, period := range timeline.Periods {
+		converted.Periods[i] = private.PeriodTimeline{
+			Period:        period.Period,
+			Start:         uint64(period.Start),
+			Proposal:      milestone(period.ProposalReceived),
+			SoftThreshold: milestone(period.SoftThreshold),
+			CertThreshold: milestone(period.CertThreshold),
+		}
+	}
+	return converted
+}
+
 // GetSupply gets the current supply reported by the ledger.
 // (GET /v2/ledger/supply)
 func (v2 *Handlers) GetSupply(ctx echo.Context) error {
@@ -286,6 +666,60 @@ func (v2 *Handlers) GetStatus(ctx echo.Context) error {
 		CatchpointAcquiredBlocks:    &stat.CatchpointCatchupAcquiredBlocks,
 	}
 
+	if len(stat.ExpiringParticipationKeys) > 0 {
+		expiring := make([]generated.ParticipationKeyExpiry, 0, len(stat.ExpiringParticipationKeys))
+		for _, exp := range stat.ExpiringParticipationKeys {
+			expiring = append(expiring, generated.ParticipationKeyExpiry{
+				Address:               exp.Address.String(),
+				VoteLastValid:         uint64(exp.VoteLastValid),
+				RoundsRemaining:       exp.RoundsRemaining,
+				RenewalInstalled:      exp.RenewalInstalled,
+				RenewalScheduledRound: numOrNil(uint64(exp.RenewalScheduledRound)),
+			})
+		}
+		response.ExpiringParticipationKeys = &expiring
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetDetailedStatus gets the node status along with the state of its peers, transaction pool and ledger.
+// (GET /v2/status/detailed)
+func (v2 *Handlers) GetDetailedStatus(ctx echo.Context) error {
+	stat, err := v2.Node.DetailedStatus()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+
+	response := generated.DetailedNodeStatusResponse{
+		LastRound:          uint64(stat.LastRound),
+		LastCommittedRound: uint64(stat.LastCommittedRound),
+		TimeSinceLastRound: uint64(stat.TimeSinceLastRound().Nanoseconds()),
+		CatchupTime:        uint64(stat.CatchupTime.Nanoseconds()),
+		Catchpoint:         strOrNil(stat.Catchpoint),
+		Ready:              true,
+		InboundPeers:       uint64(stat.InboundPeers),
+		OutboundPeers:      uint64(stat.OutboundPeers),
+		CatchupPeers: generated.CatchupPeerStats{
+			Peers:          uint64(stat.CatchupPeers.Peers),
+			FailingPeers:   uint64(stat.CatchupPeers.FailingPeers),
+			RankGroups:     uint64(stat.CatchupPeers.RankGroups),
+			RankedRequests: stat.CatchupPeers.RankedRequests,
+		},
+		PendingTransactions: uint64(stat.PendingTxns),
+		TransactionPoolSize: uint64(stat.TxPoolSize),
+	}
+	if stat.CatchupPeers.BestRank >= 0 {
+		bestRank := uint64(stat.CatchupPeers.BestRank)
+		response.CatchupPeers.BestRank = &bestRank
+	}
+
+	maxRoundAge := time.Duration(v2.Node.Config().ReadinessMaxRoundAgeSeconds) * time.Second
+	if readinessErr := stat.ReadinessError(maxRoundAge); readinessErr != nil {
+		response.Ready = false
+		response.NotReadyReason = strOrNil(readinessErr.Error())
+	}
+
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -379,6 +813,76 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, generated.PostTransactionsResponse{TxId: txid.String()})
 }
 
+// RawTransactionBatch broadcasts a batch of independent raw transaction groups to the network.
+// (POST /v2/transactions/batch)
+func (v2 *Handlers) RawTransactionBatch(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("RawTransactionBatch failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	txgroups, err := decodeTxGroups(protocol.NewDecoder(ctx.Request().Body), proto.MaxTxGroupSize)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if len(txgroups) == 0 {
+		err := errors.New("empty batch")
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	errs := v2.Node.BroadcastSignedTxGroups(txgroups)
+	response := generated.PostTransactionGroupsResponse{
+		Results: make([]generated.TransactionGroupResult, len(txgroups)),
+	}
+	for i, txgroup := range txgroups {
+		result := &response.Results[i]
+		result.TxIds = make([]string, len(txgroup))
+		for j, stxn := range txgroup {
+			result.TxIds[j] = stxn.ID().String()
+		}
+		if errs[i] != nil {
+			result.Error = strOrNil(errs[i].Error())
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// decodeTxGroups splits a stream of signed transactions into the groups it holds:
+// consecutive transactions sharing a group ID form a group, while a transaction
+// without a group ID forms a group on its own.
+func decodeTxGroups(dec protocol.Decoder, maxGroupSize int) (txgroups [][]transactions.SignedTxn, err error) {
+	for {
+		var st transactions.SignedTxn
+		err := dec.Decode(&st)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		last := len(txgroups) - 1
+		if last >= 0 && !st.Txn.Group.IsZero() && st.Txn.Group == txgroups[last][0].Txn.Group {
+			if len(txgroups[last]) >= maxGroupSize {
+				return nil, fmt.Errorf("max group size is %d", maxGroupSize)
+			}
+			txgroups[last] = append(txgroups[last], st)
+			continue
+		}
+
+		if len(txgroups) >= maxTransactionBatchGroups {
+			return nil, fmt.Errorf("max number of groups in a batch is %d", maxTransactionBatchGroups)
+		}
+		txgroups = append(txgroups, []transactions.SignedTxn{st})
+	}
+	return txgroups, nil
+}
+
 // TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
 // (POST /v2/teal/dryrun)
 func (v2 *Handlers) TealDryrun(ctx echo.Context) error {
@@ -471,6 +975,62 @@ func (v2 *Handlers) TransactionParams(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetFeeEstimate estimates the fee per byte a transaction needs to pay in order to be committed within 1, 3 and 10 rounds.
+// (GET /v2/transactions/fee-estimate)
+func (v2 *Handlers) GetFeeEstimate(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("GetFeeEstimate failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	proto := config.Consensus[stat.LastVersion]
+	estimates := v2.Node.EstimateFees(feeEstimateTargets)
+	response := generated.FeeEstimateResponse{
+		LastRound: uint64(stat.LastRound),
+		MinFee:    proto.MinTxnFee,
+		Estimates: make([]generated.FeeEstimate, len(estimates)),
+	}
+	for i, estimate := range estimates {
+		response.Estimates[i] = generated.FeeEstimate{
+			Rounds:     estimate.Rounds,
+			FeePerByte: estimate.FeePerByte,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetAdmissionFee returns the fees a transaction currently needs to pay to get into the transaction pool.
+// (GET /v2/transactions/admission-fee)
+func (v2 *Handlers) GetAdmissionFee(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("GetAdmissionFee failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	proto := config.Consensus[stat.LastVersion]
+	fee := v2.Node.AdmissionFee()
+	response := generated.AdmissionFeeResponse{
+		FeePerByte:   fee.FeePerByte,
+		MinFee:       proto.MinTxnFee,
+		PoolSize:     uint64(fee.PendingCount),
+		PoolCapacity: uint64(fee.Capacity),
+	}
+	if fee.EvictionFeePerByte != 0 {
+		response.EvictionFeePerByte = &fee.EvictionFeePerByte
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 type preEncodedTxInfo struct {
 	AssetIndex         *uint64                        `codec:"asset-index,omitempty"`
 	AssetClosingAmount *uint64                        `codec:"asset-closing-amount,omitempty"`
@@ -516,9 +1076,160 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 		return notFound(ctx, err, err.Error(), v2.Log)
 	}
 
+	return v2.writeTransactionStatus(ctx, txn, params.Format)
+}
+
+// GetConfirmedTransaction returns a transaction with the specified txID which
+// was committed in the recent rounds, without requiring the node indexer.
+// (GET /v2/transactions/{txid})
+func (v2 *Handlers) GetConfirmedTransaction(ctx echo.Context, txid string, params generated.GetConfirmedTransactionParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("GetConfirmedTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	txID := transactions.Txid{}
+	if err := txID.UnmarshalText([]byte(txid)); err != nil {
+		return badRequest(ctx, err, errNoTxnSpecified, v2.Log)
+	}
+
+	txn, ok := v2.Node.GetConfirmedTransaction(txID)
+	if !ok {
+		err := errors.New(errConfirmedTxnNotFound)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	return v2.writeTransactionStatus(ctx, txn, params.Format)
+}
+
+// preEncodedTransactionsResponse is the response of SearchForTransactions.
+type preEncodedTransactionsResponse struct {
+	Transactions []preEncodedTxInfo `codec:"transactions"`
+	NextToken    *string            `codec:"next-token,omitempty"`
+}
+
+// makeNextToken returns the token of the page following the given transaction.
+func makeNextToken(txn indexer.Transaction) string {
+	return fmt.Sprintf("%d:%d", txn.Round, txn.Intra)
+}
+
+// parseNextToken returns the position of the last transaction of the previous page.
+func parseNextToken(token string) (*indexer.TransactionPosition, error) {
+	var pos indexer.TransactionPosition
+	if _, err := fmt.Sscanf(token, "%d:%d", &pos.Round, &pos.Intra); err != nil {
+		return nil, fmt.Errorf("invalid next token %s: %v", token, err)
+	}
+	return &pos, nil
+}
+
+// SearchForTransactions searches for transactions using the node indexer.
+// (GET /v2/transactions)
+func (v2 *Handlers) SearchForTransactions(ctx echo.Context, params generated.SearchForTransactionsParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("SearchForTransactions failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	idx, err := v2.Node.Indexer()
+	if err != nil {
+		return badRequest(ctx, err, errIndexerNotActive, v2.Log)
+	}
+
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	filter := indexer.TransactionFilter{
+		AssetID:  nilToZero(params.AssetId),
+		AppID:    nilToZero(params.AppId),
+		MinRound: nilToZero(params.MinRound),
+		MaxRound: nilToZero(params.MaxRound),
+		Limit:    nilToZero(params.Limit),
+	}
+	if params.Address != nil {
+		addr, err := basics.UnmarshalChecksumAddress(*params.Address)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+		}
+		filter.Address = addr.String()
+	}
+	if params.TxType != nil {
+		filter.Type = *params.TxType
+	}
+	if params.NotePrefix != nil {
+		filter.NotePrefix, err = base64.StdEncoding.DecodeString(*params.NotePrefix)
+		if err != nil {
+			return badRequest(ctx, err, errFailedParsingNotePrefix, v2.Log)
+		}
+	}
+	if params.Next != nil {
+		filter.After, err = parseNextToken(*params.Next)
+		if err != nil {
+			return badRequest(ctx, err, errFailedParsingNextToken, v2.Log)
+		}
+	}
+
+	txns, err := idx.GetTransactions(filter)
+	if err != nil {
+		return internalError(ctx, err, errFailedSearchingIndexer, v2.Log)
+	}
+
+	// the indexer only stores the position of the transactions, which are read from the blocks.
+	ledger := v2.Node.Ledger()
+	response := preEncodedTransactionsResponse{Transactions: make([]preEncodedTxInfo, 0, len(txns))}
+	var round basics.Round
+	var payset []transactions.SignedTxnWithAD
+	for i, txn := range txns {
+		if i == 0 || basics.Round(txn.Round) != round {
+			round = basics.Round(txn.Round)
+			blk, err := ledger.Block(round)
+			if err != nil {
+				return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+			}
+			payset, err = blk.DecodePaysetFlat()
+			if err != nil {
+				return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+			}
+		}
+		if int(txn.Intra) >= len(payset) {
+			err = fmt.Errorf("transaction %s is not in block %d", txn.TXID, round)
+			return internalError(ctx, err, errFailedSearchingIndexer, v2.Log)
+		}
+
+		stxn := payset[txn.Intra]
+		response.Transactions = append(response.Transactions, makePreEncodedTxInfo(node.TxnWithStatus{
+			Txn:            stxn.SignedTxn,
+			ConfirmedRound: round,
+			ApplyData:      stxn.ApplyData,
+		}, ledger))
+	}
+	if len(txns) > 0 {
+		next := makeNextToken(txns[len(txns)-1])
+		response.NextToken = &next
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// makePreEncodedTxInfo converts the status of a pending or confirmed transaction for encoding.
+func makePreEncodedTxInfo(txn node.TxnWithStatus, ledger *data.Ledger) preEncodedTxInfo {
 	// Encoding wasn't working well without embedding "real" objects.
 	response := preEncodedTxInfo{
-		Txn: txn.Txn,
+		Txn:       txn.Txn,
+		PoolError: txn.PoolError,
 	}
 
 	if txn.ConfirmedRound != 0 {
@@ -530,14 +1241,21 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 		response.SenderRewards = &txn.ApplyData.SenderRewards.Raw
 		response.ReceiverRewards = &txn.ApplyData.ReceiverRewards.Raw
 		response.CloseRewards = &txn.ApplyData.CloseRewards.Raw
-		response.AssetIndex = computeAssetIndexFromTxn(txn, v2.Node.Ledger())
-		response.ApplicationIndex = computeAppIndexFromTxn(txn, v2.Node.Ledger())
+		response.AssetIndex = computeAssetIndexFromTxn(txn, ledger)
+		response.ApplicationIndex = computeAppIndexFromTxn(txn, ledger)
 		response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(txn)
 		response.Logs = convertLogs(txn)
 		response.Inners = convertInners(&txn)
 	}
+	return response
+}
 
-	handle, contentType, err := getCodecHandle(params.Format)
+// writeTransactionStatus writes the status of a pending or confirmed transaction
+// in the format requested by the client.
+func (v2 *Handlers) writeTransactionStatus(ctx echo.Context, txn node.TxnWithStatus, format *string) error {
+	response := makePreEncodedTxInfo(txn, v2.Node.Ledger())
+
+	handle, contentType, err := getCodecHandle(format)
 	if err != nil {
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
@@ -549,6 +1267,78 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
+// WaitForTransaction waits until the transaction with the specified txID is
+// committed, rejected by the transaction pool or expired, or until the timeout
+// elapses, and returns its status like PendingTransactionInformation does.
+// (GET /v2/transactions/{txid}/wait)
+func (v2 *Handlers) WaitForTransaction(ctx echo.Context, txid string, params generated.WaitForTransactionParams) error {
+	ledger := v2.Node.Ledger()
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("WaitForTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	txID := transactions.Txid{}
+	if err := txID.UnmarshalText([]byte(txid)); err != nil {
+		return badRequest(ctx, err, errNoTxnSpecified, v2.Log)
+	}
+	if _, _, err := getCodecHandle(params.Format); err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	timeout := maxTransactionWait
+	if params.Timeout != nil && *params.Timeout < uint64(maxTransactionWait/time.Second) {
+		timeout = time.Duration(*params.Timeout) * time.Second
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	var txn node.TxnWithStatus
+	known := false
+	for {
+		latest := ledger.Latest()
+		current, ok := v2.Node.GetPendingTransaction(txID)
+		if ok {
+			txn, known = current, true
+			if txn.ConfirmedRound != 0 || txn.PoolError != "" {
+				return v2.writeTransactionStatus(ctx, txn, params.Format)
+			}
+		} else if known && latest >= txn.Txn.Txn.LastValid {
+			// the pool no longer remembers the transaction, which can't be committed anymore.
+			txn.PoolError = fmt.Sprintf("transaction expired: last valid round %d has passed", txn.Txn.Txn.LastValid)
+			return v2.writeTransactionStatus(ctx, txn, params.Format)
+		}
+
+		// Once its last valid round is committed, the pool is about to either drop the
+		// transaction as expired or find it committed; recheck shortly rather than
+		// waiting for another round.
+		var recheck <-chan time.Time
+		if known && latest >= txn.Txn.Txn.LastValid {
+			recheck = time.After(transactionWaitRecheckInterval)
+		}
+
+		select {
+		case <-v2.Shutdown:
+			return internalError(ctx, errors.New(errServiceShuttingDown), errServiceShuttingDown, v2.Log)
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-deadline.C:
+			if !known {
+				err := errors.New(errTransactionNotFound)
+				return notFound(ctx, err, err.Error(), v2.Log)
+			}
+			return v2.writeTransactionStatus(ctx, txn, params.Format)
+		case <-ledger.Wait(latest + 1):
+		case <-recheck:
+		}
+	}
+}
+
 // getPendingTransactions returns to the provided context a list of uncomfirmed transactions currently in the transaction pool with optional Max/Address filters.
 func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format *string, addrFilter *string) error {
 
@@ -731,6 +1521,96 @@ func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// parseHoldingsPage returns the address following which a page of asset holders or
+// application accounts starts, and the number of accounts it holds.
+func parseHoldingsPage(limit *uint64, next *string) (after basics.Address, max uint64, errMsg string, err error) {
+	max = defaultHoldingsLimit
+	if limit != nil && *limit != 0 {
+		max = *limit
+		if max > maxHoldingsLimit {
+			max = maxHoldingsLimit
+		}
+	}
+	if next != nil {
+		after, err = basics.UnmarshalChecksumAddress(*next)
+		if err != nil {
+			return basics.Address{}, 0, errFailedParsingNextToken, err
+		}
+	}
+	return after, max, "", nil
+}
+
+// GetAssetBalances returns the accounts holding an asset along with their balance.
+// (GET /v2/assets/{asset-id}/balances)
+func (v2 *Handlers) GetAssetBalances(ctx echo.Context, assetID uint64, params generated.GetAssetBalancesParams) error {
+	after, max, errMsg, err := parseHoldingsPage(params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errMsg, v2.Log)
+	}
+
+	assetIdx := basics.AssetIndex(assetID)
+	myLedger := v2.Node.Ledger()
+	holders, round, err := myLedger.ListAssetHolders(assetIdx, after, max)
+	if err == ledger.ErrHoldingsIndexDisabled {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := generated.AssetBalancesResponse{
+		Round:    uint64(round),
+		Balances: make([]generated.MiniAssetHolding, 0, len(holders)),
+	}
+	for _, addr := range holders {
+		record, _, err := myLedger.LookupWithoutRewards(round, addr)
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		holding := record.Assets[assetIdx]
+		response.Balances = append(response.Balances, generated.MiniAssetHolding{
+			Address:  addr.String(),
+			Amount:   holding.Amount,
+			IsFrozen: holding.Frozen,
+		})
+	}
+	if uint64(len(holders)) == max {
+		next := holders[len(holders)-1].String()
+		response.NextToken = &next
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetApplicationAccounts returns the accounts opted into an application.
+// (GET /v2/applications/{application-id}/accounts)
+func (v2 *Handlers) GetApplicationAccounts(ctx echo.Context, applicationID uint64, params generated.GetApplicationAccountsParams) error {
+	after, max, errMsg, err := parseHoldingsPage(params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errMsg, v2.Log)
+	}
+
+	accounts, round, err := v2.Node.Ledger().ListApplicationAccounts(basics.AppIndex(applicationID), after, max)
+	if err == ledger.ErrHoldingsIndexDisabled {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := generated.ApplicationAccountsResponse{
+		Round:    uint64(round),
+		Accounts: make([]string, len(accounts)),
+	}
+	for i, addr := range accounts {
+		response.Accounts[i] = addr.String()
+	}
+	if uint64(len(accounts)) == max {
+		next := accounts[len(accounts)-1].String()
+		response.NextToken = &next
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetPendingTransactionsByAddress takes an Algorand address and returns its associated list of unconfirmed transactions currently in the transaction pool.
 // (GET /v2/accounts/{address}/transactions/pending)
 func (v2 *Handlers) GetPendingTransactionsByAddress(ctx echo.Context, addr string, params generated.GetPendingTransactionsByAddressParams) error {
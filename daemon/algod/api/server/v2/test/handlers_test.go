@@ -24,15 +24,19 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -211,6 +215,72 @@ func TestGetBlockJsonEncoding(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetCompactCert(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	l := handler.Node.Ledger()
+
+	// the cert for round 1 is confirmed in the second block
+	cert := compactcert.Cert{SignedWeight: 7, SigCommit: crypto.Digest{0x01}}
+	prev, err := l.Block(0)
+	require.NoError(t, err)
+	for _, next := range []basics.Round{1, 2, 2} {
+		var blk bookkeeping.Block
+		blk.BlockHeader = prev.BlockHeader
+		blk.BlockHeader.Round = prev.Round() + 1
+		blk.Branch = prev.Hash()
+		blk.TimeStamp = prev.TimeStamp + 1
+		blk.CompactCert = map[protocol.CompactCertType]bookkeeping.CompactCertState{
+			protocol.CompactCertBasic: {CompactCertNextRound: next},
+		}
+		if blk.Round() == 2 {
+			stx := transactions.SignedTxn{Txn: transactions.Transaction{
+				Type: protocol.CompactCertTx,
+				Header: transactions.Header{
+					Sender:      transactions.CompactCertSender,
+					GenesisHash: prev.GenesisHash(),
+					FirstValid:  blk.Round(),
+					LastValid:   blk.Round(),
+				},
+				CompactCertTxnFields: transactions.CompactCertTxnFields{CertRound: 1, Cert: cert},
+			}}
+			txib, err := blk.EncodeSignedTxn(stx, transactions.ApplyData{})
+			require.NoError(t, err)
+			blk.Payset = append(blk.Payset, txib)
+			blk.TxnCounter++
+		}
+		blk.TxnRoot, err = blk.PaysetCommit()
+		require.NoError(t, err)
+		err = l.AddBlock(blk, agreement.Certificate{})
+		require.NoError(t, err)
+		prev = blk
+	}
+
+	getCompactCert := func(round uint64) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		err := handler.GetCompactCert(echo.New().NewContext(req, rec), round)
+		require.NoError(t, err)
+		return rec
+	}
+
+	rec := getCompactCert(1)
+	require.Equal(t, 200, rec.Code)
+	var response generatedV2.CompactCertResponse
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), response.CertRound)
+	require.Equal(t, uint64(2), response.ConfirmedRound)
+	require.Equal(t, protocol.Encode(&cert), response.Cert)
+
+	require.Equal(t, 404, getCompactCert(0).Code)
+	require.Equal(t, 404, getCompactCert(2).Code)
+	require.Equal(t, 404, getCompactCert(5).Code)
+}
+
 func TestGetSupply(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -221,6 +291,72 @@ func TestGetSupply(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetEquivocations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetEquivocations(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response generatedV2.EquivocationsResponse
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Equal(t, []generatedV2.EquivocationEvidence{{
+		Address:   poolAddr.String(),
+		Round:     42,
+		Period:    1,
+		Step:      2,
+		Weight:    7,
+		Proposals: [][]byte{append([]byte{0x01}, make([]byte, 31)...), append([]byte{0x02}, make([]byte, 31)...)},
+		Detected:  1600000200,
+		Votes:     []byte{0x83},
+	}}, response.Equivocations)
+}
+
+func agreementTimelineTest(t *testing.T, params private.GetAgreementTimelineParams) []private.RoundTimeline {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetAgreementTimeline(c, params)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response private.RoundTimelinesResponse
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	return response.Rounds
+}
+
+func TestGetAgreementTimeline(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	zero := uint64(0)
+	duration := uint64(4 * time.Second)
+	assembly := uint64(300 * time.Millisecond)
+	write := uint64(80 * time.Millisecond)
+	soft := uint64(1200 * time.Millisecond)
+	cert := uint64(1500 * time.Millisecond)
+	rounds := agreementTimelineTest(t, private.GetAgreementTimelineParams{})
+	require.Equal(t, []private.RoundTimeline{{
+		Round:   43,
+		Start:   uint64(time.Unix(1600000300, 0).UnixNano()),
+		Periods: []private.PeriodTimeline{{Period: 0, Proposal: &zero}},
+	}, {
+		Round:         42,
+		Start:         uint64(time.Unix(1600000296, 0).UnixNano()),
+		Duration:      &duration,
+		BlockAssembly: &assembly,
+		LedgerWrite:   &write,
+		Periods:       []private.PeriodTimeline{{Period: 0, SoftThreshold: &soft, CertThreshold: &cert}},
+	}}, rounds)
+
+	max := uint64(1)
+	rounds = agreementTimelineTest(t, private.GetAgreementTimelineParams{Max: &max})
+	require.Len(t, rounds, 1)
+	require.Equal(t, uint64(43), rounds[0].Round)
+}
+
 func TestGetStatus(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -253,6 +389,31 @@ func TestGetStatus(t *testing.T) {
 	require.Equal(t, expectedResult, actualResult)
 }
 
+func TestGetDetailedStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetDetailedStatus(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	actualResult := generatedV2.DetailedNodeStatusResponse{}
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &actualResult)
+	require.NoError(t, err)
+	require.Equal(t, uint64(cannedStatusReportGolden.LastRound), actualResult.LastRound)
+	require.Equal(t, uint64(cannedStatusReportGolden.LastRound), actualResult.LastCommittedRound)
+	require.Equal(t, uint64(3), actualResult.InboundPeers)
+	require.Equal(t, uint64(4), actualResult.OutboundPeers)
+	require.Nil(t, actualResult.CatchupPeers.BestRank)
+	require.Equal(t, uint64(config.GetDefaultLocal().TxPoolSize), actualResult.TransactionPoolSize)
+
+	// the canned status stopped at an unsupported round.
+	require.False(t, actualResult.Ready)
+	require.NotNil(t, actualResult.NotReadyReason)
+}
+
 func TestGetStatusAfterBlock(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -266,6 +427,34 @@ func TestGetStatusAfterBlock(t *testing.T) {
 	require.Equal(t, 400, rec.Code)
 }
 
+func streamEventsTest(t *testing.T, params generatedV2.StreamEventsParams, lastEventID string, expectedCode int) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	if lastEventID != "" {
+		c.Request().Header.Set("Last-Event-ID", lastEventID)
+	}
+	err := handler.StreamEvents(c, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestStreamEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	types := []string{"block", "pending-transaction"}
+	badTypes := []string{"block", "vote"}
+	addresses := []string{poolAddr.String()}
+	badAddresses := []string{"not-an-address"}
+
+	// the mock node fails to subscribe once the parameters are validated.
+	streamEventsTest(t, generatedV2.StreamEventsParams{Types: &types, Address: &addresses}, "", 503)
+	streamEventsTest(t, generatedV2.StreamEventsParams{Types: &badTypes}, "", 400)
+	streamEventsTest(t, generatedV2.StreamEventsParams{Address: &badAddresses}, "", 400)
+	streamEventsTest(t, generatedV2.StreamEventsParams{}, "five", 400)
+	streamEventsTest(t, generatedV2.StreamEventsParams{}, "5", 503)
+}
+
 func TestGetTransactionParams(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -277,6 +466,40 @@ func TestGetTransactionParams(t *testing.T) {
 	require.Equal(t, 200, rec.Code)
 }
 
+func TestGetFeeEstimate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetFeeEstimate(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response generatedV2.FeeEstimateResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Estimates, 3)
+	require.Equal(t, uint64(1), response.Estimates[0].Rounds)
+	require.Equal(t, uint64(10), response.Estimates[2].Rounds)
+}
+
+func TestGetAdmissionFee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetAdmissionFee(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response generatedV2.AdmissionFeeResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(1), response.FeePerByte)
+	require.Equal(t, uint64(15000), response.PoolCapacity)
+	require.Nil(t, response.EvictionFeePerByte)
+}
+
 func pendingTransactionInformationTest(t *testing.T, txidToUse int, format string, expectedCode int) {
 	handler, c, rec, _, stxns, releasefunc := setupTestForMethodGet(t)
 	defer releasefunc()
@@ -300,6 +523,201 @@ func TestPendingTransactionInformation(t *testing.T) {
 	pendingTransactionInformationTest(t, 0, "bad format", 400)
 }
 
+func getConfirmedTransactionTest(t *testing.T, txidToUse int, format string, expectedCode int) {
+	handler, c, rec, _, stxns, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	txid := "bad txid"
+	if txidToUse >= 0 {
+		txid = stxns[txidToUse].ID().String()
+	}
+	params := generatedV2.GetConfirmedTransactionParams{Format: &format}
+	err := handler.GetConfirmedTransaction(c, txid, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if format == "json" && rec.Code == 200 {
+		var response generatedV2.PendingTransactionResponse
+		data := rec.Body.Bytes()
+		err = protocol.DecodeJSON(data, &response)
+		require.NoError(t, err, string(data))
+		require.NotNil(t, response.ConfirmedRound)
+		require.Equal(t, uint64(1), *response.ConfirmedRound)
+	}
+}
+
+func TestGetConfirmedTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getConfirmedTransactionTest(t, 0, "json", 200)
+	getConfirmedTransactionTest(t, 0, "msgpack", 200)
+	getConfirmedTransactionTest(t, -1, "json", 400)
+	getConfirmedTransactionTest(t, 0, "bad format", 400)
+}
+
+func TestSearchForTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// the mock node has no indexer.
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	assetID := uint64(1)
+	err := handler.SearchForTransactions(c, generatedV2.SearchForTransactionsParams{AssetId: &assetID})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
+func getApplicationAccountsTest(t *testing.T, handler v2.Handlers, params generatedV2.GetApplicationAccountsParams, expectedCode int) (response generatedV2.ApplicationAccountsResponse) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := handler.GetApplicationAccounts(c, 1, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if rec.Code == 200 {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	}
+	return
+}
+
+func TestGetApplicationAccounts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+
+	response := getApplicationAccountsTest(t, handler, generatedV2.GetApplicationAccountsParams{}, 200)
+	require.Len(t, response.Accounts, 1)
+	require.Nil(t, response.NextToken)
+
+	// a full page comes with a next token, which points past its last account.
+	limit := uint64(1)
+	response = getApplicationAccountsTest(t, handler, generatedV2.GetApplicationAccountsParams{Limit: &limit}, 200)
+	require.Len(t, response.Accounts, 1)
+	require.NotNil(t, response.NextToken)
+	response = getApplicationAccountsTest(t, handler, generatedV2.GetApplicationAccountsParams{Next: response.NextToken}, 200)
+	require.Empty(t, response.Accounts)
+
+	bad := "not an address"
+	getApplicationAccountsTest(t, handler, generatedV2.GetApplicationAccountsParams{Next: &bad}, 400)
+}
+
+func TestGetAssetBalances(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetAssetBalances(c, 5, generatedV2.GetAssetBalancesParams{})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response generatedV2.AssetBalancesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Empty(t, response.Balances)
+}
+
+func waitForTransactionTest(t *testing.T, txidToUse int, format string, expectedCode int) {
+	handler, c, rec, _, stxns, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	txid := "bad txid"
+	if txidToUse >= 0 {
+		txid = stxns[txidToUse].ID().String()
+	}
+	// the mock node reports every transaction as pending, so only wait for the timeout.
+	timeout := uint64(0)
+	params := generatedV2.WaitForTransactionParams{Timeout: &timeout, Format: &format}
+	err := handler.WaitForTransaction(c, txid, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if format == "json" && rec.Code == 200 {
+		var response generatedV2.PendingTransactionResponse
+		data := rec.Body.Bytes()
+		err = protocol.DecodeJSON(data, &response)
+		require.NoError(t, err, string(data))
+		require.Nil(t, response.ConfirmedRound)
+		require.Empty(t, response.PoolError)
+	}
+}
+
+func TestWaitForTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	waitForTransactionTest(t, 0, "json", 200)
+	waitForTransactionTest(t, 0, "msgpack", 200)
+	waitForTransactionTest(t, -1, "json", 400)
+	waitForTransactionTest(t, 0, "bad format", 400)
+}
+
+// waitForTransactionOutcomeTest waits for a transaction whose successive
+// lookups return the given statuses, the last one being repeated.
+func waitForTransactionOutcomeTest(t *testing.T, lookups []node.TxnWithStatus, found []bool) generatedV2.PendingTransactionResponse {
+	mockLedger, _, _, stxns, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	calls := 0
+	mockNode.pendingTxn = func(txID transactions.Txid) (node.TxnWithStatus, bool) {
+		i := calls
+		if i >= len(lookups) {
+			i = len(lookups) - 1
+		}
+		calls++
+		return lookups[i], found[i]
+	}
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	timeout := uint64(5)
+	format := "json"
+	start := time.Now()
+	err := handler.WaitForTransaction(c, stxns[0].ID().String(), generatedV2.WaitForTransactionParams{Timeout: &timeout, Format: &format})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	require.Less(t, int64(time.Since(start)), int64(time.Duration(timeout)*time.Second))
+	require.GreaterOrEqual(t, calls, len(lookups))
+
+	var response generatedV2.PendingTransactionResponse
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	return response
+}
+
+func TestWaitForTransactionOutcomes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// the transaction's last valid round is committed, so it is looked up again
+	// without waiting for another round.
+	var pending node.TxnWithStatus
+	pending.Txn.Txn.LastValid = 0
+
+	confirmed := pending
+	confirmed.ConfirmedRound = 7
+	response := waitForTransactionOutcomeTest(t, []node.TxnWithStatus{pending, confirmed}, []bool{true, true})
+	require.Equal(t, uint64(7), *response.ConfirmedRound)
+	require.Empty(t, response.PoolError)
+
+	rejected := pending
+	rejected.PoolError = "transaction evicted from the full transaction pool by a group paying a higher fee per byte"
+	response = waitForTransactionOutcomeTest(t, []node.TxnWithStatus{pending, rejected}, []bool{true, true})
+	require.Nil(t, response.ConfirmedRound)
+	require.Equal(t, rejected.PoolError, response.PoolError)
+
+	response = waitForTransactionOutcomeTest(t, []node.TxnWithStatus{pending, {}}, []bool{true, false})
+	require.Nil(t, response.ConfirmedRound)
+	require.Equal(t, "transaction expired: last valid round 0 has passed", response.PoolError)
+}
+
 func getPendingTransactionsTest(t *testing.T, format string, max uint64, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
 	defer releasefunc()
@@ -438,6 +856,67 @@ func TestPostTransaction(t *testing.T) {
 	postTransactionTest(t, 0, 200)
 }
 
+func postTransactionBatchTest(t *testing.T, groupFirstTwo bool, nodeError error, expectedCode int, expectedGroups int) {
+	numAccounts := 5
+	numTransactions := 5
+	offlineAccounts := true
+	mockLedger, _, _, stxns, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nodeError)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+	e := echo.New()
+	var bodyBytes []byte
+	if expectedGroups > 0 {
+		if groupFirstTwo {
+			stxns[0].Txn.Group = crypto.Digest{1}
+			stxns[1].Txn.Group = crypto.Digest{1}
+		}
+		for _, stxn := range stxns {
+			bodyBytes = append(bodyBytes, protocol.Encode(&stxn)...)
+		}
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(bodyBytes))
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := handler.RawTransactionBatch(c)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if expectedCode != http.StatusOK {
+		return
+	}
+
+	var response generatedV2.PostTransactionGroupsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Results, expectedGroups)
+	txids := 0
+	for _, result := range response.Results {
+		txids += len(result.TxIds)
+		if nodeError != nil {
+			require.NotNil(t, result.Error)
+			require.Equal(t, nodeError.Error(), *result.Error)
+		} else {
+			require.Nil(t, result.Error)
+		}
+	}
+	require.Equal(t, len(stxns), txids)
+	require.Equal(t, stxns[0].ID().String(), response.Results[0].TxIds[0])
+}
+
+func TestPostTransactionBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	postTransactionBatchTest(t, false, nil, 400, 0)
+	postTransactionBatchTest(t, false, nil, 200, 5)
+	postTransactionBatchTest(t, true, nil, 200, 4)
+	postTransactionBatchTest(t, false, errors.New("rejected"), 200, 5)
+}
+
 func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
@@ -511,6 +990,198 @@ func TestAbortCatchup(t *testing.T) {
 	abortCatchupTest(t, badCatchPoint, 400)
 }
 
+func TestGetNetworkPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetNetworkPeers(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response private.NetworkPeersResponse
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response.Peers, 2)
+
+	outgoing := response.Peers[0]
+	require.Equal(t, uint64(1), outgoing.Id)
+	require.Equal(t, "outbound", outgoing.Direction)
+	require.Equal(t, "r1.example.com:4160", outgoing.Address)
+	require.Equal(t, uint64(1600000000), outgoing.ConnectedSince)
+	require.Nil(t, outgoing.PingRoundTripTime)
+	require.Nil(t, outgoing.InstanceName)
+	require.Equal(t, []private.NetworkPeerTraffic{
+		{Tag: string(protocol.AgreementVoteTag), BytesSent: 50, MessagesSent: 1},
+		{Tag: string(protocol.TxnTag), BytesReceived: 100, MessagesReceived: 2},
+	}, outgoing.Traffic)
+
+	incoming := response.Peers[1]
+	require.Equal(t, "inbound", incoming.Direction)
+	require.Equal(t, "10.0.0.2", *incoming.RemoteHost)
+	require.Equal(t, "node2", *incoming.InstanceName)
+	require.Equal(t, uint64(20*time.Millisecond), *incoming.PingRoundTripTime)
+	require.Empty(t, incoming.Traffic)
+}
+
+func disconnectNetworkPeerTest(t *testing.T, peerID uint64, expectedCode int) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.DisconnectNetworkPeer(c, peerID)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestDisconnectNetworkPeer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	disconnectNetworkPeerTest(t, 2, 200)
+	disconnectNetworkPeerTest(t, 3, 404)
+}
+
+func banNetworkPeerTest(t *testing.T, params private.BanNetworkPeerParams, expectedCode int, expectedDisconnected uint64) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.BanNetworkPeer(c, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if expectedCode == 200 {
+		var response private.NetworkBanResponse
+		err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		require.Equal(t, expectedDisconnected, response.DisconnectedPeers)
+	}
+}
+
+func unbanNetworkPeerTest(t *testing.T, address string, expectedCode int) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.UnbanNetworkPeer(c, private.UnbanNetworkPeerParams{Address: address})
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestNetworkBans(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	err := handler.GetNetworkBans(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var bans private.NetworkBansResponse
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &bans)
+	require.NoError(t, err)
+	require.Equal(t, []private.NetworkBan{{Address: "10.0.0.3", Expires: 1600003600}}, bans.Bans)
+	releasefunc()
+
+	banNetworkPeerTest(t, private.BanNetworkPeerParams{Address: "10.0.0.1", Duration: 60}, 200, 1)
+	banNetworkPeerTest(t, private.BanNetworkPeerParams{Address: "10.0.0.9", Duration: 60}, 200, 0)
+	banNetworkPeerTest(t, private.BanNetworkPeerParams{Address: "", Duration: 60}, 400, 0)
+	banNetworkPeerTest(t, private.BanNetworkPeerParams{Address: "10.0.0.1", Duration: 0}, 400, 0)
+	banNetworkPeerTest(t, private.BanNetworkPeerParams{Address: "10.0.0.1", Duration: 365 * 24 * 3600}, 400, 0)
+
+	unbanNetworkPeerTest(t, "10.0.0.3", 200)
+	unbanNetworkPeerTest(t, "10.0.0.1", 404)
+}
+
+func TestGetParticipationKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetParticipationKeys(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response private.ParticipationKeysResponse
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 2)
+	require.Equal(t, cannedPartKeysGolden[0].ParticipationID.String(), response[0].Id)
+	require.Equal(t, poolAddr.String(), response[0].Address)
+	require.Equal(t, uint64(10), *response[0].EffectiveFirstValid)
+	require.Equal(t, uint64(42), *response[0].LastVote)
+	require.Equal(t, cannedPartKeysGolden[1].ParticipationID.String(), response[1].Id)
+	require.Nil(t, response[1].EffectiveFirstValid)
+}
+
+func getParticipationKeyByIDTest(t *testing.T, participationID string, expectedCode int) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetParticipationKeyByID(c, participationID)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if expectedCode == 200 {
+		var response private.ParticipationKeyResponse
+		err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		require.Equal(t, participationID, response.Id)
+	}
+}
+
+func TestGetParticipationKeyByID(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getParticipationKeyByIDTest(t, cannedPartKeysGolden[1].ParticipationID.String(), 200)
+	getParticipationKeyByIDTest(t, account.ParticipationID{0x09}.String(), 404)
+	getParticipationKeyByIDTest(t, "not a participation id", 400)
+}
+
+func addParticipationKeyTest(t *testing.T, partKeyBinary []byte, nodeError error, expectedCode int) {
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	handler := v2.Handlers{
+		Node:     makeMockNode(mockLedger, t.Name(), nodeError),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(partKeyBinary))
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := handler.AddParticipationKey(c)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if expectedCode == 200 {
+		var response private.PostParticipationResponse
+		err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		require.Equal(t, cannedInstalledPartID.String(), response.PartId)
+	}
+}
+
+func TestAddParticipationKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addParticipationKeyTest(t, cannedPartKeyBinary, nil, 200)
+	addParticipationKeyTest(t, nil, nil, 400)
+	addParticipationKeyTest(t, []byte("garbage"), nil, 400)
+	addParticipationKeyTest(t, cannedPartKeyBinary, account.ErrAlreadyInserted, 400)
+	addParticipationKeyTest(t, cannedPartKeyBinary, errors.New("disk full"), 500)
+}
+
+func deleteParticipationKeyByIDTest(t *testing.T, participationID string, expectedCode int) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.DeleteParticipationKeyByID(c, participationID)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestDeleteParticipationKeyByID(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	deleteParticipationKeyByIDTest(t, cannedPartKeysGolden[0].ParticipationID.String(), 200)
+	deleteParticipationKeyByIDTest(t, account.ParticipationID{0x09}.String(), 404)
+	deleteParticipationKeyByIDTest(t, "not a participation id", 400)
+}
+
 func tealCompileTest(t *testing.T, bytesToUse []byte, expectedCode int, enableDeveloperAPI bool) {
 	numAccounts := 1
 	numTransactions := 1
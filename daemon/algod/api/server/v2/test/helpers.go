@@ -17,6 +17,7 @@
 package test
 
 import (
+	"bytes"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -31,10 +32,12 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/node/indexer"
 	"github.com/algorand/go-algorand/protocol"
@@ -57,6 +60,77 @@ var cannedStatusReportGolden = node.StatusReport{
 	LastCatchpoint:                     "",
 }
 
+var cannedPeersGolden = []network.PeerInfo{
+	{
+		ID:             1,
+		Address:        "r1.example.com:4160",
+		RemoteHost:     "10.0.0.1",
+		Outgoing:       true,
+		Version:        "2.1",
+		ConnectedSince: time.Unix(1600000000, 0),
+		Traffic: map[protocol.Tag]network.TagTraffic{
+			protocol.TxnTag:           {BytesReceived: 100, MessagesReceived: 2},
+			protocol.AgreementVoteTag: {BytesSent: 50, MessagesSent: 1},
+		},
+	},
+	{
+		ID:                2,
+		RemoteHost:        "10.0.0.2",
+		Version:           "2.1",
+		InstanceName:      "node2",
+		ConnectedSince:    time.Unix(1600000100, 0),
+		PingRoundTripTime: 20 * time.Millisecond,
+	},
+}
+
+var cannedBansGolden = []network.PeerBan{{Address: "10.0.0.3", Until: time.Unix(1600003600, 0)}}
+
+var cannedEquivocationsGolden = []agreement.EquivocationEvidence{{
+	Sender:    poolAddr,
+	Round:     42,
+	Period:    1,
+	Step:      2,
+	Weight:    7,
+	Proposals: [2]crypto.Digest{{0x01}, {0x02}},
+	Detected:  time.Unix(1600000200, 0),
+	Votes:     []byte{0x83},
+}}
+
+var cannedPipelinedProposal = -50 * time.Millisecond
+var cannedSoftThreshold = 1200 * time.Millisecond
+var cannedCertThreshold = 1500 * time.Millisecond
+var cannedTimelinesGolden = []agreement.RoundTimeline{{
+	Round:   43,
+	Start:   time.Unix(1600000300, 0),
+	Periods: []agreement.PeriodTimeline{{Period: 0, ProposalReceived: &cannedPipelinedProposal}},
+}, {
+	Round:         42,
+	Start:         time.Unix(1600000296, 0),
+	Duration:      4 * time.Second,
+	BlockAssembly: 300 * time.Millisecond,
+	LedgerWrite:   80 * time.Millisecond,
+	Periods:       []agreement.PeriodTimeline{{Period: 0, SoftThreshold: &cannedSoftThreshold, CertThreshold: &cannedCertThreshold}},
+}}
+
+var cannedPartKeyBinary = []byte("partkey")
+var cannedInstalledPartID = account.ParticipationID{0x03}
+var cannedPartKeysGolden = []account.ParticipationRecord{{
+	ParticipationID: account.ParticipationID{0x01},
+	Account:         poolAddr,
+	FirstValid:      1,
+	LastValid:       1000,
+	KeyDilution:     100,
+	EffectiveFirst:  10,
+	EffectiveLast:   1000,
+	LastVote:        42,
+}, {
+	ParticipationID: account.ParticipationID{0x02},
+	Account:         poolAddr,
+	FirstValid:      900,
+	LastValid:       2000,
+	KeyDilution:     100,
+}}
+
 var poolAddrRewardBaseGolden = uint64(0)
 var poolAddrAssetsGolden = make([]generatedV2.AssetHolding, 0)
 var poolAddrCreatedAssetsGolden = make([]generatedV2.Asset, 0)
@@ -85,6 +159,10 @@ type mockNode struct {
 	genesisID string
 	config    config.Local
 	err       error
+
+	// pendingTxn, when set, replaces the default GetPendingTransaction,
+	// which reports every transaction as pending.
+	pendingTxn func(txID transactions.Txid) (node.TxnWithStatus, bool)
 }
 
 func makeMockNode(ledger *data.Ledger, genesisID string, nodeError error) mockNode {
@@ -103,6 +181,16 @@ func (m mockNode) Status() (s node.StatusReport, err error) {
 	s = cannedStatusReportGolden
 	return
 }
+
+func (m mockNode) DetailedStatus() (s node.DetailedStatusReport, err error) {
+	s.StatusReport = cannedStatusReportGolden
+	s.InboundPeers = 3
+	s.OutboundPeers = 4
+	s.CatchupPeers.BestRank = -1
+	s.TxPoolSize = m.config.TxPoolSize
+	s.LastCommittedRound = cannedStatusReportGolden.LastRound
+	return s, m.err
+}
 func (m mockNode) GenesisID() string {
 	return m.genesisID
 }
@@ -115,24 +203,90 @@ func (m mockNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	return m.err
 }
 
+func (m mockNode) BroadcastSignedTxGroups(txgroups [][]transactions.SignedTxn) []error {
+	errs := make([]error, len(txgroups))
+	for i := range errs {
+		errs[i] = m.err
+	}
+	return errs
+}
+
 func (m mockNode) GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
+	if m.pendingTxn != nil {
+		return m.pendingTxn(txID)
+	}
 	res = node.TxnWithStatus{}
 	found = true
 	return
 }
 
+func (m mockNode) GetConfirmedTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
+	res = node.TxnWithStatus{ConfirmedRound: 1}
+	found = true
+	return
+}
+
 func (m mockNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn, error) {
 	return txnPoolGolden, m.err
 }
 
+func (m mockNode) AdmissionFee() pools.AdmissionFee {
+	return pools.AdmissionFee{FeePerByte: 1, PendingCount: 1, Capacity: 15000}
+}
+
+func (m mockNode) EstimateFees(targets []uint64) []pools.FeeEstimate {
+	estimates := make([]pools.FeeEstimate, len(targets))
+	for i, rounds := range targets {
+		estimates[i] = pools.FeeEstimate{Rounds: rounds, FeePerByte: 1}
+	}
+	return estimates
+}
+
 func (m mockNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{Raw: 1}
 }
 
+func (m mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
+	if m.err != nil {
+		return account.ParticipationID{}, m.err
+	}
+	if !bytes.Equal(partKeyBinary, cannedPartKeyBinary) {
+		return account.ParticipationID{}, fmt.Errorf("%w: not a database", node.ErrInvalidParticipationKey)
+	}
+	return cannedInstalledPartID, nil
+}
+
+func (m mockNode) ListParticipationKeys() ([]account.ParticipationRecord, error) {
+	return cannedPartKeysGolden, m.err
+}
+
+func (m mockNode) GetParticipationKey(id account.ParticipationID) (account.ParticipationRecord, error) {
+	for _, record := range cannedPartKeysGolden {
+		if record.ParticipationID == id {
+			return record, m.err
+		}
+	}
+	return account.ParticipationRecord{}, account.ErrParticipationIDNotFound
+}
+
+func (m mockNode) RemoveParticipationKey(id account.ParticipationID) error {
+	_, err := m.GetParticipationKey(id)
+	return err
+}
+
+func (m mockNode) SubscribeEvents(filter node.EventFilter) (*node.EventSubscription, error) {
+	return nil, fmt.Errorf("subscribe events not implemented")
+}
+
+func (m mockNode) BlockEvents(round basics.Round, filter node.EventFilter) ([]node.Event, error) {
+	return nil, fmt.Errorf("block events not implemented")
+}
+
 // unused by handlers:
 func (m mockNode) Config() config.Local {
 	return m.config
 }
+
 func (m mockNode) Start() {}
 
 func (m mockNode) ListeningAddress() (string, bool) {
@@ -183,6 +337,53 @@ func (m mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
 
+func (m mockNode) PeersInfo() []network.PeerInfo {
+	return cannedPeersGolden
+}
+
+func (m mockNode) DisconnectPeer(id uint64) bool {
+	for _, peer := range cannedPeersGolden {
+		if peer.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (m mockNode) BanPeer(addr string, duration time.Duration) int {
+	disconnected := 0
+	for _, peer := range cannedPeersGolden {
+		if peer.RemoteHost == addr || peer.Address == addr {
+			disconnected++
+		}
+	}
+	return disconnected
+}
+
+func (m mockNode) UnbanPeer(addr string) bool {
+	for _, ban := range cannedBansGolden {
+		if ban.Address == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func (m mockNode) BannedPeers() []network.PeerBan {
+	return cannedBansGolden
+}
+
+func (m mockNode) Equivocations() []agreement.EquivocationEvidence {
+	return cannedEquivocationsGolden
+}
+
+func (m mockNode) AgreementTimeline(max int) []agreement.RoundTimeline {
+	if max > 0 && max < len(cannedTimelinesGolden) {
+		return cannedTimelinesGolden[:max]
+	}
+	return cannedTimelinesGolden
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
@@ -272,6 +473,7 @@ func testingenv(t testing.TB, numAccounts, numTxs int, offlineAccounts bool) (*d
 	const inMem = true
 	cfg := config.GetDefaultLocal()
 	cfg.Archival = true
+	cfg.EnableHoldingsIndex = true
 	ledger, err := data.LoadLedger(logging.Base(), t.Name(), inMem, protocol.ConsensusCurrentVersion, bootstrap, genesisID, genesisHash, nil, cfg)
 	if err != nil {
 		panic(err)
@@ -80,6 +80,13 @@ func numOrNil(num uint64) *uint64 {
 	return &num
 }
 
+func nilToZero(num *uint64) uint64 {
+	if num == nil {
+		return 0
+	}
+	return *num
+}
+
 func byteOrNil(data []byte) *[]byte {
 	if len(data) == 0 {
 		return nil
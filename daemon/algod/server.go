@@ -181,6 +181,17 @@ func (s *Server) Start() {
 		os.Exit(1)
 	}
 
+	scopedTokens, err := tokens.LoadScopedTokens(s.RootPath)
+	if err != nil {
+		fmt.Printf("APIToken error: %v\n", err)
+		os.Exit(1)
+	}
+	err = tokens.ValidateScopedTokensDistinct(scopedTokens, apiToken, adminAPIToken)
+	if err != nil {
+		fmt.Printf("APIToken error: invalid %s: %v\n", tokens.AlgodScopedTokensFilename, err)
+		os.Exit(1)
+	}
+
 	s.stopping = make(chan struct{})
 
 	addr := cfg.EndpointAddress
@@ -204,7 +215,7 @@ func (s *Server) Start() {
 
 	tcpListener := listener.(*net.TCPListener)
 
-	e := apiServer.NewRouter(s.log, s.node, s.stopping, apiToken, adminAPIToken, tcpListener)
+	e := apiServer.NewRouter(s.log, s.node, s.stopping, apiToken, adminAPIToken, scopedTokens, tcpListener)
 
 	// Set up files for our PID and our listening address
 	// before beginning to listen to prevent 'goal node start'
@@ -0,0 +1,554 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ParticipationID identifies a single participation key installed on this node.
+type ParticipationID crypto.Digest
+
+// IsZero returns true if the ParticipationID is all zero bytes.
+func (pid ParticipationID) IsZero() bool {
+	return crypto.Digest(pid).IsZero()
+}
+
+// String prints the ParticipationID in the same base32 encoding used for other digests.
+func (pid ParticipationID) String() string {
+	return crypto.Digest(pid).String()
+}
+
+// ParseParticipationID parses a string produced by ParticipationID.String.
+func ParseParticipationID(str string) (ParticipationID, error) {
+	d, err := crypto.DigestFromString(str)
+	return ParticipationID(d), err
+}
+
+// ParticipationKeyIdentity contains the public parameters which uniquely
+// identify a participation key. Its hash is the key's ParticipationID.
+type ParticipationKeyIdentity struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Parent      basics.Address                  `codec:"addr"`
+	VoteID      crypto.OneTimeSignatureVerifier `codec:"vote-id"`
+	SelectionID crypto.VRFVerifier              `codec:"sel-id"`
+	FirstValid  basics.Round                    `codec:"fv"`
+	LastValid   basics.Round                    `codec:"lv"`
+	KeyDilution uint64                          `codec:"kd"`
+}
+
+// ToBeHashed implements the Hashable interface.
+func (id *ParticipationKeyIdentity) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.ParticipationKeys, protocol.Encode(id)
+}
+
+// ID creates a ParticipationID hash from the identity.
+func (id *ParticipationKeyIdentity) ID() ParticipationID {
+	return ParticipationID(crypto.HashObj(id))
+}
+
+// Identity returns the ParticipationKeyIdentity of the participation key.
+func (part Participation) Identity() ParticipationKeyIdentity {
+	return ParticipationKeyIdentity{
+		Parent:      part.Parent,
+		VoteID:      part.Voting.OneTimeSignatureVerifier,
+		SelectionID: part.VRF.PK,
+		FirstValid:  part.FirstValid,
+		LastValid:   part.LastValid,
+		KeyDilution: part.KeyDilution,
+	}
+}
+
+// ID returns the ParticipationID of the participation key.
+func (part Participation) ID() ParticipationID {
+	id := part.Identity()
+	return id.ID()
+}
+
+// ParticipationAction is used when recording participation actions.
+//msgp:ignore ParticipationAction
+type ParticipationAction int
+
+const (
+	// Vote is used when recording a vote.
+	Vote ParticipationAction = iota
+
+	// BlockProposal is used when recording a block proposal.
+	BlockProposal
+
+	// CompactCertificate is used when recording a compact certificate signature.
+	CompactCertificate
+)
+
+// ParticipationRecord contains all metadata the registry keeps about a
+// participation key. It does not include any secrets.
+type ParticipationRecord struct {
+	ParticipationID ParticipationID
+
+	Account     basics.Address
+	FirstValid  basics.Round
+	LastValid   basics.Round
+	KeyDilution uint64
+	VoteID      crypto.OneTimeSignatureVerifier
+	SelectionID crypto.VRFVerifier
+
+	// Filename is the name of the participation key database within the
+	// genesis directory, if the key is backed by one.
+	Filename string
+
+	LastVote               basics.Round
+	LastBlockProposal      basics.Round
+	LastCompactCertificate basics.Round
+
+	// EffectiveFirst and EffectiveLast are the rounds during which this key
+	// was registered on chain. They are zero if the key was never registered.
+	EffectiveFirst basics.Round
+	EffectiveLast  basics.Round
+}
+
+// IsZero returns true if the record is the zero value, i.e. not found.
+func (r ParticipationRecord) IsZero() bool {
+	return r.ParticipationID.IsZero()
+}
+
+// IsActive returns true if the key was registered on chain and the
+// registration covers the given round.
+func (r ParticipationRecord) IsActive(round basics.Round) bool {
+	if r.EffectiveFirst == 0 && r.EffectiveLast == 0 {
+		return false
+	}
+	return r.EffectiveFirst <= round && round <= r.EffectiveLast
+}
+
+var (
+	// ErrParticipationIDNotFound is used when attempting to access a participation key that does not exist.
+	ErrParticipationIDNotFound = errors.New("the participation ID was not found")
+
+	// ErrAlreadyInserted is used when inserting a key which already exists in the registry.
+	ErrAlreadyInserted = errors.New("these participation keys are already inserted")
+
+	// ErrActiveKeyNotFound is used when attempting to record an action for an account with no active key.
+	ErrActiveKeyNotFound = errors.New("no active participation key found for account")
+
+	// ErrUnknownParticipationAction is used when recording an unknown action type.
+	ErrUnknownParticipationAction = errors.New("unknown participation action")
+)
+
+// ParticipationRegistry contains all functions for interacting with the
+// participation registry, which tracks the participation keys installed on
+// this node and how they have been used.
+type ParticipationRegistry interface {
+	// Insert adds a participation key to the registry, along with the name
+	// of the database file holding its secrets.
+	Insert(part Participation, filename string) (ParticipationID, error)
+
+	// Delete removes a participation key from the registry.
+	Delete(id ParticipationID) error
+
+	// Get returns the record for a single participation key.
+	Get(id ParticipationID) (ParticipationRecord, error)
+
+	// GetAll returns the records of all participation keys, ordered by
+	// account and then by first valid round.
+	GetAll() []ParticipationRecord
+
+	// Register marks a key as registered on chain starting at the given
+	// round, and ends the registration of any other key of the same account.
+	Register(id ParticipationID, on basics.Round) error
+
+	// Deregister ends the registration of the account's registered key
+	// before the given round, when the account goes offline or registers
+	// a key which is not in the registry.
+	Deregister(account basics.Address, on basics.Round) error
+
+	// Record sets the last-used round of the account's active key for the
+	// given participation action.
+	Record(account basics.Address, round basics.Round, participationType ParticipationAction) error
+
+	// Flush writes any pending Record updates to disk.
+	Flush() error
+
+	// Close flushes the registry and releases its database handles.
+	Close()
+}
+
+// ParticipationRegistrySchemaVersion is the latest version of the participation registry schema.
+const ParticipationRegistrySchemaVersion = 1
+
+const createParticipationRegistryTable = `CREATE TABLE IF NOT EXISTS ParticipationRegistry (
+	participationID BLOB PRIMARY KEY,
+	account BLOB NOT NULL,
+	firstValidRound INTEGER NOT NULL DEFAULT 0,
+	lastValidRound INTEGER NOT NULL DEFAULT 0,
+	keyDilution INTEGER NOT NULL DEFAULT 0,
+	voteID BLOB,
+	selectionID BLOB,
+	filename TEXT NOT NULL DEFAULT '',
+
+	lastVoteRound INTEGER NOT NULL DEFAULT 0,
+	lastBlockProposalRound INTEGER NOT NULL DEFAULT 0,
+	lastCompactCertRound INTEGER NOT NULL DEFAULT 0,
+	effectiveFirstRound INTEGER NOT NULL DEFAULT 0,
+	effectiveLastRound INTEGER NOT NULL DEFAULT 0
+)`
+
+const selectParticipationRecords = `SELECT participationID, account, firstValidRound, lastValidRound, keyDilution, voteID, selectionID, filename,
+	lastVoteRound, lastBlockProposalRound, lastCompactCertRound, effectiveFirstRound, effectiveLastRound
+	FROM ParticipationRegistry`
+
+// participationDB is the SQLite backed implementation of ParticipationRegistry.
+// All records are cached in memory; Record only updates the cache, and the
+// modified records are written back on Flush.
+type participationDB struct {
+	mu deadlock.RWMutex
+
+	cache map[ParticipationID]ParticipationRecord
+	dirty map[ParticipationID]struct{}
+
+	store db.Pair
+	log   logging.Logger
+}
+
+// MakeParticipationRegistry creates a registry backed by the given database
+// pair, initializing the schema if needed and loading all records.
+func MakeParticipationRegistry(accessor db.Pair, log logging.Logger) (ParticipationRegistry, error) {
+	return makeParticipationRegistry(accessor, log)
+}
+
+func makeParticipationRegistry(accessor db.Pair, log logging.Logger) (*participationDB, error) {
+	if log == nil {
+		return nil, errors.New("invalid logger provided")
+	}
+
+	registry := &participationDB{
+		cache: make(map[ParticipationID]ParticipationRecord),
+		dirty: make(map[ParticipationID]struct{}),
+		store: accessor,
+		log:   log,
+	}
+
+	err := accessor.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return participationRegistryMigrate(ctx, tx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize participation registry: %w", err)
+	}
+
+	records, err := registry.readAll()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load participation registry: %w", err)
+	}
+	for _, record := range records {
+		registry.cache[record.ParticipationID] = record
+	}
+
+	return registry, nil
+}
+
+func participationRegistryMigrate(ctx context.Context, tx *sql.Tx) error {
+	version, err := db.GetUserVersion(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	if version == 0 {
+		_, err = tx.Exec(createParticipationRegistryTable)
+		if err != nil {
+			return err
+		}
+		version = 1
+		_, err = db.SetUserVersion(ctx, tx, version)
+		if err != nil {
+			return err
+		}
+	}
+
+	if version != ParticipationRegistrySchemaVersion {
+		return fmt.Errorf("unsupported participation registry schema version %d (expected %d)", version, ParticipationRegistrySchemaVersion)
+	}
+	return nil
+}
+
+func (reg *participationDB) readAll() (records []ParticipationRecord, err error) {
+	err = reg.store.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.Query(selectParticipationRecords)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var record ParticipationRecord
+			var rawID, rawAccount, rawVoteID, rawSelectionID []byte
+			err = rows.Scan(&rawID, &rawAccount, &record.FirstValid, &record.LastValid, &record.KeyDilution,
+				&rawVoteID, &rawSelectionID, &record.Filename,
+				&record.LastVote, &record.LastBlockProposal, &record.LastCompactCertificate,
+				&record.EffectiveFirst, &record.EffectiveLast)
+			if err != nil {
+				return err
+			}
+			copy(record.ParticipationID[:], rawID)
+			copy(record.Account[:], rawAccount)
+			copy(record.VoteID[:], rawVoteID)
+			copy(record.SelectionID[:], rawSelectionID)
+			records = append(records, record)
+		}
+		return rows.Err()
+	})
+	return
+}
+
+// Insert implements ParticipationRegistry.
+func (reg *participationDB) Insert(part Participation, filename string) (id ParticipationID, err error) {
+	identity := part.Identity()
+	id = identity.ID()
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if _, has := reg.cache[id]; has {
+		return id, ErrAlreadyInserted
+	}
+
+	record := ParticipationRecord{
+		ParticipationID: id,
+		Account:         identity.Parent,
+		FirstValid:      identity.FirstValid,
+		LastValid:       identity.LastValid,
+		KeyDilution:     identity.KeyDilution,
+		VoteID:          identity.VoteID,
+		SelectionID:     identity.SelectionID,
+		Filename:        filename,
+	}
+
+	err = reg.store.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec(`INSERT INTO ParticipationRegistry (participationID, account, firstValidRound, lastValidRound, keyDilution, voteID, selectionID, filename)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			id[:], record.Account[:], record.FirstValid, record.LastValid, record.KeyDilution,
+			record.VoteID[:], record.SelectionID[:], record.Filename)
+		return err
+	})
+	if err != nil {
+		return id, fmt.Errorf("participationDB.Insert: unable to insert %s: %w", id, err)
+	}
+
+	reg.cache[id] = record
+	return id, nil
+}
+
+// Delete implements ParticipationRegistry.
+func (reg *participationDB) Delete(id ParticipationID) error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if _, has := reg.cache[id]; !has {
+		return ErrParticipationIDNotFound
+	}
+
+	err := reg.store.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM ParticipationRegistry WHERE participationID=?", id[:])
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("participationDB.Delete: unable to delete %s: %w", id, err)
+	}
+
+	delete(reg.cache, id)
+	delete(reg.dirty, id)
+	return nil
+}
+
+// Get implements ParticipationRegistry.
+func (reg *participationDB) Get(id ParticipationID) (ParticipationRecord, error) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	record, has := reg.cache[id]
+	if !has {
+		return ParticipationRecord{}, ErrParticipationIDNotFound
+	}
+	return record, nil
+}
+
+// GetAll implements ParticipationRegistry.
+func (reg *participationDB) GetAll() []ParticipationRecord {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	results := make([]ParticipationRecord, 0, len(reg.cache))
+	for _, record := range reg.cache {
+		results = append(results, record)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if c := bytes.Compare(results[i].Account[:], results[j].Account[:]); c != 0 {
+			return c < 0
+		}
+		if results[i].FirstValid != results[j].FirstValid {
+			return results[i].FirstValid < results[j].FirstValid
+		}
+		return bytes.Compare(results[i].ParticipationID[:], results[j].ParticipationID[:]) < 0
+	})
+	return results
+}
+
+// Register implements ParticipationRegistry.
+func (reg *participationDB) Register(id ParticipationID, on basics.Round) error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	record, has := reg.cache[id]
+	if !has {
+		return ErrParticipationIDNotFound
+	}
+	if on > record.LastValid {
+		return fmt.Errorf("participationDB.Register: cannot register %s at round %d after its last valid round %d", id, on, record.LastValid)
+	}
+
+	// Registering a key implicitly ends the registration of all other
+	// keys of the same account.
+	reg.deregisterLocked(record.Account, on, id)
+
+	record.EffectiveFirst = on
+	record.EffectiveLast = record.LastValid
+	reg.cache[id] = record
+	reg.dirty[id] = struct{}{}
+
+	return reg.flushLocked()
+}
+
+// Deregister implements ParticipationRegistry.
+func (reg *participationDB) Deregister(account basics.Address, on basics.Round) error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	reg.deregisterLocked(account, on, ParticipationID{})
+	return reg.flushLocked()
+}
+
+// deregisterLocked ends the registration of the account's keys, other than
+// the excluded one, which are registered at the given round.
+func (reg *participationDB) deregisterLocked(account basics.Address, on basics.Round, excluded ParticipationID) {
+	for id, record := range reg.cache {
+		if id == excluded || record.Account != account {
+			continue
+		}
+		if record.EffectiveLast >= on && record.EffectiveFirst < on {
+			record.EffectiveLast = on - 1
+			reg.cache[id] = record
+			reg.dirty[id] = struct{}{}
+		}
+	}
+}
+
+// Record implements ParticipationRegistry.
+func (reg *participationDB) Record(account basics.Address, round basics.Round, participationType ParticipationAction) error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	for id, record := range reg.cache {
+		if record.Account != account || !record.IsActive(round) {
+			continue
+		}
+
+		switch participationType {
+		case Vote:
+			if record.LastVote >= round {
+				return nil
+			}
+			record.LastVote = round
+		case BlockProposal:
+			if record.LastBlockProposal >= round {
+				return nil
+			}
+			record.LastBlockProposal = round
+		case CompactCertificate:
+			if record.LastCompactCertificate >= round {
+				return nil
+			}
+			record.LastCompactCertificate = round
+		default:
+			return ErrUnknownParticipationAction
+		}
+
+		reg.cache[id] = record
+		reg.dirty[id] = struct{}{}
+		return nil
+	}
+
+	return ErrActiveKeyNotFound
+}
+
+// Flush implements ParticipationRegistry.
+func (reg *participationDB) Flush() error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	return reg.flushLocked()
+}
+
+func (reg *participationDB) flushLocked() error {
+	if len(reg.dirty) == 0 {
+		return nil
+	}
+
+	err := reg.store.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		stmt, err := tx.Prepare(`UPDATE ParticipationRegistry SET lastVoteRound=?, lastBlockProposalRound=?, lastCompactCertRound=?,
+			effectiveFirstRound=?, effectiveLastRound=? WHERE participationID=?`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for id := range reg.dirty {
+			record := reg.cache[id]
+			_, err = stmt.Exec(record.LastVote, record.LastBlockProposal, record.LastCompactCertificate,
+				record.EffectiveFirst, record.EffectiveLast, id[:])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("participationDB.Flush: %w", err)
+	}
+
+	reg.dirty = make(map[ParticipationID]struct{})
+	return nil
+}
+
+// Close implements ParticipationRegistry.
+func (reg *participationDB) Close() {
+	err := reg.Flush()
+	if err != nil {
+		reg.log.Warnf("participationDB.Close: %v", err)
+	}
+	reg.store.Close()
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func getRegistry(t *testing.T) *participationDB {
+	rootDB, err := db.OpenPair(fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64()), true)
+	require.NoError(t, err)
+
+	registry, err := makeParticipationRegistry(rootDB, logging.TestingLog(t))
+	require.NoError(t, err)
+	require.NotNil(t, registry)
+
+	return registry
+}
+
+func makeTestParticipation(addrID int, first, last basics.Round, dilution uint64) Participation {
+	var addr basics.Address
+	addr[0] = byte(addrID)
+	p := Participation{
+		Parent:      addr,
+		FirstValid:  first,
+		LastValid:   last,
+		KeyDilution: dilution,
+		Voting:      &crypto.OneTimeSignatureSecrets{},
+		VRF:         &crypto.VRFSecrets{},
+	}
+	crypto.RandBytes(p.Voting.OneTimeSignatureVerifier[:])
+	crypto.RandBytes(p.VRF.PK[:])
+	return p
+}
+
+func TestParticipation_InsertGetDelete(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+	registry := getRegistry(t)
+	defer registry.Close()
+
+	p := makeTestParticipation(1, 1, 2000000, 3)
+	id, err := registry.Insert(p, "first.partkey")
+	a.NoError(err)
+	a.Equal(p.ID(), id)
+
+	_, err = registry.Insert(p, "first.partkey")
+	a.Equal(ErrAlreadyInserted, err)
+
+	record, err := registry.Get(id)
+	a.NoError(err)
+	a.Equal(p.Parent, record.Account)
+	a.Equal(p.FirstValid, record.FirstValid)
+	a.Equal(p.LastValid, record.LastValid)
+	a.Equal(p.KeyDilution, record.KeyDilution)
+	a.Equal(p.Voting.OneTimeSignatureVerifier, record.VoteID)
+	a.Equal(p.VRF.PK, record.SelectionID)
+	a.Equal("first.partkey", record.Filename)
+	a.Zero(record.EffectiveFirst)
+	a.Zero(record.EffectiveLast)
+	a.Len(registry.GetAll(), 1)
+
+	a.NoError(registry.Delete(id))
+	_, err = registry.Get(id)
+	a.Equal(ErrParticipationIDNotFound, err)
+	a.Len(registry.GetAll(), 0)
+	a.Equal(ErrParticipationIDNotFound, registry.Delete(id))
+}
+
+func TestParticipation_RegisterAndRecord(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+	registry := getRegistry(t)
+	defer registry.Close()
+
+	p1 := makeTestParticipation(1, 1, 2000000, 3)
+	p2 := makeTestParticipation(1, 2, 3000000, 3)
+	other := makeTestParticipation(2, 1, 2000000, 3)
+
+	id1, err := registry.Insert(p1, "")
+	a.NoError(err)
+	id2, err := registry.Insert(p2, "")
+	a.NoError(err)
+	otherID, err := registry.Insert(other, "")
+	a.NoError(err)
+
+	// nothing registered yet, so there is nothing to record.
+	a.Equal(ErrActiveKeyNotFound, registry.Record(p1.Parent, 10, Vote))
+
+	a.NoError(registry.Register(id1, 1))
+	a.NoError(registry.Register(otherID, 1))
+	a.NoError(registry.Record(p1.Parent, 10, Vote))
+	a.NoError(registry.Record(p1.Parent, 11, BlockProposal))
+	a.NoError(registry.Record(p1.Parent, 12, CompactCertificate))
+
+	// older rounds don't move the last used round backwards.
+	a.NoError(registry.Record(p1.Parent, 5, Vote))
+
+	record, err := registry.Get(id1)
+	a.NoError(err)
+	a.Equal(basics.Round(1), record.EffectiveFirst)
+	a.Equal(p1.LastValid, record.EffectiveLast)
+	a.Equal(basics.Round(10), record.LastVote)
+	a.Equal(basics.Round(11), record.LastBlockProposal)
+	a.Equal(basics.Round(12), record.LastCompactCertificate)
+
+	// registering the second key ends the first one, but not other accounts' keys.
+	a.NoError(registry.Register(id2, 50))
+	record, err = registry.Get(id1)
+	a.NoError(err)
+	a.Equal(basics.Round(49), record.EffectiveLast)
+	record, err = registry.Get(otherID)
+	a.NoError(err)
+	a.Equal(other.LastValid, record.EffectiveLast)
+
+	a.NoError(registry.Record(p1.Parent, 60, Vote))
+	record, err = registry.Get(id2)
+	a.NoError(err)
+	a.Equal(basics.Round(60), record.LastVote)
+
+	a.Error(registry.Register(id1, p1.LastValid+1))
+	a.Equal(ErrParticipationIDNotFound, registry.Register(ParticipationID{}, 1))
+	a.Equal(ErrUnknownParticipationAction, registry.Record(p1.Parent, 61, ParticipationAction(9000)))
+
+	// going offline ends the registered key, but not other accounts' keys.
+	a.NoError(registry.Deregister(p1.Parent, 100))
+	record, err = registry.Get(id2)
+	a.NoError(err)
+	a.Equal(basics.Round(50), record.EffectiveFirst)
+	a.Equal(basics.Round(99), record.EffectiveLast)
+	a.Equal(ErrActiveKeyNotFound, registry.Record(p1.Parent, 100, Vote))
+	record, err = registry.Get(otherID)
+	a.NoError(err)
+	a.Equal(other.LastValid, record.EffectiveLast)
+}
+
+func TestParticipation_GetAllOrder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+	registry := getRegistry(t)
+	defer registry.Close()
+
+	parts := []Participation{
+		makeTestParticipation(2, 1, 1000, 3),
+		makeTestParticipation(1, 500, 2000, 3),
+		makeTestParticipation(2, 100, 1000, 3),
+		makeTestParticipation(1, 1, 1000, 3),
+	}
+	for _, p := range parts {
+		_, err := registry.Insert(p, "")
+		a.NoError(err)
+	}
+
+	expected := []ParticipationID{parts[3].ID(), parts[1].ID(), parts[0].ID(), parts[2].ID()}
+	for i := 0; i < 5; i++ {
+		var ids []ParticipationID
+		for _, record := range registry.GetAll() {
+			ids = append(ids, record.ParticipationID)
+		}
+		a.Equal(expected, ids)
+	}
+}
+
+func TestParticipation_Reload(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	dir, err := ioutil.TempDir("", t.Name())
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "registry.sqlite")
+
+	accessor, err := db.OpenPair(filename, false)
+	a.NoError(err)
+	registry, err := makeParticipationRegistry(accessor, logging.TestingLog(t))
+	a.NoError(err)
+
+	p := makeTestParticipation(1, 1, 2000000, 3)
+	id, err := registry.Insert(p, "reload.partkey")
+	a.NoError(err)
+	a.NoError(registry.Register(id, 5))
+	a.NoError(registry.Record(p.Parent, 7, Vote))
+
+	// Close flushes the pending record.
+	registry.Close()
+
+	accessor, err = db.OpenPair(filename, false)
+	a.NoError(err)
+	registry, err = makeParticipationRegistry(accessor, logging.TestingLog(t))
+	a.NoError(err)
+	defer registry.Close()
+
+	record, err := registry.Get(id)
+	a.NoError(err)
+	a.Equal("reload.partkey", record.Filename)
+	a.Equal(basics.Round(5), record.EffectiveFirst)
+	a.Equal(basics.Round(7), record.LastVote)
+}
+
+func TestParticipation_ParseID(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	p := makeTestParticipation(1, 1, 2000000, 3)
+	id := p.ID()
+	parsed, err := ParseParticipationID(id.String())
+	a.NoError(err)
+	a.Equal(id, parsed)
+
+	_, err = ParseParticipationID("not-an-id")
+	a.Error(err)
+}
@@ -53,19 +53,27 @@ type AccountManager struct {
 	// AccountRegistered telemetry events
 	registeredAccounts map[string]bool
 
+	registry account.ParticipationRegistry
+
 	log logging.Logger
 }
 
 // MakeAccountManager creates a new AccountManager with a custom logger
-func MakeAccountManager(log logging.Logger) *AccountManager {
+func MakeAccountManager(log logging.Logger, registry account.ParticipationRegistry) *AccountManager {
 	manager := &AccountManager{}
 	manager.log = log
 	manager.partKeys = make(map[ParticipationKeyIdentity]account.PersistedParticipation)
 	manager.registeredAccounts = make(map[string]bool)
+	manager.registry = registry
 
 	return manager
 }
 
+// Registry returns the participation registry used by the AccountManager.
+func (manager *AccountManager) Registry() account.ParticipationRegistry {
+	return manager.registry
+}
+
 // Keys returns a list of Participation accounts.
 func (manager *AccountManager) Keys(rnd basics.Round) (out []account.Participation) {
 	manager.mu.Lock()
@@ -95,8 +103,9 @@ func (manager *AccountManager) HasLiveKeys(from, to basics.Round) bool {
 
 // AddParticipation adds a new account.Participation to be managed.
 // The return value indicates if the key has been added (true) or
-// if this is a duplicate key (false).
-func (manager *AccountManager) AddParticipation(participation account.PersistedParticipation) bool {
+// if this is a duplicate key (false). The filename is the name of the
+// participation key database, which is recorded in the registry.
+func (manager *AccountManager) AddParticipation(participation account.PersistedParticipation, filename string) bool {
 	manager.mu.Lock()
 	defer manager.mu.Unlock()
 
@@ -119,6 +128,13 @@ func (manager *AccountManager) AddParticipation(participation account.PersistedP
 
 	manager.partKeys[partkeyID] = participation
 
+	if manager.registry != nil {
+		_, err := manager.registry.Insert(participation.Participation, filename)
+		if err != nil && err != account.ErrAlreadyInserted {
+			manager.log.Warnf("AccountManager.AddParticipation: failed to insert %s into the participation registry: %v", address, err)
+		}
+	}
+
 	addressString := address.String()
 	manager.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.PartKeyRegisteredEvent, telemetryspec.PartKeyRegisteredEventDetails{
 		Address:    addressString,
@@ -138,6 +154,36 @@ func (manager *AccountManager) AddParticipation(participation account.PersistedP
 	return true
 }
 
+// RemoveParticipation stops managing the participation key with the given
+// ID and closes its database. It returns false if no such key is managed.
+func (manager *AccountManager) RemoveParticipation(id account.ParticipationID) bool {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	for partkeyID, part := range manager.partKeys {
+		if part.ID() != id {
+			continue
+		}
+		delete(manager.partKeys, partkeyID)
+		part.Close()
+		return true
+	}
+	return false
+}
+
+// Record records the use of the active participation key of the given
+// account in the participation registry.
+func (manager *AccountManager) Record(address basics.Address, round basics.Round, participationType account.ParticipationAction) {
+	if manager.registry == nil {
+		return
+	}
+
+	err := manager.registry.Record(address, round, participationType)
+	if err != nil && err != account.ErrActiveKeyNotFound {
+		manager.log.Warnf("AccountManager.Record: failed to record participation of %s on round %d: %v", address, round, err)
+	}
+}
+
 // DeleteOldKeys deletes all accounts' ephemeral keys strictly older than the
 // next round needed for each account.
 func (manager *AccountManager) DeleteOldKeys(latestHdr bookkeeping.BlockHeader, ccSigs map[basics.Address]basics.Round, agreementProto config.ConsensusParams) {
@@ -184,4 +230,12 @@ func (manager *AccountManager) DeleteOldKeys(latestHdr bookkeeping.BlockHeader,
 			logging.Base().Warnf("%s: %v", errString, err)
 		}
 	}
+
+	// flush the usage recorded in the participation registry since the last round.
+	if manager.registry != nil {
+		err := manager.registry.Flush()
+		if err != nil {
+			manager.log.Warnf("AccountManager.DeleteOldKeys(): failed to flush the participation registry: %v", err)
+		}
+	}
 }
@@ -162,6 +162,17 @@ func (l *Ledger) LookupTxid(txid transactions.Txid, r basics.Round) (stxn transa
 	return transactions.SignedTxnWithAD{}, false, nil
 }
 
+// LookupConfirmedTxn returns a recently committed transaction with a given ID,
+// along with the round in which it was committed.
+func (l *Ledger) LookupConfirmedTxn(txid transactions.Txid) (stxn transactions.SignedTxnWithAD, r basics.Round, found bool, err error) {
+	r, found = l.LookupConfirmedTxid(txid)
+	if !found {
+		return transactions.SignedTxnWithAD{}, 0, false, nil
+	}
+	stxn, found, err = l.LookupTxid(txid, r)
+	return stxn, r, found, err
+}
+
 // LastRound returns the local latest round of the network i.e. the *last* written block
 func (l *Ledger) LastRound() basics.Round {
 	return l.Latest()
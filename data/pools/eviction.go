@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var transactionPoolEvictedGroups = metrics.MakeCounter(metrics.TransactionPoolEvictedGroups)
+var transactionPoolEvictedTxns = metrics.MakeCounter(metrics.TransactionPoolEvictedTxns)
+
+// errEvictedByHigherFee is the status reported for the transactions evicted from a
+// full pool in favor of a transaction group paying a higher fee per byte.
+const errEvictedByHigherFee = "transaction evicted from the full transaction pool by a group paying a higher fee per byte"
+
+// AdmissionFee describes the fees a transaction needs to pay to get into the pool.
+type AdmissionFee struct {
+	// FeePerByte is the minimum fee per byte required by the pool, which
+	// grows when the pool holds more than one block worth of transactions.
+	FeePerByte uint64
+
+	// EvictionFeePerByte is set when the pool is full, to the fee per byte
+	// a transaction needs to exceed in order to evict the lowest paying
+	// transaction group from the pool.
+	EvictionFeePerByte uint64
+
+	// PendingCount is the number of transactions in the pool.
+	PendingCount int
+
+	// Capacity is the maximum number of transactions in the pool.
+	Capacity int
+}
+
+// groupFee is the fee paid by a transaction group, and its total encoded length.
+type groupFee struct {
+	feePerByte uint64
+	length     uint64
+}
+
+// makeGroupFee computes the fee per byte paid by a transaction group, as the
+// total fee of the group divided by its total encoded length. Groups which are
+// exempt from fees are never evicted, and are reported as paying the most.
+func makeGroupFee(txgroup []transactions.SignedTxn) groupFee {
+	var fee, length uint64
+	for _, t := range txgroup {
+		fee = basics.AddSaturate(fee, t.Txn.Fee.Raw)
+		length += uint64(t.GetEncodedLength())
+	}
+
+	res := groupFee{length: length}
+	if isFeeExempt(txgroup) {
+		res.feePerByte = math.MaxUint64
+	} else if length > 0 {
+		res.feePerByte = fee / length
+	}
+	return res
+}
+
+// canEvictFor checks whether the transaction group pays a higher fee per byte
+// than the lowest paying group in the pool, and could therefore be admitted to
+// a full pool by evicting groups paying less than it does.
+func (pool *TransactionPool) canEvictFor(txgroup []transactions.SignedTxn) bool {
+	feePerByte := makeGroupFee(txgroup).feePerByte
+
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+	return feePerByte > pool.lowestPendingFeePerByte && feePerByte != math.MaxUint64
+}
+
+// evictLowerFeeGroups makes room for the transaction group by evicting the pending
+// groups paying the lowest fee per byte, provided that they all pay less than the
+// group does. The block evaluator is recomputed after every eviction, so that the
+// evicted groups can't make it into the next block, and the remaining groups that
+// depended on them are dropped.
+//
+// evictLowerFeeGroups assumes that pool.mu is locked.
+func (pool *TransactionPool) evictLowerFeeGroups(txgroup []transactions.SignedTxn) error {
+	feePerByte := makeGroupFee(txgroup).feePerByte
+
+	pool.pendingMu.RLock()
+	needed := len(pool.pendingTxids) + len(txgroup) - pool.txPoolMaxSize
+	candidates := make([]int, 0)
+	for i, fee := range pool.pendingGroupFees {
+		if fee.feePerByte < feePerByte {
+			candidates = append(candidates, i)
+		}
+	}
+	// evict the lowest paying groups first, and the most recent ones among those paying the same.
+	sort.Slice(candidates, func(i, j int) bool {
+		fi, fj := pool.pendingGroupFees[candidates[i]].feePerByte, pool.pendingGroupFees[candidates[j]].feePerByte
+		if fi != fj {
+			return fi < fj
+		}
+		return candidates[i] > candidates[j]
+	})
+
+	evicted := make(map[int]bool)
+	freed := 0
+	for _, i := range candidates {
+		if freed >= needed {
+			break
+		}
+		evicted[i] = true
+		freed += len(pool.pendingTxGroups[i])
+	}
+	pool.pendingMu.RUnlock()
+
+	if freed < needed {
+		return fmt.Errorf("transaction pool have reached capacity, and the group's fee per byte %d is too low to evict pending transactions", feePerByte)
+	}
+	if len(evicted) == 0 {
+		return nil
+	}
+
+	pool.pendingMu.Lock()
+	remainingGroups := make([][]transactions.SignedTxn, 0, len(pool.pendingTxGroups)-len(evicted))
+	remainingFees := make([]groupFee, 0, len(pool.pendingTxGroups)-len(evicted))
+	evictedTxns := 0
+	pool.lowestPendingFeePerByte = math.MaxUint64
+	for i, group := range pool.pendingTxGroups {
+		if !evicted[i] {
+			remainingGroups = append(remainingGroups, group)
+			remainingFees = append(remainingFees, pool.pendingGroupFees[i])
+			if pool.pendingGroupFees[i].feePerByte < pool.lowestPendingFeePerByte {
+				pool.lowestPendingFeePerByte = pool.pendingGroupFees[i].feePerByte
+			}
+			continue
+		}
+		for _, tx := range group {
+			delete(pool.pendingTxids, tx.ID())
+			pool.statusCache.put(tx, errEvictedByHigherFee)
+		}
+		evictedTxns += len(group)
+	}
+	pool.pendingTxGroups = remainingGroups
+	pool.pendingGroupFees = remainingFees
+	pool.pendingMu.Unlock()
+
+	transactionPoolEvictedGroups.AddUint64(uint64(len(evicted)), nil)
+	transactionPoolEvictedTxns.AddUint64(uint64(evictedTxns), nil)
+	pool.log.Infof("TransactionPool: evicted %d transaction groups (%d transactions) paying less than %d per byte", len(evicted), evictedTxns, feePerByte)
+
+	pool.recomputeBlockEvaluator(make(map[transactions.Txid]basics.Round), 0)
+	if pool.pendingBlockEvaluator == nil {
+		return fmt.Errorf("no pending block evaluator")
+	}
+	return nil
+}
+
+// AdmissionFee returns the fees a transaction currently needs to pay to get into the pool.
+func (pool *TransactionPool) AdmissionFee() AdmissionFee {
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+
+	fee := AdmissionFee{
+		FeePerByte:   pool.FeePerByte(),
+		PendingCount: len(pool.pendingTxids),
+		Capacity:     pool.txPoolMaxSize,
+	}
+	if fee.PendingCount >= fee.Capacity && pool.lowestPendingFeePerByte != math.MaxUint64 {
+		fee.EvictionFeePerByte = pool.lowestPendingFeePerByte
+	}
+	return fee
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"math"
+	"sort"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+)
+
+// congestedBlockFullness is the fraction of the block capacity above which a
+// block is considered congested, meaning that its lowest paying transactions
+// were competing with others for a place in it.
+const congestedBlockFullness = 0.9
+
+// blockFeeStats summarizes the fees and the fullness of a committed block.
+type blockFeeStats struct {
+	// fullness is the fraction of the block capacity used by its transactions.
+	fullness float64
+
+	// minFeePerByte is the lowest fee per byte paid by a transaction of the block.
+	minFeePerByte uint64
+}
+
+// FeeEstimate is the fee per byte a transaction is expected to need in order to
+// be committed within the given number of rounds.
+type FeeEstimate struct {
+	Rounds     uint64
+	FeePerByte uint64
+}
+
+// makeBlockFeeStats computes the fee statistics of a block.
+func makeBlockFeeStats(block bookkeeping.Block) (stats blockFeeStats, ok bool) {
+	proto, ok := config.Consensus[block.CurrentProtocol]
+	if !ok || proto.MaxTxnBytesPerBlock == 0 {
+		return blockFeeStats{}, false
+	}
+	payset, err := block.DecodePaysetFlat()
+	if err != nil {
+		return blockFeeStats{}, false
+	}
+
+	stats.minFeePerByte = math.MaxUint64
+	var blockBytes uint64
+	for _, stxn := range payset {
+		length := uint64(stxn.GetEncodedLength())
+		blockBytes += length
+		if length == 0 || stxn.Txn.Fee.IsZero() {
+			continue
+		}
+		if feePerByte := stxn.Txn.Fee.Raw / length; feePerByte < stats.minFeePerByte {
+			stats.minFeePerByte = feePerByte
+		}
+	}
+	if stats.minFeePerByte == math.MaxUint64 {
+		stats.minFeePerByte = 0
+	}
+	stats.fullness = float64(blockBytes) / float64(proto.MaxTxnBytesPerBlock)
+	return stats, true
+}
+
+// recordBlockFeeStats adds the fee statistics of a new block to the fee history.
+func (pool *TransactionPool) recordBlockFeeStats(block bookkeeping.Block) {
+	if pool.feeHistorySize <= 0 {
+		return
+	}
+	stats, ok := makeBlockFeeStats(block)
+	if !ok {
+		return
+	}
+
+	pool.feeHistoryMu.Lock()
+	defer pool.feeHistoryMu.Unlock()
+	pool.feeHistory = append(pool.feeHistory, stats)
+	if len(pool.feeHistory) > pool.feeHistorySize {
+		pool.feeHistory = pool.feeHistory[len(pool.feeHistory)-pool.feeHistorySize:]
+	}
+}
+
+// EstimateFees estimates the fee per byte a transaction needs to pay in order to be
+// committed within each of the given numbers of rounds. Each estimate is the highest of:
+//  - the minimum fee per byte required by the pool;
+//  - the fee per byte needed to outbid the pending transactions filling up the
+//    blocks of these rounds;
+//  - the lowest fee per byte paid in the congested recent blocks, when there were
+//    enough of them to expect the coming blocks to be congested as well.  Targets
+//    beyond the fee history expect the coming blocks to be congested like the
+//    recent ones.
+func (pool *TransactionPool) EstimateFees(targets []uint64) []FeeEstimate {
+	var maxTxnBytesPerBlock uint64
+	hdr, err := pool.ledger.BlockHdr(pool.ledger.Latest())
+	if err == nil {
+		maxTxnBytesPerBlock = uint64(config.Consensus[hdr.CurrentProtocol].MaxTxnBytesPerBlock)
+	}
+
+	// the minimum fees paid in the congested recent blocks, highest first.
+	pool.feeHistoryMu.Lock()
+	history := uint64(len(pool.feeHistory))
+	var congested []uint64
+	for _, stats := range pool.feeHistory {
+		if stats.fullness >= congestedBlockFullness {
+			congested = append(congested, stats.minFeePerByte)
+		}
+	}
+	pool.feeHistoryMu.Unlock()
+	sort.Slice(congested, func(i, j int) bool { return congested[i] > congested[j] })
+
+	// the pending transaction groups, highest paying first.
+	pool.pendingMu.RLock()
+	pending := append([]groupFee(nil), pool.pendingGroupFees...)
+	pool.pendingMu.RUnlock()
+	sort.Slice(pending, func(i, j int) bool { return pending[i].feePerByte > pending[j].feePerByte })
+
+	poolFeePerByte := pool.FeePerByte()
+	estimates := make([]FeeEstimate, len(targets))
+	for i, rounds := range targets {
+		estimate := FeeEstimate{Rounds: rounds, FeePerByte: poolFeePerByte}
+
+		// the pending transactions paying more would fill up the blocks of these rounds.
+		capacity := rounds * maxTxnBytesPerBlock
+		var queued uint64
+		for _, fee := range pending {
+			if capacity == 0 {
+				break
+			}
+			queued += fee.length
+			if queued >= capacity {
+				if fee.feePerByte != math.MaxUint64 && fee.feePerByte+1 > estimate.FeePerByte {
+					estimate.FeePerByte = fee.feePerByte + 1
+				}
+				break
+			}
+		}
+
+		// a transaction only needs to get into the least competitive of these blocks,
+		// each of the recent blocks standing for several of them when the target is
+		// longer than the history.
+		sampled := rounds
+		if sampled > history {
+			sampled = history
+		}
+		if sampled > 0 && uint64(len(congested)) >= sampled && congested[sampled-1] > estimate.FeePerByte {
+			estimate.FeePerByte = congested[sampled-1]
+		}
+		estimates[i] = estimate
+	}
+	return estimates
+}
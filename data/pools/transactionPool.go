@@ -18,6 +18,7 @@ package pools
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,8 +35,11 @@ import (
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/condvar"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
+var transactionPoolRememberSeconds = metrics.MakeLatencyHistogram(metrics.TransactionPoolRememberSeconds)
+
 // A TransactionPool prepares valid blocks for proposal and caches
 // validated transaction groups.
 //
@@ -57,6 +61,7 @@ type TransactionPool struct {
 	logAssembleStats     bool
 	expFeeFactor         uint64
 	txPoolMaxSize        int
+	feeHistorySize       int
 	ledger               *ledger.Ledger
 
 	mu                     deadlock.Mutex
@@ -79,33 +84,59 @@ type TransactionPool struct {
 	pendingTxGroups [][]transactions.SignedTxn
 	pendingTxids    map[transactions.Txid]transactions.SignedTxn
 
+	// pendingGroupFees holds the fee paid by each of the pendingTxGroups,
+	// and lowestPendingFeePerByte the lowest fee per byte among them.
+	// Both are protected by pendingMu.
+	pendingGroupFees        []groupFee
+	lowestPendingFeePerByte uint64
+
 	// Calls to remember() add transactions to rememberedTxGroups and
 	// rememberedTxids.  Calling rememberCommit() adds them to the
 	// pendingTxGroups and pendingTxids.  This allows us to batch the
 	// changes in OnNewBlock() without preventing a concurrent call
 	// to PendingTxGroups() or Verified().
-	rememberedTxGroups [][]transactions.SignedTxn
-	rememberedTxids    map[transactions.Txid]transactions.SignedTxn
+	rememberedTxGroups  [][]transactions.SignedTxn
+	rememberedTxids     map[transactions.Txid]transactions.SignedTxn
+	rememberedGroupFees []groupFee
+
+	// admissionListeners are notified of the transaction groups
+	// admitted to the pool by Remember().  Protected by mu.
+	admissionListeners []AdmissionListener
+
+	// feeHistoryMu protects feeHistory, which holds the fee statistics
+	// of the latest feeHistorySize blocks, oldest first.
+	feeHistoryMu deadlock.Mutex
+	feeHistory   []blockFeeStats
 
 	log logging.Logger
 }
 
+// AdmissionListener represents an object that needs to get notified when
+// a transaction group is admitted to the pool.
+type AdmissionListener interface {
+	// OnTxGroupAdmitted is called by Remember after the pool lock is
+	// released.  It should not block, since the caller waits for it.
+	OnTxGroupAdmitted(txgroup []transactions.SignedTxn)
+}
+
 // MakeTransactionPool makes a transaction pool.
 func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Logger) *TransactionPool {
 	if cfg.TxPoolExponentialIncreaseFactor < 1 {
 		cfg.TxPoolExponentialIncreaseFactor = 1
 	}
 	pool := TransactionPool{
-		pendingTxids:         make(map[transactions.Txid]transactions.SignedTxn),
-		rememberedTxids:      make(map[transactions.Txid]transactions.SignedTxn),
-		expiredTxCount:       make(map[basics.Round]int),
-		ledger:               ledger,
-		statusCache:          makeStatusCache(cfg.TxPoolSize),
-		logProcessBlockStats: cfg.EnableProcessBlockStats,
-		logAssembleStats:     cfg.EnableAssembleStats,
-		expFeeFactor:         cfg.TxPoolExponentialIncreaseFactor,
-		txPoolMaxSize:        cfg.TxPoolSize,
-		log:                  log,
+		pendingTxids:            make(map[transactions.Txid]transactions.SignedTxn),
+		lowestPendingFeePerByte: math.MaxUint64,
+		rememberedTxids:         make(map[transactions.Txid]transactions.SignedTxn),
+		expiredTxCount:          make(map[basics.Round]int),
+		ledger:                  ledger,
+		statusCache:             makeStatusCache(cfg.TxPoolSize),
+		logProcessBlockStats:    cfg.EnableProcessBlockStats,
+		logAssembleStats:        cfg.EnableAssembleStats,
+		expFeeFactor:            cfg.TxPoolExponentialIncreaseFactor,
+		txPoolMaxSize:           cfg.TxPoolSize,
+		feeHistorySize:          cfg.SuggestedFeeBlockHistory,
+		log:                     log,
 	}
 	pool.cond.L = &pool.mu
 	pool.assemblyCond.L = &pool.assemblyMu
@@ -156,8 +187,11 @@ var ErrStaleBlockAssemblyRequest = fmt.Errorf("AssembleBlock: requested block as
 func (pool *TransactionPool) Reset() {
 	pool.pendingTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.pendingTxGroups = nil
+	pool.pendingGroupFees = nil
+	pool.lowestPendingFeePerByte = math.MaxUint64
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.rememberedTxGroups = nil
+	pool.rememberedGroupFees = nil
 	pool.expiredTxCount = make(map[basics.Round]int)
 	pool.numPendingWholeBlocks = 0
 	pool.pendingBlockEvaluator = nil
@@ -220,17 +254,26 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 	if flush {
 		pool.pendingTxGroups = pool.rememberedTxGroups
 		pool.pendingTxids = pool.rememberedTxids
+		pool.pendingGroupFees = pool.rememberedGroupFees
+		pool.lowestPendingFeePerByte = math.MaxUint64
 		pool.ledger.VerifiedTransactionCache().UpdatePinned(pool.pendingTxids)
 	} else {
 		pool.pendingTxGroups = append(pool.pendingTxGroups, pool.rememberedTxGroups...)
+		pool.pendingGroupFees = append(pool.pendingGroupFees, pool.rememberedGroupFees...)
 
 		for txid, txn := range pool.rememberedTxids {
 			pool.pendingTxids[txid] = txn
 		}
 	}
+	for _, fee := range pool.rememberedGroupFees {
+		if fee.feePerByte < pool.lowestPendingFeePerByte {
+			pool.lowestPendingFeePerByte = fee.feePerByte
+		}
+	}
 
 	pool.rememberedTxGroups = nil
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
+	pool.rememberedGroupFees = nil
 }
 
 // PendingCount returns the number of transactions currently pending in the pool.
@@ -310,14 +353,8 @@ func (pool *TransactionPool) computeFeePerByte() uint64 {
 // checkSufficientFee take a set of signed transactions and verifies that each transaction has
 // sufficient fee to get into the transaction pool
 func (pool *TransactionPool) checkSufficientFee(txgroup []transactions.SignedTxn) error {
-	// Special case: the compact cert transaction, if issued from the
-	// special compact-cert-sender address, in a singleton group, pays
-	// no fee.
-	if len(txgroup) == 1 {
-		t := txgroup[0].Txn
-		if t.Type == protocol.CompactCertTx && t.Sender == transactions.CompactCertSender && t.Fee.IsZero() {
-			return nil
-		}
+	if isFeeExempt(txgroup) {
+		return nil
 	}
 
 	// get the current fee per byte
@@ -334,11 +371,26 @@ func (pool *TransactionPool) checkSufficientFee(txgroup []transactions.SignedTxn
 	return nil
 }
 
+// isFeeExempt checks whether the transaction group is the compact cert
+// transaction, which, if issued from the special compact-cert-sender
+// address, in a singleton group, pays no fee.
+func isFeeExempt(txgroup []transactions.SignedTxn) bool {
+	if len(txgroup) == 1 {
+		t := txgroup[0].Txn
+		if t.Type == protocol.CompactCertTx && t.Sender == transactions.CompactCertSender && t.Fee.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
 // Test performs basic duplicate detection and well-formedness checks
 // on a transaction group without storing the group.
 func (pool *TransactionPool) Test(txgroup []transactions.SignedTxn) error {
 	if err := pool.checkPendingQueueSize(len(txgroup)); err != nil {
-		return err
+		if !pool.canEvictFor(txgroup) {
+			return err
+		}
 	}
 
 	pool.mu.Lock()
@@ -409,6 +461,7 @@ func (pool *TransactionPool) ingest(txgroup []transactions.SignedTxn, params poo
 	}
 
 	pool.rememberedTxGroups = append(pool.rememberedTxGroups, txgroup)
+	pool.rememberedGroupFees = append(pool.rememberedGroupFees, makeGroupFee(txgroup))
 	for _, t := range txgroup {
 		pool.rememberedTxids[t.ID()] = t
 	}
@@ -423,21 +476,59 @@ func (pool *TransactionPool) RememberOne(t transactions.SignedTxn) error {
 
 // Remember stores the provided transaction group.
 // Precondition: Only Remember() properly-signed and well-formed transactions (i.e., ensure t.WellFormed())
-func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
+func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) (err error) {
+	start := time.Now()
+	defer func() {
+		transactionPoolRememberSeconds.ObserveSince(start, map[string]string{"accepted": fmt.Sprintf("%t", err == nil)})
+	}()
+
+	evict := false
 	if err := pool.checkPendingQueueSize(len(txgroup)); err != nil {
-		return err
+		if !pool.canEvictFor(txgroup) {
+			return err
+		}
+		evict = true
+	}
+
+	listeners, err := pool.admit(txgroup, evict)
+	if err != nil {
+		return fmt.Errorf("TransactionPool.Remember: %v", err)
+	}
+
+	for _, listener := range listeners {
+		listener.OnTxGroupAdmitted(txgroup)
 	}
+	return nil
+}
 
+// admit adds the transaction group to the pool, evicting lower-paying groups
+// first if needed, and returns the listeners to notify of the admission.
+func (pool *TransactionPool) admit(txgroup []transactions.SignedTxn, evict bool) ([]AdmissionListener, error) {
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
+	if evict {
+		err := pool.evictLowerFeeGroups(txgroup)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err := pool.remember(txgroup)
 	if err != nil {
-		return fmt.Errorf("TransactionPool.Remember: %v", err)
+		return nil, err
 	}
 
 	pool.rememberCommit(false)
-	return nil
+	return pool.admissionListeners, nil
+}
+
+// RegisterAdmissionListeners registers listeners that will be notified of
+// the transaction groups admitted to the pool.
+func (pool *TransactionPool) RegisterAdmissionListeners(listeners []AdmissionListener) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.admissionListeners = append(pool.admissionListeners, listeners...)
 }
 
 // Lookup returns the error associated with a transaction that used
@@ -468,6 +559,8 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 	var knownCommitted uint
 	var unknownCommitted uint
 
+	pool.recordBlockFeeStats(block)
+
 	committedTxids := delta.Txids
 	if pool.logProcessBlockStats {
 		pool.pendingMu.RLock()
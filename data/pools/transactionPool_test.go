@@ -21,6 +21,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -855,6 +856,57 @@ func TestRemove(t *testing.T) {
 	require.Equal(t, transactionPool.PendingTxGroups(), [][]transactions.SignedTxn{{signedTx}})
 }
 
+// poolQueryingListener looks at the pool when notified of an admission, which
+// would deadlock if it was notified with the pool lock held.
+type poolQueryingListener struct {
+	pool     *TransactionPool
+	admitted []int
+}
+
+func (l *poolQueryingListener) OnTxGroupAdmitted(txgroup []transactions.SignedTxn) {
+	l.admitted = append(l.admitted, l.pool.PendingCount())
+}
+
+func TestAdmissionListener(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 2
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		secrets[i] = secret
+		addresses[i] = basics.Address(secret.SignatureVerifier)
+	}
+
+	ledger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+	listener := &poolQueryingListener{pool: transactionPool}
+	transactionPool.RegisterAdmissionListeners([]AdmissionListener{listener})
+
+	for i := 0; i < 2; i++ {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[0],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee + 1},
+				FirstValid:  0,
+				LastValid:   10,
+				Note:        []byte{byte(i)},
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[1],
+			},
+		}
+		require.NoError(t, transactionPool.RememberOne(tx.Sign(secrets[0])))
+	}
+	require.Equal(t, []int{1, 2}, listener.admitted)
+}
+
 func TestLogicSigOK(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -1271,3 +1323,163 @@ func TestTxPoolSizeLimits(t *testing.T) {
 		}
 	}
 }
+
+func TestTxPoolFeeEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	// Generate accounts
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		addr := basics.Address(secret.SignatureVerifier)
+		secrets[i] = secret
+		addresses[i] = addr
+	}
+
+	// the third account is only funded by a pending transaction.
+	mockLedger := makeMockLedger(t, initAccFixed(addresses[:2], 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = 4
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	uniqueTxID := 0
+	makeTx := func(sender, receiver int, fee uint64, amount uint64) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[sender],
+				Fee:         basics.MicroAlgos{Raw: fee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        []byte{byte(uniqueTxID)},
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[receiver],
+				Amount:   basics.MicroAlgos{Raw: amount},
+			},
+		}
+		uniqueTxID++
+		return tx.Sign(secrets[sender])
+	}
+
+	funding := makeTx(0, 2, proto.MinTxnFee, 2*proto.MinBalance)
+	dependent := makeTx(2, 0, 5*proto.MinTxnFee, 1)
+	fillers := []transactions.SignedTxn{makeTx(0, 1, 3*proto.MinTxnFee, 1), makeTx(1, 0, 3*proto.MinTxnFee, 1)}
+
+	require.NoError(t, transactionPool.RememberOne(funding))
+	require.NoError(t, transactionPool.RememberOne(dependent))
+	for _, tx := range fillers {
+		require.NoError(t, transactionPool.RememberOne(tx))
+	}
+	require.Equal(t, 4, transactionPool.PendingCount())
+	require.Equal(t, makeGroupFee([]transactions.SignedTxn{funding}).feePerByte, transactionPool.AdmissionFee().EvictionFeePerByte)
+
+	// a transaction paying as little as the lowest pending one is rejected.
+	require.Error(t, transactionPool.Test([]transactions.SignedTxn{makeTx(1, 0, proto.MinTxnFee, 1)}))
+	require.Error(t, transactionPool.RememberOne(makeTx(1, 0, proto.MinTxnFee, 1)))
+
+	// a higher paying transaction evicts the lowest paying one, along with the transactions depending on it.
+	highFee := makeTx(1, 0, 10*proto.MinTxnFee, 1)
+	require.NoError(t, transactionPool.Test([]transactions.SignedTxn{highFee}))
+	require.NoError(t, transactionPool.RememberOne(highFee))
+	require.Equal(t, 3, transactionPool.PendingCount())
+	require.Zero(t, transactionPool.AdmissionFee().EvictionFeePerByte)
+
+	_, txErr, found := transactionPool.Lookup(funding.ID())
+	require.True(t, found)
+	require.Equal(t, errEvictedByHigherFee, txErr)
+	_, txErr, found = transactionPool.Lookup(dependent.ID())
+	require.True(t, found)
+	require.NotEmpty(t, txErr)
+	for _, tx := range append(fillers, highFee) {
+		_, txErr, found = transactionPool.Lookup(tx.ID())
+		require.True(t, found)
+		require.Empty(t, txErr)
+	}
+
+	// every eviction recomputes the pool, so that the evicted transactions are
+	// not assembled into the next block.
+	funding2 := makeTx(0, 2, 2*proto.MinTxnFee, 2*proto.MinBalance)
+	dependent2 := makeTx(2, 0, 5*proto.MinTxnFee, 1)
+	require.NoError(t, transactionPool.RememberOne(funding2))
+	require.Error(t, transactionPool.RememberOne(dependent2))
+	require.Equal(t, 3, transactionPool.PendingCount())
+	_, txErr, found = transactionPool.Lookup(funding2.ID())
+	require.True(t, found)
+	require.Equal(t, errEvictedByHigherFee, txErr)
+
+	blk, err := transactionPool.AssembleBlock(mockLedger.Latest()+1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	payset, err := blk.Block().DecodePaysetFlat()
+	require.NoError(t, err)
+	var assembled []transactions.Txid
+	for _, stxn := range payset {
+		assembled = append(assembled, stxn.ID())
+	}
+	require.ElementsMatch(t, []transactions.Txid{fillers[0].ID(), fillers[1].ID(), highFee.ID()}, assembled)
+	require.NoError(t, mockLedger.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), ledgercore.StateDelta{})
+	require.Zero(t, transactionPool.PendingCount())
+}
+
+func TestTransactionPool_EstimateFees(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger := makeMockLedger(t, initAccFixed([]basics.Address{{1}}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	targets := []uint64{1, 3, 10}
+	for _, estimate := range transactionPool.EstimateFees(targets) {
+		require.Zero(t, estimate.FeePerByte)
+	}
+
+	// two of the recent blocks were congested.
+	transactionPool.feeHistory = []blockFeeStats{
+		{fullness: 0.95, minFeePerByte: 5},
+		{fullness: 0.5, minFeePerByte: 1},
+		{fullness: 1, minFeePerByte: 10},
+	}
+	estimates := transactionPool.EstimateFees(targets)
+	require.Equal(t, uint64(10), estimates[0].FeePerByte)
+	require.Zero(t, estimates[1].FeePerByte)
+	require.Zero(t, estimates[2].FeePerByte)
+
+	// targets beyond the history expect the coming blocks to be as congested as the recent ones.
+	transactionPool.feeHistory[1].fullness = 0.9
+	estimates = transactionPool.EstimateFees(targets)
+	require.Equal(t, uint64(10), estimates[0].FeePerByte)
+	require.Equal(t, uint64(1), estimates[1].FeePerByte)
+	require.Equal(t, uint64(1), estimates[2].FeePerByte)
+	transactionPool.feeHistory[1].fullness = 0.5
+
+	// the pending transactions fill up more than the next block.
+	half := uint64(proto.MaxTxnBytesPerBlock/2 + 1)
+	transactionPool.pendingGroupFees = []groupFee{
+		{feePerByte: 20, length: half},
+		{feePerByte: 30, length: half},
+		{feePerByte: 2, length: half},
+	}
+	estimates = transactionPool.EstimateFees(targets)
+	require.Equal(t, uint64(21), estimates[0].FeePerByte)
+	require.Zero(t, estimates[1].FeePerByte)
+	require.Equal(t, uint64(1), estimates[0].Rounds)
+	require.Equal(t, uint64(10), estimates[2].Rounds)
+
+	// the history only records the latest blocks.
+	transactionPool.feeHistory = nil
+	for i := 0; i < 2*cfg.SuggestedFeeBlockHistory; i++ {
+		transactionPool.recordBlockFeeStats(bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+			UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusCurrentVersion},
+		}})
+	}
+	require.Len(t, transactionPool.feeHistory, cfg.SuggestedFeeBlockHistory)
+	require.Zero(t, transactionPool.feeHistory[0].fullness)
+}
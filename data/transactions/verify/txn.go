@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
@@ -36,6 +37,7 @@ import (
 var logicGoodTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_logic_ok", Description: "Total transaction scripts executed and accepted"})
 var logicRejTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_logic_rej", Description: "Total transaction scripts executed and rejected"})
 var logicErrTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_logic_err", Description: "Total transaction scripts executed and errored"})
+var txnGroupVerifySeconds = metrics.MakeLatencyHistogram(metrics.MetricName{Name: "algod_txn_group_verify_seconds", Description: "seconds spent verifying a transaction group"})
 
 // The PaysetGroups is taking large set of transaction groups and attempt to verify their validity using multiple go-routines.
 // When doing so, it attempts to break these into smaller "worksets" where each workset takes about 2ms of execution time in order
@@ -133,6 +135,8 @@ func TxnBatchVerify(s *transactions.SignedTxn, txnIdx int, groupCtx *GroupContex
 
 // TxnGroup verifies a []SignedTxn as being signed and having no obviously inconsistent data.
 func TxnGroup(stxs []transactions.SignedTxn, contextHdr bookkeeping.BlockHeader, cache VerifiedTransactionCache) (groupCtx *GroupContext, err error) {
+	defer txnGroupVerifySeconds.ObserveSince(time.Now(), nil)
+
 	batchVerifier := crypto.MakeBatchVerifierDefaultSize()
 
 	if groupCtx, err = TxnGroupBatchVerify(stxs, contextHdr, cache, batchVerifier); err != nil {
@@ -450,6 +454,56 @@ func PaysetGroups(ctx context.Context, payset [][]transactions.SignedTxn, blkHea
 	return err
 }
 
+// groupVerifyResult is the outcome of the verification of a single group by TxnGroups
+type groupVerifyResult struct {
+	idx int
+	err error
+}
+
+// TxnGroups verifies a set of independent transaction groups over the provided execution pool,
+// and returns the verification error of each of them, nil for the groups that were found valid.
+// Unlike PaysetGroups, an invalid group does not abort the verification of the other groups.
+func TxnGroups(ctx context.Context, stxnGroups [][]transactions.SignedTxn, contextHdr bookkeeping.BlockHeader, verificationPool execpool.BacklogPool, cache VerifiedTransactionCache) []error {
+	errs := make([]error, len(stxnGroups))
+	done := make([]bool, len(stxnGroups))
+	resultsCh := make(chan interface{}, len(stxnGroups))
+	pending := 0
+	for i, group := range stxnGroups {
+		idx := i
+		err := verificationPool.EnqueueBacklog(ctx, func(arg interface{}) interface{} {
+			if ctx.Err() != nil {
+				return groupVerifyResult{idx: idx, err: ctx.Err()}
+			}
+			_, err := TxnGroup(arg.([]transactions.SignedTxn), contextHdr, cache)
+			return groupVerifyResult{idx: idx, err: err}
+		}, group, resultsCh)
+		if err != nil {
+			errs[idx] = err
+			done[idx] = true
+			continue
+		}
+		pending++
+	}
+
+	for ; pending > 0; pending-- {
+		select {
+		case res := <-resultsCh:
+			result := res.(groupVerifyResult)
+			errs[result.idx] = result.err
+			done[result.idx] = true
+		case <-ctx.Done():
+			// the groups we did not hear back from are reported as canceled.
+			for i := range stxnGroups {
+				if !done[i] {
+					errs[i] = ctx.Err()
+				}
+			}
+			return errs
+		}
+	}
+	return errs
+}
+
 // worksetBuilder is a helper struct used to construct well sized worksets for the execution pool to process
 type worksetBuilder struct {
 	payset [][]transactions.SignedTxn
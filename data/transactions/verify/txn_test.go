@@ -341,6 +341,56 @@ func TestPaysetGroups(t *testing.T) {
 	}
 }
 
+func TestTxnGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, signedTxn, secrets, addrs := generateTestObjects(1000, 20, 50)
+	blkHdr := bookkeeping.BlockHeader{
+		Round:       50,
+		GenesisHash: crypto.Hash([]byte{1, 2, 3, 4, 5}),
+		UpgradeState: bookkeeping.UpgradeState{
+			CurrentProtocol: protocol.ConsensusCurrentVersion,
+		},
+		RewardsState: bookkeeping.RewardsState{
+			FeeSink:     feeSink,
+			RewardsPool: poolAddr,
+		},
+	}
+
+	execPool := execpool.MakePool(t)
+	verificationPool := execpool.MakeBacklog(execPool, 64, execpool.LowPriority, t)
+	defer verificationPool.Shutdown()
+
+	txnGroups := generateTransactionGroups(signedTxn, secrets, addrs)
+
+	errs := TxnGroups(context.Background(), txnGroups, blkHdr, verificationPool, MakeVerifiedTransactionCache(50000))
+	require.Len(t, errs, len(txnGroups))
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// break the signature of a single group, and ensure only that one is reported.
+	badGroup := len(txnGroups) / 2
+	txnGroups[badGroup][0].Sig[0] = txnGroups[badGroup][0].Sig[0] + 1
+	errs = TxnGroups(context.Background(), txnGroups, blkHdr, verificationPool, MakeVerifiedTransactionCache(50000))
+	require.Len(t, errs, len(txnGroups))
+	for i, err := range errs {
+		if i == badGroup {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	// a canceled context fails all the groups.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs = TxnGroups(ctx, txnGroups, blkHdr, verificationPool, MakeVerifiedTransactionCache(50000))
+	for _, err := range errs {
+		require.Error(t, err)
+	}
+}
+
 func BenchmarkPaysetGroups(b *testing.B) {
 	if b.N < 2000 {
 		b.N = 2000
@@ -38,6 +38,11 @@ var printmsgpack = flag.Bool("msgpack", false, "If provided, emit msgpack instea
 var skipHead = flag.String("skip-head", "", "The first round to trim before")
 var skipTail = flag.String("skip-tail", "", "The last round to trim after")
 
+var replay = flag.Bool("replay", false, "If provided, re-drive the recorded events through the state machine and report the divergent outputs")
+var breakRound = flag.String("break-round", "", "The round at which the replay stops and dumps the state machine")
+var breakPeriod = flag.Uint64("break-period", 0, "The period at which the replay stops, within the break round")
+var breakStep = flag.Uint64("break-step", 0, "The step at which the replay stops, within the break period")
+
 func mustParse(data []byte) uint64 {
 	x, err := strconv.ParseUint(string(data), 10, 64)
 	if err != nil {
@@ -90,6 +95,35 @@ func main() {
 	}
 	defer autopsy.Close()
 
+	if *replay {
+		var opts agreement.ReplayOptions
+		if *breakRound != "" {
+			opts.Breakpoint = &agreement.ReplayBreakpoint{
+				Round:  basics.Round(parseRoundBound(*breakRound)),
+				Period: *breakPeriod,
+				Step:   *breakStep,
+			}
+		}
+
+		report := autopsy.Replay(opts, os.Stdout)
+		if report.Version != version.GetCommitHash() {
+			log.Printf("coroner: cadaver version mismatches coroner version:\n(%s (cadaver) != %s (coroner))\n", report.Version, version.GetCommitHash())
+		}
+
+		divergences := 0
+		for _, d := range report.Divergences {
+			if !d.Warmup {
+				divergences++
+			}
+		}
+		log.Printf("coroner: replayed %d events: %d divergences (%d during warm-up)\n", report.Events, divergences, len(report.Divergences)-divergences)
+		if divergences > 0 {
+			autopsy.Close()
+			os.Exit(1)
+		}
+		return
+	}
+
 	var filter agreement.AutopsyFilter
 	if *skipHead != "" {
 		filter.Enabled = true
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
@@ -36,6 +37,7 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 // ErrNoSpace indicates insufficient space for transaction in block
@@ -44,6 +46,8 @@ var ErrNoSpace = errors.New("block does not have space for transaction")
 // ErrRoundZero is self-explanatory
 var ErrRoundZero = errors.New("cannot start evaluator for round 0")
 
+var ledgerEvalBlockSeconds = metrics.MakeLatencyHistogram(metrics.MetricName{Name: "algod_ledger_eval_block_seconds", Description: "seconds spent evaluating a block, by whether it was validated"})
+
 // maxPaysetHint makes sure that we don't allocate too much memory up front
 // in the block evaluator, since there cannot reasonably be more than this
 // many transactions in a block.
@@ -1181,6 +1185,9 @@ func (validator *evalTxValidator) run() {
 // AddBlock: eval(context.Background(), l, blk, false, txcache, nil, true)
 // tracker:  eval(context.Background(), l, blk, false, txcache, nil, false)
 func eval(ctx context.Context, l ledgerForEvaluator, blk bookkeeping.Block, validate bool, txcache verify.VerifiedTransactionCache, executionPool execpool.BacklogPool) (ledgercore.StateDelta, error) {
+	start := time.Now()
+	defer ledgerEvalBlockSeconds.ObserveSince(start, map[string]string{"validate": fmt.Sprintf("%t", validate)})
+
 	proto, ok := config.Consensus[blk.BlockHeader.CurrentProtocol]
 	if !ok {
 		return ledgercore.StateDelta{}, protocol.Error(blk.BlockHeader.CurrentProtocol)
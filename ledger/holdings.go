@@ -0,0 +1,257 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ErrHoldingsIndexDisabled is returned when listing the holders of an asset or
+// the accounts opted into an application on a node which doesn't index them.
+var ErrHoldingsIndexDisabled = errors.New("the holdings index is not enabled, see the EnableHoldingsIndex configuration option")
+
+// holdingsTracker maintains an index of the accounts holding each asset and opted
+// into each application, so that they can be listed without scanning the accounts
+// database. The index is only maintained when enabled by the EnableHoldingsIndex
+// configuration option.
+type holdingsTracker struct {
+	enabled bool
+
+	// accts provides the accounts modified in the rounds which were not yet
+	// written to the accounts database.
+	accts *accountUpdates
+
+	// assets and apps keep the accounts of each asset and application in increasing
+	// order, so that they can be paged through without sorting them.
+	assets map[basics.AssetIndex]addressSet
+	apps   map[basics.AppIndex]addressSet
+
+	// round is the latest round reflected by the index.
+	round basics.Round
+}
+
+func (ht *holdingsTracker) initialize(cfg config.Local, accts *accountUpdates) {
+	ht.enabled = cfg.EnableHoldingsIndex
+	ht.accts = accts
+}
+
+// loadFromDisk builds the index from the accounts database, and from the accounts
+// modified since the last round written to it.
+func (ht *holdingsTracker) loadFromDisk(l ledgerForTracker) error {
+	ht.assets = make(map[basics.AssetIndex]addressSet)
+	ht.apps = make(map[basics.AppIndex]addressSet)
+	ht.round = l.Latest()
+	if !ht.enabled {
+		return nil
+	}
+
+	ht.accts.accountsMu.RLock()
+	defer ht.accts.accountsMu.RUnlock()
+
+	start := time.Now()
+	accounts := 0
+	dbs := l.trackerDB()
+	err := dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT address, data FROM accountbase")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var addr basics.Address
+		for rows.Next() {
+			if accounts%1000 == 0 {
+				db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(time.Second))
+			}
+
+			var addrbuf []byte
+			var buf []byte
+			err = rows.Scan(&addrbuf, &buf)
+			if err != nil {
+				return err
+			}
+			if len(addrbuf) != len(addr) {
+				return fmt.Errorf("Account DB address length mismatch: %d != %d", len(addrbuf), len(addr))
+			}
+			copy(addr[:], addrbuf)
+			accounts++
+
+			if _, modified := ht.accts.accounts[addr]; modified {
+				// indexed below, from its latest state.
+				continue
+			}
+
+			var data basics.AccountData
+			err = protocol.Decode(buf, &data)
+			if err != nil {
+				return err
+			}
+			ht.addAccount(addr, data)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return err
+	}
+
+	for addr, macct := range ht.accts.accounts {
+		ht.addAccount(addr, macct.data)
+	}
+
+	// every account was added once, so sorting the sets is enough to order them.
+	for _, holders := range ht.assets {
+		holders.sort()
+	}
+	for _, accounts := range ht.apps {
+		accounts.sort()
+	}
+
+	l.trackerLog().Infof("holdingsTracker: indexed the holdings of %d accounts in %v", accounts, time.Since(start))
+	return nil
+}
+
+// addAccount adds the holdings of an account while loading the index, leaving the
+// sets unsorted until it is loaded.
+func (ht *holdingsTracker) addAccount(addr basics.Address, data basics.AccountData) {
+	for aidx := range data.Assets {
+		ht.assets[aidx] = append(ht.assets[aidx], addr)
+	}
+	for aidx := range data.AppLocalStates {
+		ht.apps[aidx] = append(ht.apps[aidx], addr)
+	}
+}
+
+func (ht *holdingsTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	ht.round = blk.Round()
+	if !ht.enabled {
+		return
+	}
+
+	for aa, created := range delta.ModifiedAssetHoldings {
+		holders := ht.assets[aa.Asset]
+		if created {
+			ht.assets[aa.Asset] = holders.insert(aa.Address)
+			continue
+		}
+		holders = holders.remove(aa.Address)
+		if len(holders) == 0 {
+			delete(ht.assets, aa.Asset)
+		} else {
+			ht.assets[aa.Asset] = holders
+		}
+	}
+	for aa, created := range delta.ModifiedAppLocalStates {
+		accounts := ht.apps[aa.App]
+		if created {
+			ht.apps[aa.App] = accounts.insert(aa.Address)
+			continue
+		}
+		accounts = accounts.remove(aa.Address)
+		if len(accounts) == 0 {
+			delete(ht.apps, aa.App)
+		} else {
+			ht.apps[aa.App] = accounts
+		}
+	}
+}
+
+func (ht *holdingsTracker) committedUpTo(committedRound basics.Round) basics.Round {
+	return committedRound
+}
+
+func (ht *holdingsTracker) close() {
+}
+
+// addressSet is a set of addresses, in increasing order.
+type addressSet []basics.Address
+
+func (set addressSet) sort() {
+	sort.Slice(set, func(i, j int) bool { return bytes.Compare(set[i][:], set[j][:]) < 0 })
+}
+
+// search returns the position of the first address of the set which doesn't precede addr.
+func (set addressSet) search(addr basics.Address) int {
+	return sort.Search(len(set), func(i int) bool { return bytes.Compare(set[i][:], addr[:]) >= 0 })
+}
+
+// insert returns the set with addr added to it.
+func (set addressSet) insert(addr basics.Address) addressSet {
+	i := set.search(addr)
+	if i < len(set) && set[i] == addr {
+		return set
+	}
+	set = append(set, basics.Address{})
+	copy(set[i+1:], set[i:])
+	set[i] = addr
+	return set
+}
+
+// remove returns the set with addr removed from it.
+func (set addressSet) remove(addr basics.Address) addressSet {
+	i := set.search(addr)
+	if i == len(set) || set[i] != addr {
+		return set
+	}
+	return append(set[:i], set[i+1:]...)
+}
+
+// listAddresses returns, in increasing order, up to maxResults of the addresses
+// following the given one.
+func listAddresses(set addressSet, after basics.Address, maxResults uint64) []basics.Address {
+	i := set.search(after)
+	if i < len(set) && set[i] == after {
+		i++
+	}
+	end := len(set)
+	if uint64(end-i) > maxResults {
+		end = i + int(maxResults)
+	}
+	return append([]basics.Address(nil), set[i:end]...)
+}
+
+// assetHolders returns, in increasing order, up to maxResults of the accounts
+// holding the asset whose address follows the given one, along with the round
+// as of which they hold it.
+func (ht *holdingsTracker) assetHolders(aidx basics.AssetIndex, after basics.Address, maxResults uint64) ([]basics.Address, basics.Round, error) {
+	if !ht.enabled {
+		return nil, 0, ErrHoldingsIndexDisabled
+	}
+	return listAddresses(ht.assets[aidx], after, maxResults), ht.round, nil
+}
+
+// appAccounts returns, in increasing order, up to maxResults of the accounts
+// opted into the application whose address follows the given one, along with
+// the round as of which they are opted in.
+func (ht *holdingsTracker) appAccounts(aidx basics.AppIndex, after basics.Address, maxResults uint64) ([]basics.Address, basics.Round, error) {
+	if !ht.enabled {
+		return nil, 0, ErrHoldingsIndexDisabled
+	}
+	return listAddresses(ht.apps[aidx], after, maxResults), ht.round, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHoldingsTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion)
+	defer ml.Close()
+
+	addrs := []basics.Address{{1}, {2}, {3}, {4}}
+	accts := make(map[basics.Address]basics.AccountData)
+	for _, addr := range addrs {
+		accts[addr] = basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000 * 1000}}
+	}
+	for _, addr := range addrs[:3] {
+		data := accts[addr]
+		data.Assets = map[basics.AssetIndex]basics.AssetHolding{5: {Amount: 1}}
+		accts[addr] = data
+	}
+	data := accts[addrs[3]]
+	data.AppLocalStates = map[basics.AppIndex]basics.AppLocalState{7: {}}
+	accts[addrs[3]] = data
+
+	au := &accountUpdates{}
+	au.initialize(config.GetDefaultLocal(), ".", proto, accts)
+	defer au.close()
+	require.NoError(t, au.loadFromDisk(ml))
+
+	// a disabled index can't be queried.
+	disabled := holdingsTracker{}
+	disabled.initialize(config.GetDefaultLocal(), au)
+	require.NoError(t, disabled.loadFromDisk(ml))
+	_, _, err := disabled.assetHolders(5, basics.Address{}, 10)
+	require.Equal(t, ErrHoldingsIndexDisabled, err)
+
+	// the account modified since the last round written to the database is indexed from its latest state.
+	au.accounts[addrs[2]] = modifiedAccount{data: basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000 * 1000}}, ndeltas: 1}
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableHoldingsIndex = true
+	ht := holdingsTracker{}
+	ht.initialize(cfg, au)
+	require.NoError(t, ht.loadFromDisk(ml))
+
+	holders, rnd, err := ht.assetHolders(5, basics.Address{}, 10)
+	require.NoError(t, err)
+	require.Equal(t, ml.Latest(), rnd)
+	require.Equal(t, addrs[:2], holders)
+
+	// paginate.
+	holders, _, err = ht.assetHolders(5, basics.Address{}, 1)
+	require.NoError(t, err)
+	require.Equal(t, addrs[:1], holders)
+	holders, _, err = ht.assetHolders(5, addrs[0], 1)
+	require.NoError(t, err)
+	require.Equal(t, addrs[1:2], holders)
+
+	accounts, _, err := ht.appAccounts(7, basics.Address{}, 10)
+	require.NoError(t, err)
+	require.Equal(t, addrs[3:], accounts)
+
+	// opt in and out.
+	blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: ml.Latest() + 1}}
+	delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, 0, 0)
+	delta.ModifiedAssetHoldings[ledgercore.AccountAsset{Address: addrs[0], Asset: 5}] = false
+	delta.ModifiedAssetHoldings[ledgercore.AccountAsset{Address: addrs[3], Asset: 5}] = true
+	delta.ModifiedAppLocalStates[ledgercore.AccountApp{Address: addrs[3], App: 7}] = false
+	ht.newBlock(blk, delta)
+
+	holders, rnd, err = ht.assetHolders(5, basics.Address{}, 10)
+	require.NoError(t, err)
+	require.Equal(t, blk.Round(), rnd)
+	require.Equal(t, []basics.Address{addrs[1], addrs[3]}, holders)
+
+	accounts, _, err = ht.appAccounts(7, basics.Address{}, 10)
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+	require.NotContains(t, ht.apps, basics.AppIndex(7))
+}
+
+func TestAddressSet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var set addressSet
+	for _, addr := range []basics.Address{{3}, {1}, {2}, {3}} {
+		set = set.insert(addr)
+	}
+	require.Equal(t, addressSet{{1}, {2}, {3}}, set)
+
+	require.Equal(t, []basics.Address{{2}, {3}}, listAddresses(set, basics.Address{1}, 10))
+	require.Equal(t, []basics.Address{{2}}, listAddresses(set, basics.Address{1, 1}, 1))
+	require.Empty(t, listAddresses(set, basics.Address{3}, 10))
+
+	set = set.remove(basics.Address{2})
+	set = set.remove(basics.Address{4})
+	require.Equal(t, addressSet{{1}, {3}}, set)
+}
@@ -72,6 +72,7 @@ type Ledger struct {
 	// State-machine trackers
 	accts    accountUpdates
 	txTail   txTail
+	holdings holdingsTracker
 	bulletin bulletin
 	notifier blockNotifier
 	time     timeTracker
@@ -154,6 +155,7 @@ func OpenLedger(
 	}
 
 	l.accts.initialize(cfg, dbPathPrefix, l.genesisProto, l.genesisAccounts)
+	l.holdings.initialize(cfg, &l.accts)
 
 	err = l.reloadLedger()
 	if err != nil {
@@ -190,6 +192,7 @@ func (l *Ledger) reloadLedger() error {
 	l.trackers.register(&l.accts)    // update the balances
 	l.trackers.register(&l.time)     // tracks the block timestamps
 	l.trackers.register(&l.txTail)   // update the transaction tail, tracking the recent 1000 txn
+	l.trackers.register(&l.holdings) // index the asset holders and the application opted-in accounts
 	l.trackers.register(&l.bulletin) // provide closed channel signaling support for completed rounds
 	l.trackers.register(&l.notifier) // send OnNewBlocks to subscribers
 	l.trackers.register(&l.metrics)  // provides metrics reporting support
@@ -433,6 +436,25 @@ func (l *Ledger) ListApplications(maxAppIdx basics.AppIndex, maxResults uint64)
 	return l.accts.ListApplications(maxAppIdx, maxResults)
 }
 
+// ListAssetHolders returns, in increasing address order, up to maxResults of the
+// accounts holding the asset whose address follows the given one, along with the
+// round as of which they hold it. It requires the EnableHoldingsIndex configuration option.
+func (l *Ledger) ListAssetHolders(aidx basics.AssetIndex, after basics.Address, maxResults uint64) ([]basics.Address, basics.Round, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.holdings.assetHolders(aidx, after, maxResults)
+}
+
+// ListApplicationAccounts returns, in increasing address order, up to maxResults of
+// the accounts opted into the application whose address follows the given one, along
+// with the round as of which they are opted in. It requires the EnableHoldingsIndex
+// configuration option.
+func (l *Ledger) ListApplicationAccounts(aidx basics.AppIndex, after basics.Address, maxResults uint64) ([]basics.Address, basics.Round, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.holdings.appAccounts(aidx, after, maxResults)
+}
+
 // Lookup uses the accounts tracker to return the account state for a
 // given account in a particular round.  The account values reflect
 // the changes of all blocks up to and including rnd.
@@ -477,6 +499,15 @@ func (l *Ledger) CheckDup(currentProto config.ConsensusParams, current basics.Ro
 	return l.txTail.checkDup(currentProto, current, firstValid, lastValid, txid, txl.Txlease)
 }
 
+// LookupConfirmedTxid returns the round in which the transaction with the given
+// id was committed, provided that it was committed within the rounds still
+// tracked for duplicate detection, that is at least the last MaxTxnLife rounds.
+func (l *Ledger) LookupConfirmedTxid(txid transactions.Txid) (basics.Round, bool) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.txTail.lookupTxid(txid)
+}
+
 // Latest returns the latest known block round added to the ledger.
 func (l *Ledger) Latest() basics.Round {
 	return l.blockQ.latest()
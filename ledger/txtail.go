@@ -29,6 +29,7 @@ import (
 const initialLastValidArrayLen = 256
 
 type roundTxMembers struct {
+	txids    map[transactions.Txid]basics.Round  // map of transaction id to its last valid round
 	txleases map[ledgercore.Txlease]basics.Round // map of transaction lease to when it expires
 	proto    config.ConsensusParams
 }
@@ -81,12 +82,15 @@ func (t *txTail) loadFromDisk(l ledgerForTracker) error {
 
 		consensusParams := config.Consensus[blk.CurrentProtocol]
 		t.recent[old] = roundTxMembers{
+			txids:    make(map[transactions.Txid]basics.Round, len(payset)),
 			txleases: make(map[ledgercore.Txlease]basics.Round, len(payset)),
 			proto:    consensusParams,
 		}
 
 		for _, txad := range payset {
 			tx := txad.SignedTxn
+			txid := tx.ID()
+			t.recent[old].txids[txid] = tx.Txn.LastValid
 			if consensusParams.SupportTransactionLeases && (tx.Txn.Lease != [32]byte{}) {
 				t.recent[old].txleases[ledgercore.Txlease{Sender: tx.Txn.Sender, Lease: tx.Txn.Lease}] = tx.Txn.LastValid
 			}
@@ -103,7 +107,7 @@ func (t *txTail) loadFromDisk(l ledgerForTracker) error {
 					copy(newList[:], list[:])
 					list = newList
 				}
-				list = append(list, tx.ID())
+				list = append(list, txid)
 				roundsLastValids[tx.Txn.LastValid] = list
 			}
 		}
@@ -132,6 +136,7 @@ func (t *txTail) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
 	}
 
 	t.recent[rnd] = roundTxMembers{
+		txids:    delta.Txids,
 		txleases: delta.Txleases,
 		proto:    config.Consensus[blk.CurrentProtocol],
 	}
@@ -194,6 +199,17 @@ func (t *txTail) checkDup(proto config.ConsensusParams, current basics.Round, fi
 	return nil
 }
 
+// lookupTxid returns the round in which the transaction with the given id was
+// committed, if that round is still tracked by the txTail.
+func (t *txTail) lookupTxid(txid transactions.Txid) (basics.Round, bool) {
+	for rnd, members := range t.recent {
+		if _, ok := members.txids[txid]; ok {
+			return rnd, true
+		}
+	}
+	return 0, false
+}
+
 func (t *txTail) putLV(lastValid basics.Round, id transactions.Txid) {
 	if _, ok := t.lastValid[lastValid]; !ok {
 		t.lastValid[lastValid] = make(map[transactions.Txid]struct{})
@@ -192,3 +192,49 @@ func TestTxTailLoadFromDisk(t *testing.T) {
 		}
 	}
 }
+
+func TestTxTailLookupTxid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	var ledger txTailTestLedger
+	txtail := txTail{}
+	require.NoError(t, txtail.loadFromDisk(&ledger))
+
+	latest := ledger.Latest()
+	maxTxnLife := basics.Round(config.Consensus[protocol.ConsensusCurrentVersion].MaxTxnLife)
+	oldest := latest + 1 - maxTxnLife
+
+	// the transactions of the rounds loaded from disk are found in their round.
+	for _, r := range []basics.Round{oldest, (oldest + latest) / 2, latest} {
+		txn := makeTxTailTestTransaction(r, 1)
+		rnd, found := txtail.lookupTxid(txn.Txn.ID())
+		require.True(t, found, "round %d", r)
+		require.Equal(t, r, rnd)
+	}
+	_, found := txtail.lookupTxid(makeTxTailTestTransaction(oldest-1, 1).Txn.ID())
+	require.False(t, found)
+
+	// add new blocks, until the rounds loaded from disk are no longer tracked.
+	newTxid := transactions.Txid(crypto.Hash([]byte("new transaction")))
+	for rnd := latest + 1; rnd <= latest+maxTxnLife+1; rnd++ {
+		blk := bookkeeping.Block{
+			BlockHeader: bookkeeping.BlockHeader{
+				Round: rnd,
+				UpgradeState: bookkeeping.UpgradeState{
+					CurrentProtocol: protocol.ConsensusCurrentVersion,
+				},
+			},
+		}
+		delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, 1, 0)
+		if rnd == latest+maxTxnLife {
+			delta.Txids[newTxid] = rnd + 10
+		}
+		txtail.newBlock(blk, delta)
+		txtail.committedUpTo(rnd)
+	}
+
+	rnd, found := txtail.lookupTxid(newTxid)
+	require.True(t, found)
+	require.Equal(t, latest+maxTxnLife, rnd)
+	_, found = txtail.lookupTxid(makeTxTailTestTransaction(latest, 1).Txn.ID())
+	require.False(t, found)
+}
@@ -25,13 +25,13 @@ import (
 	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	kmdclient "github.com/algorand/go-algorand/daemon/kmd/client"
 	"github.com/algorand/go-algorand/rpcs"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
-	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -51,13 +51,11 @@ const DefaultKMDDataDir = nodecontrol.DefaultKMDDataDir
 
 // Client represents the entry point for all libgoal functions
 type Client struct {
-	nc                   nodecontrol.NodeController
-	kmdStartArgs         nodecontrol.KMDStartArgs
-	dataDir              string
-	cacheDir             string
-	consensus            config.ConsensusProtocols
-	algodVersionAffinity algodclient.APIVersion
-	kmdVersionAffinity   kmdclient.APIVersion
+	nc           nodecontrol.NodeController
+	kmdStartArgs nodecontrol.KMDStartArgs
+	dataDir      string
+	cacheDir     string
+	consensus    config.ConsensusProtocols
 }
 
 // ClientConfig is data to configure a Client
@@ -140,8 +138,6 @@ func (c *Client) init(config ClientConfig, clientType ClientType) error {
 	}
 	c.dataDir = dataDir
 	c.cacheDir = config.CacheDir
-	c.algodVersionAffinity = algodclient.APIVersionV1
-	c.kmdVersionAffinity = kmdclient.APIVersionV1
 
 	// Get node controller
 	nc, err := getNodeController(config.BinDir, config.AlgodDataDir)
@@ -196,7 +192,6 @@ func (c *Client) ensureAlgodClient() (*algodclient.RestClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	algod.SetAPIVersionAffinity(c.algodVersionAffinity)
 	return &algod, err
 }
 
@@ -613,7 +608,7 @@ func (c *Client) ConstructPayment(from, to string, fee, amount uint64, note []by
 		tx.PaymentTxnFields.CloseRemainderTo = closeToAddr
 	}
 
-	tx.Header.GenesisID = params.GenesisID
+	tx.Header.GenesisID = params.GenesisId
 
 	// Check if the protocol supports genesis hash
 	if cp.SupportGenesisHash {
@@ -645,7 +640,7 @@ func (c *Client) Status() (resp generatedV2.NodeStatusResponse, err error) {
 }
 
 // AccountInformation takes an address and returns its information
-func (c *Client) AccountInformation(account string) (resp v1.Account, err error) {
+func (c *Client) AccountInformation(account string) (resp generatedV2.Account, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
 		resp, err = algod.AccountInformation(account)
@@ -653,21 +648,12 @@ func (c *Client) AccountInformation(account string) (resp v1.Account, err error)
 	return
 }
 
-// AccountInformationV2 takes an address and returns its information
-func (c *Client) AccountInformationV2(account string) (resp generatedV2.Account, err error) {
-	algod, err := c.ensureAlgodClient()
-	if err == nil {
-		resp, err = algod.AccountInformationV2(account)
-	}
-	return
-}
-
 // AccountData takes an address and returns its basics.AccountData
 func (c *Client) AccountData(account string) (accountData basics.AccountData, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
 		var resp []byte
-		resp, err = algod.RawAccountInformationV2(account)
+		resp, err = algod.RawAccountInformation(account)
 		if err == nil {
 			err = protocol.Decode(resp, &accountData)
 		}
@@ -676,21 +662,12 @@ func (c *Client) AccountData(account string) (accountData basics.AccountData, er
 }
 
 // AssetInformation takes an asset's index and returns its information
-func (c *Client) AssetInformation(index uint64) (resp v1.AssetParams, err error) {
-	algod, err := c.ensureAlgodClient()
-	if err == nil {
-		resp, err = algod.AssetInformation(index)
-	}
-	return
-}
-
-// AssetInformationV2 takes an asset's index and returns its information
-func (c *Client) AssetInformationV2(index uint64) (resp generatedV2.Asset, err error) {
+func (c *Client) AssetInformation(index uint64) (resp generatedV2.Asset, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err != nil {
 		return
 	}
-	resp, err = algod.AssetInformationV2(index)
+	resp, err = algod.AssetInformation(index)
 	if err != nil {
 		return generatedV2.Asset{}, err
 	}
@@ -727,18 +704,19 @@ func (c *Client) ApplicationInformation(index uint64) (resp generatedV2.Applicat
 	return
 }
 
-// TransactionInformation takes an address and associated txid and return its information
-func (c *Client) TransactionInformation(addr, txid string) (resp v1.Transaction, err error) {
+// ConfirmedTransactionInformation returns information about a transaction
+// confirmed in one of the last rounds the node keeps track of, based on its txid.
+func (c *Client) ConfirmedTransactionInformation(txid string) (resp generatedV2.PendingTransactionResponse, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
-		resp, err = algod.TransactionInformation(addr, txid)
+		resp, err = algod.ConfirmedTransactionInformation(txid)
 	}
 	return
 }
 
 // PendingTransactionInformation returns information about a recently issued
 // transaction based on its txid.
-func (c *Client) PendingTransactionInformation(txid string) (resp v1.Transaction, err error) {
+func (c *Client) PendingTransactionInformation(txid string) (resp generatedV2.PendingTransactionResponse, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
 		resp, err = algod.PendingTransactionInformation(txid)
@@ -746,48 +724,39 @@ func (c *Client) PendingTransactionInformation(txid string) (resp v1.Transaction
 	return
 }
 
-// PendingTransactionInformationV2 returns information about a recently issued
-// transaction based on its txid.
-func (c *Client) PendingTransactionInformationV2(txid string) (resp generatedV2.PendingTransactionResponse, err error) {
+// WaitForTransaction waits up to timeoutSeconds for a recently issued transaction
+// to be committed or rejected, and returns its information.
+func (c *Client) WaitForTransaction(txid string, timeoutSeconds uint64) (resp generatedV2.PendingTransactionResponse, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
-		resp, err = algod.PendingTransactionInformationV2(txid)
+		resp, err = algod.WaitForTransaction(txid, timeoutSeconds)
 	}
 	return
 }
 
 // Block takes a round and returns its block
-func (c *Client) Block(round uint64) (resp v1.Block, err error) {
+func (c *Client) Block(round uint64) (block bookkeeping.Block, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
-		resp, err = algod.Block(round)
+		block, err = algod.Block(round)
 	}
 	return
 }
 
-// RawBlock takes a round and returns its block
-func (c *Client) RawBlock(round uint64) (resp v1.RawBlock, err error) {
+// BlockCert takes a round and returns its block along with its certificate
+func (c *Client) BlockCert(round uint64) (cert rpcs.EncodedBlockCert, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
-		resp, err = algod.RawBlock(round)
+		cert, err = algod.BlockCert(round)
 	}
 	return
 }
 
-// BookkeepingBlock takes a round and returns its block
-func (c *Client) BookkeepingBlock(round uint64) (block bookkeeping.Block, err error) {
+// RawBlock takes a round and returns its msgpack encoded block, along with its certificate
+func (c *Client) RawBlock(round uint64) (resp []byte, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
-		var resp []byte
 		resp, err = algod.RawBlock(round)
-		if err == nil {
-			var b rpcs.EncodedBlockCert
-			err = protocol.DecodeReflect(resp, &b)
-			if err != nil {
-				return
-			}
-			block = b.Block
-		}
 	}
 	return
 }
@@ -801,6 +770,24 @@ func (c *Client) HealthCheck() error {
 	return err
 }
 
+// ReadyCheck returns an error explaining why the node is not ready to serve requests, if it isn't
+func (c *Client) ReadyCheck() error {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		err = algod.ReadyCheck()
+	}
+	return err
+}
+
+// DetailedStatus returns the node status along with the state of its peers, transaction pool and ledger
+func (c *Client) DetailedStatus() (resp generatedV2.DetailedNodeStatusResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.DetailedStatus()
+	}
+	return
+}
+
 // WaitForRound takes a round, waits until it appears and returns its status. This function blocks.
 func (c *Client) WaitForRound(round uint64) (resp generatedV2.NodeStatusResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -829,7 +816,7 @@ func (c Client) AlgodVersions() (resp common.Version, err error) {
 }
 
 // LedgerSupply returns the total number of algos in the system
-func (c Client) LedgerSupply() (resp v1.Supply, err error) {
+func (c Client) LedgerSupply() (resp generatedV2.SupplyResponse, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
 		resp, err = algod.LedgerSupply()
@@ -837,6 +824,25 @@ func (c Client) LedgerSupply() (resp v1.Supply, err error) {
 	return
 }
 
+// Equivocations returns the evidence of the vote equivocations detected by the node, most recent first
+func (c Client) Equivocations() (resp generatedV2.EquivocationsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.Equivocations()
+	}
+	return
+}
+
+// AgreementTimeline returns the agreement timelines of at most max of the latest rounds, most recent first, or of all the
+// rounds the node keeps when max is zero
+func (c Client) AgreementTimeline(max uint64) (resp privateV2.RoundTimelinesResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AgreementTimeline(max)
+	}
+	return
+}
+
 // CurrentRound returns the current known round
 func (c Client) CurrentRound() (lastRound uint64, err error) {
 	// Get current round
@@ -854,7 +860,7 @@ func (c Client) CurrentRound() (lastRound uint64, err error) {
 func (c *Client) SuggestedFee() (fee uint64, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
-		resp, err := algod.SuggestedFee()
+		resp, err := algod.SuggestedParams()
 		if err == nil {
 			fee = resp.Fee
 		}
@@ -863,7 +869,7 @@ func (c *Client) SuggestedFee() (fee uint64, err error) {
 }
 
 // SuggestedParams returns the suggested parameters for a new transaction
-func (c *Client) SuggestedParams() (params v1.TransactionParams, err error) {
+func (c *Client) SuggestedParams() (params generatedV2.TransactionParametersResponse, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
 		params, err = algod.SuggestedParams()
@@ -873,7 +879,7 @@ func (c *Client) SuggestedParams() (params v1.TransactionParams, err error) {
 
 // GetPendingTransactions gets a snapshot of current pending transactions on the node.
 // If maxTxns = 0, fetches as many transactions as possible.
-func (c *Client) GetPendingTransactions(maxTxns uint64) (resp v1.PendingTransactions, err error) {
+func (c *Client) GetPendingTransactions(maxTxns uint64) (resp algodclient.PendingTransactions, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
 		resp, err = algod.GetPendingTransactions(maxTxns)
@@ -883,7 +889,7 @@ func (c *Client) GetPendingTransactions(maxTxns uint64) (resp v1.PendingTransact
 
 // GetPendingTransactionsByAddress gets a snapshot of current pending transactions on the node for the given address.
 // If maxTxns = 0, fetches as many transactions as possible.
-func (c *Client) GetPendingTransactionsByAddress(addr string, maxTxns uint64) (resp v1.PendingTransactions, err error) {
+func (c *Client) GetPendingTransactionsByAddress(addr string, maxTxns uint64) (resp algodclient.PendingTransactions, err error) {
 	algod, err := c.ensureAlgodClient()
 	if err == nil {
 		resp, err = algod.PendingTransactionsByAddr(addr, maxTxns)
@@ -916,7 +922,7 @@ func (c *Client) ConsensusParams(round uint64) (consensus config.ConsensusParams
 		return
 	}
 
-	params, ok := c.consensus[protocol.ConsensusVersion(block.CurrentProtocol)]
+	params, ok := c.consensus[block.CurrentProtocol]
 	if !ok {
 		err = fmt.Errorf("ConsensusParams: unknown consensus protocol %s", block.CurrentProtocol)
 		return
@@ -925,20 +931,12 @@ func (c *Client) ConsensusParams(round uint64) (consensus config.ConsensusParams
 	return params, nil
 }
 
-// SetAPIVersionAffinity sets the desired client API version affinity of the algod and kmd clients.
-func (c *Client) SetAPIVersionAffinity(algodVersionAffinity algodclient.APIVersion, kmdVersionAffinity kmdclient.APIVersion) {
-	c.algodVersionAffinity = algodVersionAffinity
-	c.kmdVersionAffinity = kmdVersionAffinity
-}
-
 // AbortCatchup aborts the currently running catchup
 func (c *Client) AbortCatchup() error {
 	algod, err := c.ensureAlgodClient()
 	if err != nil {
 		return err
 	}
-	// we need to ensure we're using the v2 status so that we would get the catchpoint information.
-	algod.SetAPIVersionAffinity(algodclient.APIVersionV2)
 	resp, err := algod.Status()
 	if err != nil {
 		return err
@@ -967,6 +965,54 @@ func (c *Client) Catchup(catchpointLabel string) error {
 	return nil
 }
 
+// NetworkPeers lists the peers the node is connected to
+func (c *Client) NetworkPeers() (resp privateV2.NetworkPeersResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.NetworkPeers()
+	}
+	return
+}
+
+// DisconnectPeer disconnects the node from the given peer
+func (c *Client) DisconnectPeer(peerID uint64) error {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		err = algod.DisconnectPeer(peerID)
+	}
+	return err
+}
+
+// NetworkBans lists the peer addresses and hosts the node currently bans
+func (c *Client) NetworkBans() (resp privateV2.NetworkBansResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.NetworkBans()
+	}
+	return
+}
+
+// BanPeer bans the given peer address or host for the given number of seconds, and returns
+// the number of connected peers it disconnected
+func (c *Client) BanPeer(address string, durationSeconds uint64) (disconnected uint64, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		var resp privateV2.NetworkBanResponse
+		resp, err = algod.BanPeer(address, durationSeconds)
+		disconnected = resp.DisconnectedPeers
+	}
+	return
+}
+
+// UnbanPeer lifts the ban on the given peer address or host
+func (c *Client) UnbanPeer(address string) error {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		err = algod.UnbanPeer(address)
+	}
+	return err
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
@@ -1059,7 +1105,7 @@ func MakeDryrunStateGenerated(client Client, txnOrStxn interface{}, other []tran
 			accounts := append(tx.Accounts, tx.Sender)
 			for _, acc := range accounts {
 				var info generatedV2.Account
-				if info, err = client.AccountInformationV2(acc.String()); err != nil {
+				if info, err = client.AccountInformation(acc.String()); err != nil {
 					return
 				}
 				dr.Accounts = append(dr.Accounts, info)
@@ -1069,11 +1115,11 @@ func MakeDryrunStateGenerated(client Client, txnOrStxn interface{}, other []tran
 			if dr.Round, err = client.CurrentRound(); err != nil {
 				return
 			}
-			var b v1.Block
+			var b bookkeeping.Block
 			if b, err = client.Block(dr.Round); err != nil {
 				return
 			}
-			dr.LatestTimestamp = uint64(b.Timestamp)
+			dr.LatestTimestamp = uint64(b.TimeStamp)
 		}
 	}
 	return
@@ -1100,3 +1146,12 @@ func (c *Client) TxnProof(txid string, round uint64) (resp generatedV2.ProofResp
 	}
 	return
 }
+
+// CompactCert returns the compact cert for a round, along with the round it was confirmed in.
+func (c *Client) CompactCert(round uint64) (resp generatedV2.CompactCertResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.CompactCert(round)
+	}
+	return
+}
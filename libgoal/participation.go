@@ -26,7 +26,9 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/db"
 )
 
@@ -242,6 +244,52 @@ func (c *Client) InstallParticipationKeys(inputfile string) (part account.Partic
 	return part, newdbpath, nil
 }
 
+// ScheduleKeyRegistration copies a signed key registration transaction into the
+// node's genesis directory. The node picks it up and submits it once the
+// transaction's first valid round is reached.
+func (c *Client) ScheduleKeyRegistration(inputfile string) (stxn transactions.SignedTxn, filePath string, err error) {
+	data, err := ioutil.ReadFile(inputfile)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(data, &stxn)
+	if err != nil {
+		err = fmt.Errorf("Cannot decode transaction in %s: %v", inputfile, err)
+		return
+	}
+	if stxn.Txn.Type != protocol.KeyRegistrationTx {
+		err = fmt.Errorf("%s does not contain a key registration transaction", inputfile)
+		return
+	}
+	if stxn.Sig.Blank() && stxn.Msig.Blank() && stxn.Lsig.Blank() {
+		err = fmt.Errorf("The key registration transaction in %s is not signed", inputfile)
+		return
+	}
+
+	genID, err := c.GenesisID()
+	if err != nil {
+		return
+	}
+
+	filePath = filepath.Join(c.DataDir(), genID, config.KeyregFilename(stxn.Txn.Sender.String(), uint64(stxn.Txn.FirstValid)))
+	if util.FileExists(filePath) {
+		err = fmt.Errorf("A key registration for %s is already scheduled for round %d", stxn.Txn.Sender, stxn.Txn.FirstValid)
+		return
+	}
+
+	// Write to a temporary file first, so that the node never reads a partial transaction.
+	tmpPath := filePath + ".tmp"
+	err = ioutil.WriteFile(tmpPath, data, 0600)
+	if err != nil {
+		return
+	}
+	err = os.Rename(tmpPath, filePath)
+	if err != nil {
+		os.Remove(tmpPath)
+	}
+	return
+}
+
 // ListParticipationKeys returns the available participation keys,
 // as a map from database filename to Participation key object.
 func (c *Client) ListParticipationKeys() (partKeyFiles map[string]account.Participation, err error) {
@@ -21,7 +21,7 @@ import (
 	"fmt"
 
 	"github.com/algorand/go-algorand/crypto"
-	"github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -167,7 +167,7 @@ func (c *Client) BroadcastTransaction(stx transactions.SignedTxn) (txid string,
 	if err != nil {
 		return
 	}
-	return resp.TxID, nil
+	return resp.TxId, nil
 }
 
 // BroadcastTransactionGroup broadcasts a signed transaction group to the network using algod
@@ -179,6 +179,20 @@ func (c *Client) BroadcastTransactionGroup(txgroup []transactions.SignedTxn) err
 	return algod.SendRawTransactionGroup(txgroup)
 }
 
+// BroadcastTransactionGroups broadcasts a batch of independent signed transaction groups to the
+// network using algod, and returns the outcome of each group, in order.
+func (c *Client) BroadcastTransactionGroups(txgroups [][]transactions.SignedTxn) ([]generatedV2.TransactionGroupResult, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := algod.SendRawTransactionGroups(txgroups)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
 // SignAndBroadcastTransaction signs the unsigned transaction with keys from the default wallet, and broadcasts it
 func (c *Client) SignAndBroadcastTransaction(walletHandle, pw []byte, utx transactions.Transaction) (txid string, err error) {
 	// Sign the transaction
@@ -613,15 +627,15 @@ func (c *Client) MakeUnsignedAssetDestroyTx(index uint64) (transactions.Transact
 func (c *Client) MakeUnsignedAssetConfigTx(creator string, index uint64, newManager *string, newReserve *string, newFreeze *string, newClawback *string) (transactions.Transaction, error) {
 	var tx transactions.Transaction
 	var err error
-	var ok bool
 
 	// If the creator was passed in blank, look up asset info by index
-	var params v1.AssetParams
+	var params generatedV2.AssetParams
 	if creator == "" {
-		params, err = c.AssetInformation(index)
+		asset, err := c.AssetInformation(index)
 		if err != nil {
 			return tx, err
 		}
+		params = asset.Params
 	} else {
 		// Fetch the current state, to fill in as a template
 		current, err := c.AccountInformation(creator)
@@ -629,26 +643,49 @@ func (c *Client) MakeUnsignedAssetConfigTx(creator string, index uint64, newMana
 			return tx, err
 		}
 
-		params, ok = current.AssetParams[index]
+		var ok bool
+		if current.CreatedAssets != nil {
+			for _, asset := range *current.CreatedAssets {
+				if asset.Index == index {
+					params = asset.Params
+					ok = true
+					break
+				}
+			}
+		}
 		if !ok {
 			return tx, fmt.Errorf("asset ID %d not found in account %s", index, creator)
 		}
 	}
 
+	// Unset addresses of the asset are zero keys
+	emptyAddr := ""
 	if newManager == nil {
-		newManager = &params.ManagerAddr
+		newManager = params.Manager
+		if newManager == nil {
+			newManager = &emptyAddr
+		}
 	}
 
 	if newReserve == nil {
-		newReserve = &params.ReserveAddr
+		newReserve = params.Reserve
+		if newReserve == nil {
+			newReserve = &emptyAddr
+		}
 	}
 
 	if newFreeze == nil {
-		newFreeze = &params.FreezeAddr
+		newFreeze = params.Freeze
+		if newFreeze == nil {
+			newFreeze = &emptyAddr
+		}
 	}
 
 	if newClawback == nil {
-		newClawback = &params.ClawbackAddr
+		newClawback = params.Clawback
+		if newClawback == nil {
+			newClawback = &emptyAddr
+		}
 	}
 
 	tx.Type = protocol.AssetConfigTx
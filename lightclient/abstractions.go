@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// Source captures the ability to fetch the data which a Client verifies.
+// None of the data is trusted.
+type Source interface {
+	// LatestRound returns the latest round known to the source.
+	LatestRound() (basics.Round, error)
+
+	// BlockHeader returns the block header of a round.
+	BlockHeader(basics.Round) (bookkeeping.BlockHeader, error)
+
+	// CompactCert returns the compact cert of the block header of a round.
+	CompactCert(basics.Round) (compactcert.Cert, error)
+
+	// TxnProof returns a proof that a transaction is in the block of a round.
+	TxnProof(basics.Round, transactions.Txid) (TxnProof, error)
+}
+
+// TxnProof is a Merkle proof that a transaction is in the payset of a block,
+// as produced by the node for the block's TxnRoot commitment.
+type TxnProof struct {
+	// Index is the position of the transaction in the payset.
+	Index uint64
+
+	// Stibhash is the hash of the SignedTxnInBlock.
+	Stibhash crypto.Digest
+
+	// Proof holds the sibling digests returned by merklearray.Tree.Prove.
+	Proof []crypto.Digest
+}
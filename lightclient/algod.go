@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// AlgodSource is a Source which fetches the data from the REST API of algod.
+type AlgodSource struct {
+	client client.RestClient
+}
+
+// MakeAlgodSource returns a Source fetching the data from the algod behind the
+// given client.
+func MakeAlgodSource(client client.RestClient) AlgodSource {
+	return AlgodSource{client: client}
+}
+
+// LatestRound implements the Source interface.
+func (s AlgodSource) LatestRound() (basics.Round, error) {
+	status, err := s.client.Status()
+	if err != nil {
+		return 0, err
+	}
+	return basics.Round(status.LastRound), nil
+}
+
+// BlockHeader implements the Source interface.  The API has no endpoint for
+// block headers alone, so the whole block is fetched.
+func (s AlgodSource) BlockHeader(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	block, err := s.client.Block(uint64(rnd))
+	if err != nil {
+		return bookkeeping.BlockHeader{}, err
+	}
+	return block.BlockHeader, nil
+}
+
+// CompactCert implements the Source interface.
+func (s AlgodSource) CompactCert(rnd basics.Round) (cert compactcert.Cert, err error) {
+	response, err := s.client.CompactCert(uint64(rnd))
+	if err != nil {
+		return
+	}
+	if response.CertRound != uint64(rnd) {
+		err = fmt.Errorf("algod returned the compact cert of round %d for round %d", response.CertRound, rnd)
+		return
+	}
+	err = protocol.Decode(response.Cert, &cert)
+	return
+}
+
+// TxnProof implements the Source interface.
+func (s AlgodSource) TxnProof(rnd basics.Round, txid transactions.Txid) (proof TxnProof, err error) {
+	response, err := s.client.Proof(txid.String(), uint64(rnd))
+	if err != nil {
+		return
+	}
+	if len(response.Stibhash) != crypto.DigestSize || len(response.Proof)%crypto.DigestSize != 0 {
+		err = fmt.Errorf("algod returned a malformed proof of transaction %v", txid)
+		return
+	}
+
+	proof.Index = response.Idx
+	copy(proof.Stibhash[:], response.Stibhash)
+	for concat := response.Proof; len(concat) > 0; concat = concat[crypto.DigestSize:] {
+		var d crypto.Digest
+		copy(d[:], concat)
+		proof.Proof = append(proof.Proof, d)
+	}
+	return
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrNotCertified is returned for the rounds past the latest certified block header.
+var ErrNotCertified = errors.New("the round is past the latest certified block header")
+
+// GenesisVotersRound returns the round of the block header which commits to
+// the voters of the first compact cert of a chain whose genesis uses the given
+// consensus protocol.  These voters are the root of trust of a Client: nothing
+// in the chain certifies them, so their header must be obtained from a trusted
+// party.
+func GenesisVotersRound(proto protocol.ConsensusVersion) (basics.Round, error) {
+	params, ok := config.Consensus[proto]
+	if !ok {
+		return 0, fmt.Errorf("unknown consensus protocol %s", proto)
+	}
+	if params.CompactCertRounds == 0 {
+		return 0, fmt.Errorf("compact certs not enabled in consensus protocol %s", proto)
+	}
+
+	// The ledger picks the first voters when evaluating the first block.
+	firstRound := basics.Round(1)
+	return (firstRound + basics.Round(params.CompactCertVotersLookback)).RoundUpToMultipleOf(basics.Round(params.CompactCertRounds)), nil
+}
+
+// A Client verifies the block headers and the transactions of a chain without
+// the ledger state.  Starting from a trusted block header which commits to
+// compact cert voters, it follows the compact certs fetched from its source:
+// each cert certifies the block header CompactCertRounds later, which commits
+// to the voters of the next cert.  The headers of the other rounds are verified
+// through the hash chain leading to the next certified header.
+//
+// A Client is not safe for concurrent use.
+type Client struct {
+	source Source
+
+	// certified holds the trusted block header, followed by the block
+	// headers certified since, in increasing round order.
+	certified []bookkeeping.BlockHeader
+}
+
+// MakeClient returns a Client which trusts the given block header.  The header
+// must commit to compact cert voters: it is either the header of the genesis
+// voters round, or a header certified by an earlier Client.
+func MakeClient(source Source, trusted bookkeeping.BlockHeader) (*Client, error) {
+	if trusted.CompactCert[protocol.CompactCertBasic].CompactCertVoters.IsZero() {
+		return nil, fmt.Errorf("MakeClient: the block header of round %d does not commit to compact cert voters", trusted.Round)
+	}
+
+	return &Client{
+		source:    source,
+		certified: []bookkeeping.BlockHeader{trusted},
+	}, nil
+}
+
+// Latest returns the latest certified block header.
+func (c *Client) Latest() bookkeeping.BlockHeader {
+	return c.certified[len(c.certified)-1]
+}
+
+// Sync verifies the compact certs the source confirmed since the latest
+// certified block header, and returns the new latest certified header.
+func (c *Client) Sync() (bookkeeping.BlockHeader, error) {
+	latest, err := c.source.LatestRound()
+	if err != nil {
+		return c.Latest(), err
+	}
+	tip, err := c.source.BlockHeader(latest)
+	if err != nil {
+		return c.Latest(), err
+	}
+
+	// The tip isn't verified: it only tells which certs the source has.
+	confirmed := tip.CompactCert[protocol.CompactCertBasic].CompactCertNextRound
+	for {
+		voters := c.Latest()
+		if voters.CompactCert[protocol.CompactCertBasic].CompactCertVoters.IsZero() {
+			// compact certs got disabled
+			return voters, nil
+		}
+
+		proto := config.Consensus[voters.CurrentProtocol]
+		certRound := voters.Round + basics.Round(proto.CompactCertRounds)
+		if proto.CompactCertRounds == 0 || certRound >= confirmed {
+			return voters, nil
+		}
+
+		hdr, err := c.certify(voters, certRound)
+		if err != nil {
+			return voters, err
+		}
+		c.certified = append(c.certified, hdr)
+	}
+}
+
+// certify fetches the block header of a round and its compact cert, and
+// verifies the cert against the voters of the given header.
+func (c *Client) certify(voters bookkeeping.BlockHeader, rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	cert, err := c.source.CompactCert(rnd)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("could not fetch the compact cert of round %d: %w", rnd, err)
+	}
+	hdr, err := c.source.BlockHeader(rnd)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("could not fetch the block header of round %d: %w", rnd, err)
+	}
+	if hdr.Round != rnd || hdr.GenesisHash != voters.GenesisHash {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("the source returned the block header of round %d of genesis %v for round %d",
+			hdr.Round, hdr.GenesisHash, rnd)
+	}
+
+	params, err := ledger.CompactCertParams(voters, hdr)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, err
+	}
+	verifier := compactcert.MkVerifier(params, voters.CompactCert[protocol.CompactCertBasic].CompactCertVoters)
+	err = verifier.Verify(&cert)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("the compact cert of round %d does not verify: %w", rnd, err)
+	}
+	return hdr, nil
+}
+
+// BlockHeader returns the verified block header of a round: either a certified
+// header, or one whose hash chain leads to the next certified header.
+func (c *Client) BlockHeader(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	i := sort.Search(len(c.certified), func(i int) bool { return c.certified[i].Round >= rnd })
+	if i == len(c.certified) {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("round %d: %w", rnd, ErrNotCertified)
+	}
+
+	hdr := c.certified[i]
+	for hdr.Round > rnd {
+		prev, err := c.source.BlockHeader(hdr.Round - 1)
+		if err != nil {
+			return bookkeeping.BlockHeader{}, fmt.Errorf("could not fetch the block header of round %d: %w", hdr.Round-1, err)
+		}
+		if prev.Round != hdr.Round-1 || prev.Hash() != hdr.Branch {
+			return bookkeeping.BlockHeader{}, fmt.Errorf("the block header of round %d does not match the branch of round %d", hdr.Round-1, hdr.Round)
+		}
+		hdr = prev
+	}
+	return hdr, nil
+}
+
+// VerifyTransaction checks that a transaction is in the block of a round, and
+// returns the verified header of the block.
+func (c *Client) VerifyTransaction(rnd basics.Round, txid transactions.Txid) (bookkeeping.BlockHeader, error) {
+	hdr, err := c.BlockHeader(rnd)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, err
+	}
+	if config.Consensus[hdr.CurrentProtocol].PaysetCommit != config.PaysetCommitMerkle {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("the block of round %d does not commit to its transactions with a Merkle tree", rnd)
+	}
+
+	proof, err := c.source.TxnProof(rnd, txid)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("could not fetch the proof of transaction %v: %w", txid, err)
+	}
+
+	leaf := []byte(protocol.TxnMerkleLeaf)
+	leaf = append(leaf, txid[:]...)
+	leaf = append(leaf, proof.Stibhash[:]...)
+	err = merklearray.Verify(hdr.TxnRoot, map[uint64]crypto.Digest{proof.Index: crypto.Hash(leaf)}, proof.Proof)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("transaction %v is not in the block of round %d: %w", txid, rnd, err)
+	}
+	return hdr, nil
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type participantsArray []compactcert.Participant
+
+func (a participantsArray) Length() uint64 {
+	return uint64(len(a))
+}
+
+func (a participantsArray) GetHash(pos uint64) (crypto.Digest, error) {
+	if pos >= uint64(len(a)) {
+		return crypto.Digest{}, fmt.Errorf("pos %d >= len %d", pos, len(a))
+	}
+	return crypto.HashObj(a[pos]), nil
+}
+
+// testSource serves a chain with compact certs from memory.
+type testSource struct {
+	blocks map[basics.Round]bookkeeping.Block
+	certs  map[basics.Round]compactcert.Cert
+	latest basics.Round
+}
+
+func (s *testSource) LatestRound() (basics.Round, error) {
+	return s.latest, nil
+}
+
+func (s *testSource) BlockHeader(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	blk, ok := s.blocks[rnd]
+	if !ok {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("no block for round %d", rnd)
+	}
+	return blk.BlockHeader, nil
+}
+
+func (s *testSource) CompactCert(rnd basics.Round) (compactcert.Cert, error) {
+	cert, ok := s.certs[rnd]
+	if !ok {
+		return compactcert.Cert{}, fmt.Errorf("no compact cert for round %d", rnd)
+	}
+	return cert, nil
+}
+
+func (s *testSource) TxnProof(rnd basics.Round, txid transactions.Txid) (TxnProof, error) {
+	blk := s.blocks[rnd]
+	txns, err := blk.DecodePaysetFlat()
+	if err != nil {
+		return TxnProof{}, err
+	}
+	for idx := range txns {
+		if txns[idx].Txn.ID() != txid {
+			continue
+		}
+		tree, err := blk.TxnMerkleTree()
+		if err != nil {
+			return TxnProof{}, err
+		}
+		proof, err := tree.Prove([]uint64{uint64(idx)})
+		if err != nil {
+			return TxnProof{}, err
+		}
+		return TxnProof{Index: uint64(idx), Stibhash: blk.Payset[idx].Hash(), Proof: proof}, nil
+	}
+	return TxnProof{}, fmt.Errorf("no transaction %v in round %d", txid, rnd)
+}
+
+// testChain is a chain whose voters all sign every certified block header.
+type testChain struct {
+	testSource
+
+	certRounds basics.Round
+	key        *crypto.OneTimeSignatureSecrets
+	parts      participantsArray
+	partTree   *merklearray.Tree
+	txids      []transactions.Txid
+	txnRound   basics.Round
+}
+
+// makeTestChain makes a chain of the given number of rounds, with compact certs for all of the certified rounds
+// before the last one.
+func makeTestChain(t *testing.T, numRounds basics.Round) *testChain {
+	proto := config.Consensus[protocol.ConsensusFuture]
+	c := &testChain{
+		testSource: testSource{
+			blocks: make(map[basics.Round]bookkeeping.Block),
+			certs:  make(map[basics.Round]compactcert.Cert),
+			latest: numRounds - 1,
+		},
+		certRounds: basics.Round(proto.CompactCertRounds),
+		key:        crypto.GenerateOneTimeSignatureSecrets(0, 1),
+	}
+
+	var total uint64
+	for i := 0; i < 10; i++ {
+		part := compactcert.Participant{PK: c.key.OneTimeSignatureVerifier, Weight: uint64(1000 * (i + 1)), KeyDilution: 10000}
+		c.parts = append(c.parts, part)
+		total += part.Weight
+	}
+	var err error
+	c.partTree, err = merklearray.Build(c.parts)
+	require.NoError(t, err)
+
+	genesisHash := crypto.Hash([]byte("lightclient test genesis"))
+	c.txnRound = c.certRounds + 5
+	var prev bookkeeping.BlockHeader
+	for rnd := basics.Round(0); rnd < numRounds; rnd++ {
+		var blk bookkeeping.Block
+		blk.BlockHeader = bookkeeping.BlockHeader{
+			Round:       rnd,
+			GenesisHash: genesisHash,
+			TimeStamp:   int64(rnd),
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusFuture,
+			},
+		}
+		if rnd > 0 {
+			blk.Branch = prev.Hash()
+		}
+		state := bookkeeping.CompactCertState{CompactCertNextRound: 2 * c.certRounds}
+		if rnd >= 2*c.certRounds {
+			// the cert of a round is confirmed a few rounds later
+			state.CompactCertNextRound = (rnd-8)/c.certRounds*c.certRounds + c.certRounds
+		}
+		if rnd > 0 && rnd%c.certRounds == 0 {
+			state.CompactCertVoters = c.partTree.Root()
+			state.CompactCertVotersTotal = basics.MicroAlgos{Raw: total}
+		}
+		blk.CompactCert = map[protocol.CompactCertType]bookkeeping.CompactCertState{protocol.CompactCertBasic: state}
+
+		if rnd == c.txnRound {
+			for i := 0; i < 5; i++ {
+				stxn := transactions.SignedTxn{Txn: transactions.Transaction{
+					Type: protocol.PaymentTx,
+					Header: transactions.Header{
+						Sender:      basics.Address(crypto.Hash([]byte{byte(i)})),
+						FirstValid:  rnd,
+						LastValid:   rnd + 10,
+						GenesisHash: genesisHash,
+					},
+					PaymentTxnFields: transactions.PaymentTxnFields{Amount: basics.MicroAlgos{Raw: uint64(i)}},
+				}}
+				txib, err := blk.EncodeSignedTxn(stxn, transactions.ApplyData{})
+				require.NoError(t, err)
+				blk.Payset = append(blk.Payset, txib)
+				c.txids = append(c.txids, stxn.ID())
+			}
+			blk.TxnRoot, err = blk.PaysetCommit()
+			require.NoError(t, err)
+		}
+
+		c.blocks[rnd] = blk
+		prev = blk.BlockHeader
+	}
+
+	for rnd := 2 * c.certRounds; rnd < c.latest; rnd += c.certRounds {
+		if rnd < c.blocks[c.latest].CompactCert[protocol.CompactCertBasic].CompactCertNextRound {
+			c.certs[rnd] = c.makeCert(t, c.blocks[rnd-c.certRounds].BlockHeader, c.blocks[rnd].BlockHeader)
+		}
+	}
+	return c
+}
+
+func (c *testChain) makeCert(t *testing.T, votersHdr bookkeeping.BlockHeader, hdr bookkeeping.BlockHeader) compactcert.Cert {
+	params, err := ledger.CompactCertParams(votersHdr, hdr)
+	require.NoError(t, err)
+	builder, err := compactcert.MkBuilder(params, c.parts, c.partTree)
+	require.NoError(t, err)
+	for i, part := range c.parts {
+		sig := c.key.Sign(basics.OneTimeIDForRound(params.SigRound, part.KeyDilution), hdr)
+		err = builder.Add(uint64(i), sig, true)
+		require.NoError(t, err)
+	}
+	cert, err := builder.Build()
+	require.NoError(t, err)
+	return *cert
+}
+
+func TestGenesisVotersRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rnd, err := GenesisVotersRound(protocol.ConsensusFuture)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(config.Consensus[protocol.ConsensusFuture].CompactCertRounds), rnd)
+
+	_, err = GenesisVotersRound(protocol.ConsensusV26)
+	require.Error(t, err)
+	_, err = GenesisVotersRound("no such protocol")
+	require.Error(t, err)
+}
+
+func TestClientSync(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	chain := makeTestChain(t, 128*4+20)
+	n := chain.certRounds
+
+	_, err := MakeClient(&chain.testSource, chain.blocks[n+1].BlockHeader)
+	require.Error(t, err)
+
+	client, err := MakeClient(&chain.testSource, chain.blocks[n].BlockHeader)
+	require.NoError(t, err)
+	latest, err := client.Sync()
+	require.NoError(t, err)
+	require.Equal(t, 4*n, latest.Round)
+	require.Equal(t, latest, client.Latest())
+
+	// nothing new to certify
+	latest, err = client.Sync()
+	require.NoError(t, err)
+	require.Equal(t, 4*n, latest.Round)
+
+	for _, rnd := range []basics.Round{0, n - 1, n, 2*n + 7, 3 * n, 4 * n} {
+		hdr, err := client.BlockHeader(rnd)
+		require.NoError(t, err)
+		require.Equal(t, chain.blocks[rnd].BlockHeader, hdr)
+	}
+	_, err = client.BlockHeader(4*n + 1)
+	require.True(t, errors.Is(err, ErrNotCertified), err)
+
+	for _, txid := range chain.txids {
+		hdr, err := client.VerifyTransaction(chain.txnRound, txid)
+		require.NoError(t, err)
+		require.Equal(t, chain.txnRound, hdr.Round)
+	}
+	_, err = client.VerifyTransaction(chain.txnRound+1, chain.txids[0])
+	require.Error(t, err)
+	_, err = client.VerifyTransaction(4*n+1, chain.txids[0])
+	require.True(t, errors.Is(err, ErrNotCertified), err)
+}
+
+func TestClientRejectsForgeries(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	chain := makeTestChain(t, 128*3+20)
+	n := chain.certRounds
+
+	// a certified header which isn't the one the voters signed
+	forged := chain.blocks[3*n]
+	forged.TimeStamp++
+	chain.blocks[3*n] = forged
+	client, err := MakeClient(&chain.testSource, chain.blocks[n].BlockHeader)
+	require.NoError(t, err)
+	latest, err := client.Sync()
+	require.Error(t, err)
+	require.Equal(t, 2*n, latest.Round)
+
+	// a header which isn't on the hash chain of the certified ones
+	forged = chain.blocks[2*n-3]
+	forged.TimeStamp++
+	chain.blocks[2*n-3] = forged
+	_, err = client.BlockHeader(2*n - 2)
+	require.NoError(t, err)
+	_, err = client.BlockHeader(2*n - 5)
+	require.Error(t, err)
+
+	// a cert signed by other voters
+	chain = makeTestChain(t, 128*3+20)
+	chain.key = crypto.GenerateOneTimeSignatureSecrets(0, 1)
+	for i := range chain.parts {
+		chain.parts[i].PK = chain.key.OneTimeSignatureVerifier
+	}
+	chain.partTree, err = merklearray.Build(chain.parts)
+	require.NoError(t, err)
+	chain.certs[2*n] = chain.makeCert(t, chain.blocks[n].BlockHeader, chain.blocks[2*n].BlockHeader)
+	client, err = MakeClient(&chain.testSource, chain.blocks[n].BlockHeader)
+	require.NoError(t, err)
+	latest, err = client.Sync()
+	require.Error(t, err)
+	require.Equal(t, n, latest.Round)
+}
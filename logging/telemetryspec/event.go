@@ -107,6 +107,30 @@ type PartKeyRegisteredEventDetails struct {
 	LastValid  uint64
 }
 
+// PartKeyExpiringEvent event
+const PartKeyExpiringEvent Event = "PartKeyExpiring"
+
+// PartKeyExpiringEventDetails contains details for the PartKeyExpiringEvent
+type PartKeyExpiringEventDetails struct {
+	Address               string
+	VoteLastValid         uint64
+	RoundsRemaining       uint64
+	RenewalInstalled      bool
+	RenewalScheduledRound uint64
+}
+
+// KeyRegSubmittedEvent event
+const KeyRegSubmittedEvent Event = "KeyRegSubmitted"
+
+// KeyRegSubmittedEventDetails contains details for the KeyRegSubmittedEvent
+type KeyRegSubmittedEventDetails struct {
+	Address    string
+	TxID       string
+	Round      uint64
+	FirstValid uint64
+	LastValid  uint64
+}
+
 // BlockProposedEvent event
 const BlockProposedEvent Event = "BlockProposed"
 
@@ -175,6 +199,21 @@ type EquivocatedVoteEventDetails struct {
 	PreviousProposalHash2 string
 }
 
+// EquivocationEvidenceEvent event
+const EquivocationEvidenceEvent Event = "EquivocationEvidence"
+
+// EquivocationEvidenceEventDetails contains details for the EquivocationEvidenceEvent
+type EquivocationEvidenceEventDetails struct {
+	VoterAddress  string
+	Round         uint64
+	Period        uint64
+	Step          uint64
+	Weight        uint64
+	ProposalHash1 string
+	ProposalHash2 string
+	Votes         string // base64 msgpack encoding of the pair of signed votes
+}
+
 // ConnectPeerEvent event
 const ConnectPeerEvent Event = "ConnectPeer"
 
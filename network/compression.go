@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// compressedMessagesProtocolVersion is the first protocol version in which the peers accept messages
+// wrapped with the CompressedMsgTag.
+const compressedMessagesProtocolVersion = "2.2"
+
+// A compressed message is sent with the CompressedMsgTag, and its payload is made of the tag of the
+// original message, followed by a single byte identifying the compression scheme and by the compressed
+// payload of the original message.
+const compressedMessageHeaderLength = 3
+
+// compressionSchemeDeflate compresses the payload with deflate, using gossipCompressionDictionary as the preset dictionary.
+const compressionSchemeDeflate byte = 1
+
+// minCompressedMessageSize is the payload size under which messages aren't worth compressing.
+const minCompressedMessageSize = 512
+
+var networkCompressionInputBytesByTag = metrics.NewTagCounter("algod_network_compression_input_bytes_{TAG}", "Number of bytes of the messages that were compressed before being sent, per message tag")
+var networkCompressionOutputBytesByTag = metrics.NewTagCounter("algod_network_compression_output_bytes_{TAG}", "Number of bytes the compressed messages were reduced to, per message tag")
+var networkCompressionRatio = metrics.MakeHistogram(metrics.MetricName{Name: "algod_network_compression_ratio", Description: "Ratio between the compressed and the original size of the compressed messages"},
+	[]float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1})
+var networkDecompressedBytesByTag = metrics.NewTagCounter("algod_network_decompressed_bytes_{TAG}", "Number of bytes the received compressed messages were expanded to, per message tag")
+
+var errCompressedMessageTooShort = errors.New("compressed message is too short")
+var errCompressedMessageTooLong = errors.New("decompressed message exceeds the maximum message length")
+
+// gossipCompressionDictionary holds the msgpack encoding of the most common keys of the transactions,
+// blocks and proposals, which are otherwise repeated in every single message.
+// The dictionary is part of the compressionSchemeDeflate scheme, and therefore must never be changed;
+// a new scheme is to be introduced instead.
+var gossipCompressionDictionary = makeMsgpackDictionary([]string{
+	// blocks and proposals
+	"oper", "oprop", "prev", "seed", "ts", "tc", "fees", "rwd", "earn", "rate", "frac", "rwcalr",
+	"proto", "nextproto", "nextyes", "nextbefore", "nextswitch", "upgradeprop", "upgradedelay", "upgradeyes",
+	"cc", "txn", "rnd", "gen", "gh", "txns",
+	// signatures
+	"pk", "s", "thr", "subsig", "msig", "lsig", "l", "arg", "sgnr", "hgi", "hgh",
+	// transactions
+	"votekey", "selkey", "votefst", "votelst", "votekd", "nonpart",
+	"caid", "apar", "xaid", "aamt", "asnd", "arcv", "aclose", "fadd", "faid", "afrz",
+	"apid", "apan", "apaa", "apat", "apfa", "apas", "apls", "apgs", "apap", "apsu", "apep",
+	"rcv", "amt", "close", "rekey", "grp", "lx", "note", "type", "pay", "axfer", "appl", "keyreg",
+	"snd", "fee", "fv", "lv", "sig",
+})
+
+// versionAtLeast tests whether the major.minor protocol version is the same as, or
+// newer than, the minimum one. Versions which can't be parsed are older than any other.
+func versionAtLeast(version string, minimum string) bool {
+	major, minor, ok := parseProtocolVersion(version)
+	if !ok {
+		return false
+	}
+	minMajor, minMinor, ok := parseProtocolVersion(minimum)
+	if !ok {
+		return false
+	}
+	return major > minMajor || (major == minMajor && minor >= minMinor)
+}
+
+func parseProtocolVersion(version string) (major int, minor int, ok bool) {
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// makeMsgpackDictionary concatenates the msgpack encoding of the given strings. The strings are expected
+// to be shorter than 32 bytes, so that they are encoded as fixstr.
+func makeMsgpackDictionary(keys []string) []byte {
+	var buf bytes.Buffer
+	for _, key := range keys {
+		buf.WriteByte(0xa0 | byte(len(key)))
+		buf.WriteString(key)
+	}
+	return buf.Bytes()
+}
+
+// parseCompressedTags parses the comma separated list of tags of the GossipCompressionTags configuration.
+func parseCompressedTags(list string) (tags map[protocol.Tag]bool, err error) {
+	tags = make(map[protocol.Tag]bool)
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if len(tag) != 2 || protocol.Tag(tag) == protocol.CompressedMsgTag {
+			return nil, fmt.Errorf("invalid compressed message tag %#v", tag)
+		}
+		tags[protocol.Tag(tag)] = true
+	}
+	return tags, nil
+}
+
+// messageCompressor compresses the outgoing messages of the configured tags.
+type messageCompressor struct {
+	tags    map[protocol.Tag]bool
+	writers sync.Pool
+}
+
+func makeMessageCompressor(tags map[protocol.Tag]bool) *messageCompressor {
+	return &messageCompressor{
+		tags: tags,
+		writers: sync.Pool{
+			New: func() interface{} {
+				// BestSpeed is used since proposals are compressed on the critical path of their propagation.
+				w, _ := flate.NewWriterDict(nil, flate.BestSpeed, gossipCompressionDictionary)
+				return w
+			},
+		},
+	}
+}
+
+// compress returns the compressed form of the given message, made of its tag followed by its payload.
+// It returns nil if the message isn't to be compressed, or if compressing it wouldn't make it any shorter.
+func (mc *messageCompressor) compress(mbytes []byte) []byte {
+	if len(mbytes) < len(protocol.CompressedMsgTag)+minCompressedMessageSize {
+		return nil
+	}
+	tag := protocol.Tag(mbytes[:2])
+	if !mc.tags[tag] {
+		return nil
+	}
+	var buf bytes.Buffer
+	buf.Grow(len(mbytes) / 2)
+	buf.WriteString(string(protocol.CompressedMsgTag))
+	buf.WriteString(string(tag))
+	buf.WriteByte(compressionSchemeDeflate)
+
+	w := mc.writers.Get().(*flate.Writer)
+	defer mc.writers.Put(w)
+	w.Reset(&buf)
+	if _, err := w.Write(mbytes[2:]); err != nil {
+		return nil
+	}
+	if err := w.Close(); err != nil {
+		return nil
+	}
+	if buf.Len() >= len(mbytes) {
+		return nil
+	}
+	compressed := buf.Bytes()
+	networkCompressionInputBytesByTag.Add(string(tag), uint64(len(mbytes)))
+	networkCompressionOutputBytesByTag.Add(string(tag), uint64(len(compressed)))
+	networkCompressionRatio.Observe(float64(len(compressed))/float64(len(mbytes)), map[string]string{"tag": string(tag)})
+	return compressed
+}
+
+// compressAll returns the compressed form of each of the given messages, or the message itself
+// if it isn't to be compressed.
+func (mc *messageCompressor) compressAll(data [][]byte) [][]byte {
+	out := make([][]byte, len(data))
+	for i, mbytes := range data {
+		out[i] = mbytes
+		if compressed := mc.compress(mbytes); compressed != nil {
+			out[i] = compressed
+		}
+	}
+	return out
+}
+
+var flateReaders = sync.Pool{
+	New: func() interface{} {
+		return flate.NewReaderDict(nil, gossipCompressionDictionary)
+	},
+}
+
+// decompressMessage decodes the payload of a message sent with the CompressedMsgTag, and returns the
+// tag and the payload of the original message.
+func decompressMessage(data []byte) (tag protocol.Tag, payload []byte, err error) {
+	if len(data) < compressedMessageHeaderLength {
+		return "", nil, errCompressedMessageTooShort
+	}
+	tag = protocol.Tag(data[:2])
+	if tag == protocol.CompressedMsgTag {
+		return "", nil, fmt.Errorf("compressed message wraps another compressed message")
+	}
+	if data[2] != compressionSchemeDeflate {
+		return "", nil, fmt.Errorf("unsupported compression scheme %d", data[2])
+	}
+
+	r := flateReaders.Get().(io.ReadCloser)
+	defer flateReaders.Put(r)
+	if err = r.(flate.Resetter).Reset(bytes.NewReader(data[compressedMessageHeaderLength:]), gossipCompressionDictionary); err != nil {
+		return "", nil, err
+	}
+	payload, err = ioutil.ReadAll(io.LimitReader(r, maxMessageLength+1))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(payload) > maxMessageLength {
+		return "", nil, errCompressedMessageTooLong
+	}
+	networkDecompressedBytesByTag.Add(string(tag), uint64(len(payload)))
+	return tag, payload, nil
+}
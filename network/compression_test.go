@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestTxnMessage(count int) []byte {
+	var data []byte
+	for i := 0; i < count; i++ {
+		var sender, receiver basics.Address
+		crypto.RandBytes(sender[:])
+		crypto.RandBytes(receiver[:])
+		stxn := transactions.SignedTxn{
+			Txn: transactions.Transaction{
+				Type: protocol.PaymentTx,
+				Header: transactions.Header{
+					Sender:      sender,
+					Fee:         basics.MicroAlgos{Raw: 1000},
+					FirstValid:  basics.Round(i + 1),
+					LastValid:   basics.Round(i + 1001),
+					GenesisID:   "go-test-network-genesis",
+					GenesisHash: crypto.Hash([]byte("genesis")),
+				},
+				PaymentTxnFields: transactions.PaymentTxnFields{
+					Receiver: receiver,
+					Amount:   basics.MicroAlgos{Raw: uint64(i) * 1000000},
+				},
+			},
+		}
+		crypto.RandBytes(stxn.Sig[:])
+		data = append(data, protocol.Encode(&stxn)...)
+	}
+	return data
+}
+
+func TestMessageCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mc := makeMessageCompressor(map[protocol.Tag]bool{protocol.TxnTag: true})
+	payload := makeTestTxnMessage(16)
+	mbytes := append([]byte(protocol.TxnTag), payload...)
+
+	compressed := mc.compress(mbytes)
+	require.NotNil(t, compressed)
+	require.Less(t, len(compressed), len(mbytes))
+	require.Equal(t, protocol.CompressedMsgTag, protocol.Tag(compressed[:2]))
+
+	tag, decompressed, err := decompressMessage(compressed[2:])
+	require.NoError(t, err)
+	require.Equal(t, protocol.TxnTag, tag)
+	require.Equal(t, payload, decompressed)
+
+	// other tags and short messages are left uncompressed
+	require.Nil(t, mc.compress(append([]byte(protocol.ProposalPayloadTag), payload...)))
+	require.Nil(t, mc.compress(append([]byte(protocol.TxnTag), payload[:minCompressedMessageSize-1]...)))
+
+	// as are the messages which don't get any shorter
+	random := make([]byte, 4096)
+	crypto.RandBytes(random)
+	require.Nil(t, mc.compress(append([]byte(protocol.TxnTag), random...)))
+
+	data := mc.compressAll([][]byte{mbytes, []byte("TXfoo")})
+	require.Equal(t, compressed, data[0])
+	require.Equal(t, []byte("TXfoo"), data[1])
+}
+
+func TestDecompressMessageErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, _, err := decompressMessage([]byte("TX"))
+	require.Equal(t, errCompressedMessageTooShort, err)
+
+	_, _, err = decompressMessage([]byte{'C', 'M', compressionSchemeDeflate})
+	require.Error(t, err)
+
+	_, _, err = decompressMessage([]byte{'T', 'X', 0x7f, 0, 0})
+	require.Error(t, err)
+
+	// corrupted payloads are rejected
+	mc := makeMessageCompressor(map[protocol.Tag]bool{protocol.TxnTag: true})
+	compressed := mc.compress(append([]byte(protocol.TxnTag), makeTestTxnMessage(4)...))
+	require.NotNil(t, compressed)
+	_, _, err = decompressMessage(compressed[2 : len(compressed)-8])
+	require.Error(t, err)
+
+	// messages expanding over the maximum message length are rejected
+	mc.tags[protocol.ProposalPayloadTag] = true
+	compressed = mc.compress(append([]byte(protocol.ProposalPayloadTag), make([]byte, maxMessageLength+1)...))
+	require.NotNil(t, compressed)
+	require.Less(t, len(compressed), maxMessageLength)
+	_, _, err = decompressMessage(compressed[2:])
+	require.Equal(t, errCompressedMessageTooLong, err)
+}
+
+func TestParseCompressedTags(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tags, err := parseCompressedTags("")
+	require.NoError(t, err)
+	require.Empty(t, tags)
+
+	tags, err = parseCompressedTags(" PP, TX,")
+	require.NoError(t, err)
+	require.Equal(t, map[protocol.Tag]bool{protocol.ProposalPayloadTag: true, protocol.TxnTag: true}, tags)
+
+	_, err = parseCompressedTags("PP,TXN")
+	require.Error(t, err)
+	_, err = parseCompressedTags("CM")
+	require.Error(t, err)
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.True(t, versionAtLeast("2.2", compressedMessagesProtocolVersion))
+	require.True(t, versionAtLeast("2.10", compressedMessagesProtocolVersion))
+	require.True(t, versionAtLeast("3.0", compressedMessagesProtocolVersion))
+	require.False(t, versionAtLeast("2.1", compressedMessagesProtocolVersion))
+	require.False(t, versionAtLeast("1.9", compressedMessagesProtocolVersion))
+	require.False(t, versionAtLeast("", compressedMessagesProtocolVersion))
+	require.False(t, versionAtLeast("2.x", compressedMessagesProtocolVersion))
+}
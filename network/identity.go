@@ -0,0 +1,443 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/algorand/websocket"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// Nodes having a node identity authenticate each other while upgrading the gossip connection to a websocket.
+// The client sends its identity, a random challenge and an ephemeral X25519 key with the request headers, and the
+// server answers with its own, along with its signature over the client challenge and both ephemeral keys. Once the
+// connection is upgraded, the client sends the NodeIdentityTag message carrying its signature over the server
+// challenge and both ephemeral keys. All the messages which follow are encrypted with the keys derived from the
+// ephemeral keys. The connections with the peers which don't have an identity remain unauthenticated and
+// unencrypted, unless the GossipIdentityAllowlist is set, in which case these are refused.
+
+// NodeIdentityHeader HTTP header carrying the base64 encoded node identity, which is the public key the node signs the handshake with.
+const NodeIdentityHeader = "X-Algorand-NodeIdentity"
+
+// NodeIdentityChallengeHeader HTTP header carrying the random challenge the other side of the connection is to sign.
+const NodeIdentityChallengeHeader = "X-Algorand-NodeIdentityChallenge"
+
+// NodeIdentityKeyHeader HTTP header carrying the ephemeral key from which the connection encryption keys are derived.
+const NodeIdentityKeyHeader = "X-Algorand-NodeIdentityKey"
+
+// NodeIdentitySignatureHeader HTTP header by which the server proves its identity to the client.
+const NodeIdentitySignatureHeader = "X-Algorand-NodeIdentitySig"
+
+// identityChallengeSize is the size of the random challenges exchanged during the handshake.
+const identityChallengeSize = 32
+
+// identityProofTimeout is the time the server waits for the identity proof of the client once the connection is upgraded.
+const identityProofTimeout = 10 * time.Second
+
+// The domains separating the signatures of the client from the ones of the server, and the encryption keys from
+// anything else that might be derived from the ephemeral keys.
+const (
+	identityClientDomain = "algorand-gossip-identity-client"
+	identityServerDomain = "algorand-gossip-identity-server"
+	identityKeysDomain   = "algorand-gossip-encryption-keys"
+)
+
+var networkIdentityHandshakeFailures = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_identity_handshake_failures_total", Description: "number of gossip connections dropped because the peer identity could not be authenticated or wasn't allowed"})
+var networkEncryptedConnections = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_encrypted_connections_total", Description: "number of authenticated gossip connections carrying encrypted traffic"})
+
+var errIdentityProofMissing = errors.New("the peer did not send its identity proof")
+var errIdentityProofInvalid = errors.New("the identity proof of the peer is invalid")
+var errIdentityNotAllowed = errors.New("the peer identity is not in the allowlist")
+var errIdentityRequired = errors.New("the peer did not authenticate with a node identity")
+var errEncryptedMessageInvalid = errors.New("could not decrypt the message")
+
+// IdentityString returns the base64 encoding of a node identity, as used in the GossipIdentityAllowlist.
+func IdentityString(identity crypto.PublicKey) string {
+	return base64.StdEncoding.EncodeToString(identity[:])
+}
+
+// parseIdentity decodes the base64 encoding of a node identity.
+func parseIdentity(s string) (identity crypto.PublicKey, err error) {
+	err = decodeIdentityValue(s, identity[:])
+	return
+}
+
+// decodeIdentityValue decodes a base64 encoded value of a fixed size.
+func decodeIdentityValue(s string, out []byte) error {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	if len(data) != len(out) {
+		return fmt.Errorf("invalid length %d, expected %d", len(data), len(out))
+	}
+	copy(out, data)
+	return nil
+}
+
+// parseIdentityAllowlist parses the comma separated list of node identities of the GossipIdentityAllowlist configuration.
+func parseIdentityAllowlist(list string) (map[crypto.PublicKey]bool, error) {
+	allowlist := make(map[crypto.PublicKey]bool)
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		identity, err := parseIdentity(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node identity %#v: %v", s, err)
+		}
+		allowlist[identity] = true
+	}
+	return allowlist, nil
+}
+
+// LoadNodeIdentity loads the node identity from the given file, and generates it if the file doesn't exist yet.
+// The file holds the base64 encoded seed of the identity.
+func LoadNodeIdentity(path string) (*crypto.SignatureSecrets, error) {
+	var seed crypto.Seed
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		crypto.RandBytes(seed[:])
+		var f *os.File
+		f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return nil, err
+		}
+		_, err = f.Write([]byte(base64.StdEncoding.EncodeToString(seed[:])))
+		closeErr := f.Close()
+		if err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		return crypto.GenerateSignatureSecrets(seed), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = decodeIdentityValue(strings.TrimSpace(string(data)), seed[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid node identity file %s: %v", path, err)
+	}
+	return crypto.GenerateSignatureSecrets(seed), nil
+}
+
+// identityHandshake holds the state of the identity handshake of a single connection.
+type identityHandshake struct {
+	identity           *crypto.SignatureSecrets
+	challenge          [identityChallengeSize]byte
+	ephemeralKey       [curve25519.ScalarSize]byte
+	ephemeralPublicKey [curve25519.PointSize]byte
+
+	peerIdentity           crypto.PublicKey
+	peerChallenge          [identityChallengeSize]byte
+	peerEphemeralPublicKey [curve25519.PointSize]byte
+}
+
+func makeIdentityHandshake(identity *crypto.SignatureSecrets) (*identityHandshake, error) {
+	h := &identityHandshake{identity: identity}
+	crypto.RandBytes(h.challenge[:])
+	crypto.RandBytes(h.ephemeralKey[:])
+	publicKey, err := curve25519.X25519(h.ephemeralKey[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	copy(h.ephemeralPublicKey[:], publicKey)
+	return h, nil
+}
+
+// setHeaders sets the identity, the challenge and the ephemeral key of this node.
+func (h *identityHandshake) setHeaders(header http.Header) {
+	header.Set(NodeIdentityHeader, IdentityString(h.identity.SignatureVerifier))
+	header.Set(NodeIdentityChallengeHeader, base64.StdEncoding.EncodeToString(h.challenge[:]))
+	header.Set(NodeIdentityKeyHeader, base64.StdEncoding.EncodeToString(h.ephemeralPublicKey[:]))
+}
+
+// readPeerHeaders reads the identity, the challenge and the ephemeral key of the peer. It returns false when
+// the peer didn't send an identity.
+func (h *identityHandshake) readPeerHeaders(header http.Header) (bool, error) {
+	identity := header.Get(NodeIdentityHeader)
+	if identity == "" {
+		return false, nil
+	}
+	var err error
+	h.peerIdentity, err = parseIdentity(identity)
+	if err != nil {
+		return true, fmt.Errorf("invalid %s header: %v", NodeIdentityHeader, err)
+	}
+	err = decodeIdentityValue(header.Get(NodeIdentityChallengeHeader), h.peerChallenge[:])
+	if err != nil {
+		return true, fmt.Errorf("invalid %s header: %v", NodeIdentityChallengeHeader, err)
+	}
+	err = decodeIdentityValue(header.Get(NodeIdentityKeyHeader), h.peerEphemeralPublicKey[:])
+	if err != nil {
+		return true, fmt.Errorf("invalid %s header: %v", NodeIdentityKeyHeader, err)
+	}
+	return true, nil
+}
+
+// identityTranscript returns the message signed by one side of the connection: its domain, followed by the
+// challenge of the other side, the ephemeral key of the signing side and the ephemeral key of the other side.
+func identityTranscript(domain string, challenge []byte, signerKey []byte, otherKey []byte) []byte {
+	transcript := make([]byte, 0, len(domain)+len(challenge)+len(signerKey)+len(otherKey))
+	transcript = append(transcript, domain...)
+	transcript = append(transcript, challenge...)
+	transcript = append(transcript, signerKey...)
+	return append(transcript, otherKey...)
+}
+
+// sign signs the challenge of the peer and the ephemeral keys with the node identity.
+func (h *identityHandshake) sign(domain string) crypto.Signature {
+	return h.identity.SignBytes(identityTranscript(domain, h.peerChallenge[:], h.ephemeralPublicKey[:], h.peerEphemeralPublicKey[:]))
+}
+
+// verify verifies the signature of the peer over the challenge of this node and the ephemeral keys.
+func (h *identityHandshake) verify(domain string, sig crypto.Signature) bool {
+	return h.peerIdentity.VerifyBytes(identityTranscript(domain, h.challenge[:], h.peerEphemeralPublicKey[:], h.ephemeralPublicKey[:]), sig)
+}
+
+// sessionKeys derives the keys encrypting the traffic sent and received by this node from the ephemeral keys.
+func (h *identityHandshake) sessionKeys(outgoing bool) (send cipher.AEAD, recv cipher.AEAD, err error) {
+	shared, err := curve25519.X25519(h.ephemeralKey[:], h.peerEphemeralPublicKey[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	clientChallenge, serverChallenge := h.challenge, h.peerChallenge
+	if !outgoing {
+		clientChallenge, serverChallenge = h.peerChallenge, h.challenge
+	}
+	salt := append(clientChallenge[:], serverChallenge[:]...)
+	keys := make([]byte, 2*chacha20poly1305.KeySize)
+	_, err = io.ReadFull(hkdf.New(sha256.New, shared, salt, []byte(identityKeysDomain)), keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	clientKey, serverKey := keys[:chacha20poly1305.KeySize], keys[chacha20poly1305.KeySize:]
+	if !outgoing {
+		clientKey, serverKey = serverKey, clientKey
+	}
+	if send, err = chacha20poly1305.New(clientKey); err != nil {
+		return nil, nil, err
+	}
+	if recv, err = chacha20poly1305.New(serverKey); err != nil {
+		return nil, nil, err
+	}
+	return send, recv, nil
+}
+
+// encryptedConn encrypts the binary messages written to a websocket connection, and decrypts the ones read from it.
+// Each direction has its own key, and the messages are numbered in each direction, so that the messages which were
+// replayed, reordered or dropped fail to decrypt.
+type encryptedConn struct {
+	wsPeerWebsocketConn
+
+	sendMu      deadlock.Mutex
+	send        cipher.AEAD
+	sendCounter uint64
+
+	// recv and recvCounter are only used by the peer read loop.
+	recv        cipher.AEAD
+	recvCounter uint64
+}
+
+func makeEncryptedConn(conn wsPeerWebsocketConn, send, recv cipher.AEAD) *encryptedConn {
+	networkEncryptedConnections.Inc(nil)
+	return &encryptedConn{wsPeerWebsocketConn: conn, send: send, recv: recv}
+}
+
+func encryptedConnNonce(counter uint64) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.LittleEndian.PutUint64(nonce[chacha20poly1305.NonceSize-8:], counter)
+	return nonce
+}
+
+// SetReadLimit limits the length of the decrypted messages.
+func (c *encryptedConn) SetReadLimit(limit int64) {
+	c.wsPeerWebsocketConn.SetReadLimit(limit + int64(c.recv.Overhead()))
+}
+
+// NextReader returns a reader of the next decrypted message.
+func (c *encryptedConn) NextReader() (int, io.Reader, error) {
+	mtype, reader, err := c.wsPeerWebsocketConn.NextReader()
+	if err != nil || mtype != websocket.BinaryMessage {
+		return mtype, reader, err
+	}
+	ciphertext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return mtype, nil, err
+	}
+	plaintext, err := c.recv.Open(ciphertext[:0], encryptedConnNonce(c.recvCounter), ciphertext, nil)
+	if err != nil {
+		return mtype, nil, errEncryptedMessageInvalid
+	}
+	c.recvCounter++
+	return mtype, bytes.NewReader(plaintext), nil
+}
+
+// WriteMessage encrypts and writes a message.
+func (c *encryptedConn) WriteMessage(mtype int, data []byte) error {
+	if mtype != websocket.BinaryMessage {
+		return c.wsPeerWebsocketConn.WriteMessage(mtype, data)
+	}
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	ciphertext := c.send.Seal(nil, encryptedConnNonce(c.sendCounter), data, nil)
+	c.sendCounter++
+	return c.wsPeerWebsocketConn.WriteMessage(mtype, ciphertext)
+}
+
+// SetIdentity sets the node identity the gossip connections are authenticated with. It should be called before
+// the network is started.
+func (wn *WebsocketNetwork) SetIdentity(identity *crypto.SignatureSecrets) {
+	wn.identity = identity
+}
+
+// identityAllowed tells whether the connections with the given node identity are allowed.
+func (wn *WebsocketNetwork) identityAllowed(identity crypto.PublicKey) bool {
+	return wn.identityAllowlist == nil || wn.identityAllowlist[identity]
+}
+
+// refuseIdentity refuses an incoming connection which failed the identity checks.
+func (wn *WebsocketNetwork) refuseIdentity(response http.ResponseWriter, request *http.Request, status int, err error) int {
+	wn.log.Warn(filterASCII(fmt.Sprintf("new peer %s refused: %v", request.RemoteAddr, err)))
+	networkIdentityHandshakeFailures.Inc(nil)
+	networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "identity"})
+	response.WriteHeader(status)
+	n, werr := response.Write([]byte(err.Error()))
+	if werr != nil {
+		wn.log.Warnf("ws failed to write response '%s' : n = %d err = %v", err.Error(), n, werr)
+	}
+	return status
+}
+
+// checkIncomingIdentity checks the identity of the peer requesting an incoming connection, and sets the identity
+// headers of the response. It returns the handshake to complete once the connection is upgraded, which is nil when
+// the connection isn't authenticated.
+func (wn *WebsocketNetwork) checkIncomingIdentity(response http.ResponseWriter, request *http.Request, responseHeader http.Header) (*identityHandshake, int) {
+	if wn.identity == nil {
+		if wn.identityAllowlist != nil {
+			return nil, wn.refuseIdentity(response, request, http.StatusForbidden, errIdentityRequired)
+		}
+		return nil, http.StatusOK
+	}
+	h, err := makeIdentityHandshake(wn.identity)
+	if err != nil {
+		return nil, wn.refuseIdentity(response, request, http.StatusInternalServerError, err)
+	}
+	hasIdentity, err := h.readPeerHeaders(request.Header)
+	if err != nil {
+		return nil, wn.refuseIdentity(response, request, http.StatusPreconditionFailed, err)
+	}
+	if !hasIdentity {
+		if wn.identityAllowlist != nil {
+			return nil, wn.refuseIdentity(response, request, http.StatusForbidden, errIdentityRequired)
+		}
+		return nil, http.StatusOK
+	}
+	if !wn.identityAllowed(h.peerIdentity) {
+		return nil, wn.refuseIdentity(response, request, http.StatusForbidden, errIdentityNotAllowed)
+	}
+	h.setHeaders(responseHeader)
+	sig := h.sign(identityServerDomain)
+	responseHeader.Set(NodeIdentitySignatureHeader, base64.StdEncoding.EncodeToString(sig[:]))
+	return h, http.StatusOK
+}
+
+// completeIncomingIdentity reads the identity proof of the client from the upgraded connection, and returns the
+// connection encrypting the traffic.
+func completeIncomingIdentity(conn *websocket.Conn, h *identityHandshake) (wsPeerWebsocketConn, error) {
+	conn.SetReadLimit(int64(len(protocol.NodeIdentityTag) + len(crypto.Signature{})))
+	conn.SetReadDeadline(time.Now().Add(identityProofTimeout))
+	mtype, data, err := conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	if mtype != websocket.BinaryMessage || len(data) != len(protocol.NodeIdentityTag)+len(crypto.Signature{}) || protocol.Tag(data[:2]) != protocol.NodeIdentityTag {
+		return nil, errIdentityProofMissing
+	}
+	var sig crypto.Signature
+	copy(sig[:], data[2:])
+	if !h.verify(identityClientDomain, sig) {
+		return nil, errIdentityProofInvalid
+	}
+	conn.SetReadDeadline(time.Time{})
+	send, recv, err := h.sessionKeys(false)
+	if err != nil {
+		return nil, err
+	}
+	return makeEncryptedConn(conn, send, recv), nil
+}
+
+// completeOutgoingIdentity checks the identity of the server of an outgoing connection, sends the identity proof of
+// this node and returns the connection encrypting the traffic. The connection is returned as is when it isn't
+// authenticated.
+func (wn *WebsocketNetwork) completeOutgoingIdentity(conn *websocket.Conn, h *identityHandshake, responseHeader http.Header) (wsPeerWebsocketConn, error) {
+	if h == nil {
+		return conn, nil
+	}
+	hasIdentity, err := h.readPeerHeaders(responseHeader)
+	if err != nil {
+		return nil, err
+	}
+	if !hasIdentity {
+		if wn.identityAllowlist != nil {
+			return nil, errIdentityRequired
+		}
+		return conn, nil
+	}
+	if !wn.identityAllowed(h.peerIdentity) {
+		return nil, errIdentityNotAllowed
+	}
+	var sig crypto.Signature
+	err = decodeIdentityValue(responseHeader.Get(NodeIdentitySignatureHeader), sig[:])
+	if err != nil || !h.verify(identityServerDomain, sig) {
+		// the server claims an identity it can't prove; it might be impersonating another relay.
+		return nil, errIdentityProofInvalid
+	}
+	proof := h.sign(identityClientDomain)
+	err = conn.WriteMessage(websocket.BinaryMessage, append([]byte(protocol.NodeIdentityTag), proof[:]...))
+	if err != nil {
+		return nil, err
+	}
+	send, recv, err := h.sessionKeys(true)
+	if err != nil {
+		return nil, err
+	}
+	return makeEncryptedConn(conn, send, recv), nil
+}
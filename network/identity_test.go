@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorand/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// queueConn is a connection which reads back the messages written to it.
+type queueConn struct {
+	nopConn
+	messages chan []byte
+}
+
+func (qc *queueConn) NextReader() (int, io.Reader, error) {
+	return websocket.BinaryMessage, bytes.NewReader(<-qc.messages), nil
+}
+
+func (qc *queueConn) WriteMessage(mtype int, data []byte) error {
+	qc.messages <- data
+	return nil
+}
+
+func makeTestIdentity() *crypto.SignatureSecrets {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	return crypto.GenerateSignatureSecrets(seed)
+}
+
+func TestIdentityHandshake(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	clientIdentity := makeTestIdentity()
+	serverIdentity := makeTestIdentity()
+	client, err := makeIdentityHandshake(clientIdentity)
+	require.NoError(t, err)
+	server, err := makeIdentityHandshake(serverIdentity)
+	require.NoError(t, err)
+
+	requestHeader := make(http.Header)
+	client.setHeaders(requestHeader)
+	hasIdentity, err := server.readPeerHeaders(requestHeader)
+	require.NoError(t, err)
+	require.True(t, hasIdentity)
+	require.Equal(t, clientIdentity.SignatureVerifier, server.peerIdentity)
+
+	responseHeader := make(http.Header)
+	server.setHeaders(responseHeader)
+	hasIdentity, err = client.readPeerHeaders(responseHeader)
+	require.NoError(t, err)
+	require.True(t, hasIdentity)
+
+	// each side verifies the signature of the other over its own challenge
+	serverSig := server.sign(identityServerDomain)
+	require.True(t, client.verify(identityServerDomain, serverSig))
+	require.False(t, client.verify(identityClientDomain, serverSig))
+	require.False(t, server.verify(identityServerDomain, serverSig))
+	clientSig := client.sign(identityClientDomain)
+	require.True(t, server.verify(identityClientDomain, clientSig))
+
+	// a signature made for another connection doesn't verify
+	impersonator, err := makeIdentityHandshake(serverIdentity)
+	require.NoError(t, err)
+	_, err = impersonator.readPeerHeaders(requestHeader)
+	require.NoError(t, err)
+	other, err := makeIdentityHandshake(clientIdentity)
+	require.NoError(t, err)
+	_, err = other.readPeerHeaders(responseHeader)
+	require.NoError(t, err)
+	require.False(t, other.verify(identityServerDomain, impersonator.sign(identityServerDomain)))
+
+	// both sides derive the same keys, and the messages go through in both directions
+	clientSend, clientRecv, err := client.sessionKeys(true)
+	require.NoError(t, err)
+	serverSend, serverRecv, err := server.sessionKeys(false)
+	require.NoError(t, err)
+	toServer := &queueConn{messages: make(chan []byte, 10)}
+	toClient := &queueConn{messages: make(chan []byte, 10)}
+	clientConn := makeEncryptedConn(toServer, clientSend, clientRecv)
+	serverConn := makeEncryptedConn(toServer, serverSend, serverRecv)
+	serverToClientConn := makeEncryptedConn(toClient, serverSend, serverRecv)
+	clientFromServerConn := makeEncryptedConn(toClient, clientSend, clientRecv)
+
+	for _, msg := range []string{"hello", "world"} {
+		require.NoError(t, clientConn.WriteMessage(websocket.BinaryMessage, []byte(msg)))
+		ciphertext := <-toServer.messages
+		require.NotContains(t, string(ciphertext), msg)
+		toServer.messages <- ciphertext
+		_, reader, err := serverConn.NextReader()
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, msg, string(data))
+	}
+	require.NoError(t, serverToClientConn.WriteMessage(websocket.BinaryMessage, []byte("reply")))
+	_, reader, err := clientFromServerConn.NextReader()
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "reply", string(data))
+
+	// replayed messages fail to decrypt
+	require.NoError(t, clientConn.WriteMessage(websocket.BinaryMessage, []byte("once")))
+	ciphertext := <-toServer.messages
+	toServer.messages <- ciphertext
+	toServer.messages <- ciphertext
+	_, _, err = serverConn.NextReader()
+	require.NoError(t, err)
+	_, _, err = serverConn.NextReader()
+	require.Equal(t, errEncryptedMessageInvalid, err)
+}
+
+func TestIdentityHeadersErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h, err := makeIdentityHandshake(makeTestIdentity())
+	require.NoError(t, err)
+	hasIdentity, err := h.readPeerHeaders(make(http.Header))
+	require.NoError(t, err)
+	require.False(t, hasIdentity)
+
+	header := make(http.Header)
+	h.setHeaders(header)
+	header.Set(NodeIdentityKeyHeader, "Zm9v")
+	hasIdentity, err = h.readPeerHeaders(header)
+	require.Error(t, err)
+	require.True(t, hasIdentity)
+}
+
+func TestParseIdentityAllowlist(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := makeTestIdentity().SignatureVerifier
+	b := makeTestIdentity().SignatureVerifier
+	allowlist, err := parseIdentityAllowlist(IdentityString(a) + ", " + IdentityString(b) + ",")
+	require.NoError(t, err)
+	require.Equal(t, map[crypto.PublicKey]bool{a: true, b: true}, allowlist)
+
+	_, err = parseIdentityAllowlist(IdentityString(a) + ",foo")
+	require.Error(t, err)
+}
+
+func TestLoadNodeIdentity(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), "node.identity")
+	identity, err := LoadNodeIdentity(path)
+	require.NoError(t, err)
+	loaded, err := LoadNodeIdentity(path)
+	require.NoError(t, err)
+	require.Equal(t, identity.SignatureVerifier, loaded.SignatureVerifier)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("foo"), 0600))
+	_, err = LoadNodeIdentity(path)
+	require.Error(t, err)
+}
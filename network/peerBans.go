@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// PeerBan is a temporary ban of an address or a host placed by the node operator.
+type PeerBan struct {
+	// Address is either a host ( i.e. an IP address ), or a host:port address as it appears in the phonebook.
+	Address string
+	// Until is the time at which the ban expires.
+	Until time.Time
+}
+
+// peerBanList holds the bans placed by the node operator. Expired bans are dropped lazily.
+type peerBanList struct {
+	mu   deadlock.Mutex
+	bans map[string]peerBan
+}
+
+// peerBan is a ban held by a peerBanList, keyed by its normalized address.
+type peerBan struct {
+	until time.Time
+	// addrs are the addresses the ban was placed on, along with the normalized one.
+	addrs []string
+}
+
+// normalizeBanAddress strips the scheme and the path out of URL addresses, so that a ban on
+// a URL would match the host:port address it refers to.
+func normalizeBanAddress(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if strings.Contains(addr, "://") {
+		if parsedURL, err := url.Parse(addr); err == nil && parsedURL.Host != "" {
+			return parsedURL.Host
+		}
+	}
+	return addr
+}
+
+// ban adds a ban on the given address until the given time, and returns the normalized address.
+func (bl *peerBanList) ban(addr string, until time.Time) string {
+	addr = strings.TrimSpace(addr)
+	normalized := normalizeBanAddress(addr)
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	if bl.bans == nil {
+		bl.bans = make(map[string]peerBan)
+	}
+	ban := peerBan{until: until, addrs: []string{normalized}}
+	if existing, found := bl.bans[normalized]; found {
+		ban.addrs = existing.addrs
+	}
+	for _, banned := range ban.addrs {
+		if banned == addr {
+			bl.bans[normalized] = ban
+			return normalized
+		}
+	}
+	ban.addrs = append(ban.addrs, addr)
+	bl.bans[normalized] = ban
+	return normalized
+}
+
+// unban lifts the ban on the given address. It returns the normalized address, along
+// with the addresses the ban was placed on, or no addresses if no such ban was in place.
+func (bl *peerBanList) unban(now time.Time, addr string) (string, []string) {
+	addr = normalizeBanAddress(addr)
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	if !bl.activeLocked(now, addr) {
+		return addr, nil
+	}
+	addrs := bl.bans[addr].addrs
+	delete(bl.bans, addr)
+	return addr, addrs
+}
+
+// isBanned tests whether any of the given addresses, or the hosts they refer to, is banned.
+func (bl *peerBanList) isBanned(now time.Time, addrs ...string) bool {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	if len(bl.bans) == 0 {
+		return false
+	}
+	for _, addr := range addrs {
+		if addr == "" {
+			continue
+		}
+		addr = normalizeBanAddress(addr)
+		if bl.activeLocked(now, addr) || bl.activeLocked(now, justHost(addr)) {
+			return true
+		}
+	}
+	return false
+}
+
+// activeLocked tests whether the given address has a ban that hasn't expired yet. The caller must hold bl.mu.
+func (bl *peerBanList) activeLocked(now time.Time, addr string) bool {
+	ban, found := bl.bans[addr]
+	if !found {
+		return false
+	}
+	if !now.Before(ban.until) {
+		delete(bl.bans, addr)
+		return false
+	}
+	return true
+}
+
+// list returns the bans which haven't expired yet, sorted by address.
+func (bl *peerBanList) list(now time.Time) []PeerBan {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	out := make([]PeerBan, 0, len(bl.bans))
+	for addr := range bl.bans {
+		if bl.activeLocked(now, addr) {
+			out = append(out, PeerBan{Address: addr, Until: bl.bans[addr].until})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Address < out[j].Address })
+	return out
+}
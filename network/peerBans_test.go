@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPeerBanList(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var bl peerBanList
+	now := time.Now()
+	require.False(t, bl.isBanned(now, "1.2.3.4"))
+
+	require.Equal(t, "1.2.3.4", bl.ban(" 1.2.3.4 ", now.Add(time.Minute)))
+	require.Equal(t, "r1.example.com:4160", bl.ban("ws://r1.example.com:4160/v1/testnet/gossip", now.Add(time.Second)))
+
+	// a host ban matches any port on that host, while an address ban only matches that address.
+	require.True(t, bl.isBanned(now, "1.2.3.4"))
+	require.True(t, bl.isBanned(now, "1.2.3.4:4160"))
+	require.True(t, bl.isBanned(now, "http://1.2.3.4:8080"))
+	require.True(t, bl.isBanned(now, "", "r1.example.com:4160"))
+	require.False(t, bl.isBanned(now, "r1.example.com"))
+	require.False(t, bl.isBanned(now, "r1.example.com:4161"))
+	require.False(t, bl.isBanned(now, "1.2.3.5"))
+
+	bans := bl.list(now)
+	require.Equal(t, []PeerBan{
+		{Address: "1.2.3.4", Until: now.Add(time.Minute)},
+		{Address: "r1.example.com:4160", Until: now.Add(time.Second)},
+	}, bans)
+
+	// expired bans are dropped.
+	later := now.Add(2 * time.Second)
+	require.False(t, bl.isBanned(later, "r1.example.com:4160"))
+	require.Equal(t, []PeerBan{{Address: "1.2.3.4", Until: now.Add(time.Minute)}}, bl.list(later))
+
+	// expired bans can't be lifted.
+	_, addrs := bl.unban(later, "r1.example.com:4160")
+	require.Empty(t, addrs)
+
+	addr, addrs := bl.unban(now, "1.2.3.4")
+	require.Equal(t, "1.2.3.4", addr)
+	require.Equal(t, []string{"1.2.3.4"}, addrs)
+	_, addrs = bl.unban(now, "1.2.3.4")
+	require.Empty(t, addrs)
+	require.Empty(t, bl.list(now))
+
+	// lifting a ban returns every address it was placed on.
+	bl.ban("ws://r2.example.com:4160/v1/testnet/gossip", now.Add(time.Minute))
+	bl.ban("r2.example.com:4160", now.Add(time.Minute))
+	_, addrs = bl.unban(now, "r2.example.com:4160")
+	require.Equal(t, []string{"r2.example.com:4160", "ws://r2.example.com:4160/v1/testnet/gossip"}, addrs)
+}
@@ -0,0 +1,245 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// Relays which enable the peer exchange share the addresses of the relays they are connected to, along with their own
+// public address, with the peers which registered their interest in the PeerExchangeTag. The addresses are sent once
+// the messages of interest of the peer are received, and then every peerExchangeInterval.
+
+// peerExchangeInterval is the interval at which the relays send the peer exchange message to their peers.
+const peerExchangeInterval = 5 * time.Minute
+
+// maxPeerExchangeAddresses is the maximal number of addresses in a single peer exchange message.
+const maxPeerExchangeAddresses = 32
+
+// maxPeerExchangeAddressLength is the maximal length of a single address in a peer exchange message.
+const maxPeerExchangeAddressLength = 256
+
+// maxExchangedPeers is the maximal number of addresses that are added to the phonebook through the peer exchange.
+const maxExchangedPeers = 256
+
+// exchangedPeerMaxAge is the time after which an address added through the peer exchange is removed from the
+// phonebook, unless a relay shared it again since.
+const exchangedPeerMaxAge = 12 * peerExchangeInterval
+
+// peerExchangeNetworkName is the phonebook network name of the addresses learned through the peer exchange.
+const peerExchangeNetworkName = "peer-exchange"
+
+// savedPhonebookNetworkName is the phonebook network name of the addresses loaded from the phonebook file.
+const savedPhonebookNetworkName = "saved"
+
+// phonebookSaveInterval is the interval at which the phonebook is saved to the phonebook file.
+const phonebookSaveInterval = 10 * time.Minute
+
+var networkPeerExchangeAddressesAdded = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_exchange_addresses_added_total", Description: "number of relay addresses added to the phonebook through the peer exchange"})
+
+var peerExchangeHandlers = []TaggedMessageHandler{
+	{protocol.PeerExchangeTag, HandlerFunc(peerExchangeHandler)},
+}
+
+// peerExchangeAddresses returns the addresses shared through the peer exchange: the public address of this relay
+// and the addresses of the relays it is connected to.
+func (wn *WebsocketNetwork) peerExchangeAddresses() []string {
+	addrs := make([]string, 0, maxPeerExchangeAddresses)
+	if wn.config.PublicAddress != "" {
+		addrs = append(addrs, wn.config.PublicAddress)
+	}
+	for _, peer := range wn.outgoingPeers() {
+		if len(addrs) >= maxPeerExchangeAddresses {
+			break
+		}
+		addrs = append(addrs, peer.(*wsPeer).rootURL)
+	}
+	return addrs
+}
+
+// sendPeerExchange sends the peer exchange message to a single peer.
+func (wn *WebsocketNetwork) sendPeerExchange(peer *wsPeer) {
+	addrs := wn.peerExchangeAddresses()
+	if len(addrs) == 0 {
+		return
+	}
+	err := peer.Unicast(wn.ctx, []byte(strings.Join(addrs, "\n")), protocol.PeerExchangeTag)
+	if err != nil {
+		wn.log.Debugf("could not send the peer exchange message: %v", err)
+	}
+}
+
+// peerExchangeThread periodically sends the peer exchange message to the peers which are interested in it.
+func (wn *WebsocketNetwork) peerExchangeThread() {
+	defer wn.wg.Done()
+	ticker := time.NewTicker(peerExchangeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			addrs := wn.peerExchangeAddresses()
+			if len(addrs) == 0 {
+				continue
+			}
+			// the peers which didn't ask for the peer exchange messages filter these out.
+			wn.Broadcast(wn.ctx, protocol.PeerExchangeTag, []byte(strings.Join(addrs, "\n")), false, nil)
+		case <-wn.ctx.Done():
+			return
+		}
+	}
+}
+
+// peerExchangeHandler adds the relay addresses received from a relay this node connected to to the phonebook.
+func peerExchangeHandler(message IncomingMessage) OutgoingMessage {
+	wn := message.Net.(*WebsocketNetwork)
+	peer := message.Sender.(*wsPeer)
+	if !peer.outgoing {
+		// only the relays picked from the phonebook are trusted to share other relays.
+		wn.log.Debugf("ignoring the peer exchange message from the incoming peer %s", peer.rootURL)
+		return OutgoingMessage{}
+	}
+	lines := strings.Split(string(message.Data), "\n")
+	if len(lines) > maxPeerExchangeAddresses {
+		wn.log.Infof("peer exchange message with too many addresses (%d) from %s", len(lines), peer.rootURL)
+		return OutgoingMessage{}
+	}
+	addrs := make([]string, 0, len(lines))
+	for _, addr := range lines {
+		if addr == "" || len(addr) > maxPeerExchangeAddressLength || addr == wn.config.PublicAddress {
+			continue
+		}
+		if _, err := ParseHostOrURL(addr); err != nil {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	wn.addExchangedPeers(addrs, time.Now())
+	return OutgoingMessage{}
+}
+
+// addExchangedPeers adds the addresses shared by a relay to the phonebook. The addresses which weren't shared again
+// for exchangedPeerMaxAge are removed, and once there are maxExchangedPeers of these, the address with the lowest
+// weight makes room for a new one if it is weighted below the addresses without history.
+func (wn *WebsocketNetwork) addExchangedPeers(addrs []string, now time.Time) {
+	wn.exchangedPeersMu.Lock()
+	defer wn.exchangedPeersMu.Unlock()
+	removed := false
+	for addr, shared := range wn.exchangedPeers {
+		if now.Sub(shared) > exchangedPeerMaxAge {
+			delete(wn.exchangedPeers, addr)
+			removed = true
+		}
+	}
+
+	added := 0
+	for _, addr := range addrs {
+		if _, has := wn.exchangedPeers[addr]; has {
+			wn.exchangedPeers[addr] = now
+			continue
+		}
+		if len(wn.exchangedPeers) >= maxExchangedPeers {
+			lowest, lowestWeight := "", addressScore{}.weight()
+			for exchanged := range wn.exchangedPeers {
+				// the addresses added by this call aren't in the phonebook yet, and are weighted 0.
+				if weight := wn.phonebook.Weight(exchanged); weight > 0 && weight < lowestWeight {
+					lowest, lowestWeight = exchanged, weight
+				}
+			}
+			if lowest == "" {
+				continue
+			}
+			delete(wn.exchangedPeers, lowest)
+			removed = true
+		}
+		wn.exchangedPeers[addr] = now
+		added++
+	}
+
+	if added == 0 && !removed {
+		return
+	}
+	exchanged := make([]string, 0, len(wn.exchangedPeers))
+	for addr := range wn.exchangedPeers {
+		exchanged = append(exchanged, addr)
+	}
+	wn.phonebook.ReplacePeerList(exchanged, peerExchangeNetworkName, PhoneBookEntryRelayRole)
+	networkPeerExchangeAddressesAdded.AddUint64(uint64(added), nil)
+}
+
+// SetPhonebookFile sets the file in which the phonebook addresses and their scores are kept across restarts.
+// It should be called before the network is started.
+func (wn *WebsocketNetwork) SetPhonebookFile(path string) {
+	wn.phonebookFile = path
+}
+
+// loadPhonebook adds the addresses saved in the phonebook file to the phonebook.
+func (wn *WebsocketNetwork) loadPhonebook() {
+	f, err := os.Open(wn.phonebookFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			wn.log.Warnf("could not open the phonebook file: %v", err)
+		}
+		return
+	}
+	defer f.Close()
+	err = wn.phonebook.Load(f, savedPhonebookNetworkName)
+	if err != nil {
+		wn.log.Warnf("could not load the phonebook file %s: %v", wn.phonebookFile, err)
+	}
+}
+
+// savePhonebook writes the phonebook addresses and their scores to the phonebook file.
+func (wn *WebsocketNetwork) savePhonebook() {
+	tmpFile := wn.phonebookFile + ".tmp"
+	f, err := os.OpenFile(tmpFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		wn.log.Warnf("could not create the phonebook file: %v", err)
+		return
+	}
+	err = wn.phonebook.Save(f)
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFile, wn.phonebookFile)
+	}
+	if err != nil {
+		wn.log.Warnf("could not save the phonebook file %s: %v", wn.phonebookFile, err)
+		os.Remove(tmpFile)
+	}
+}
+
+// phonebookSaveThread periodically saves the phonebook to the phonebook file.
+func (wn *WebsocketNetwork) phonebookSaveThread() {
+	defer wn.wg.Done()
+	ticker := time.NewTicker(phonebookSaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			wn.savePhonebook()
+		case <-wn.ctx.Done():
+			return
+		}
+	}
+}
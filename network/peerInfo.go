@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// TagTraffic counts the traffic exchanged with a peer for a single message tag.
+type TagTraffic struct {
+	BytesReceived    uint64
+	BytesSent        uint64
+	MessagesReceived uint64
+	MessagesSent     uint64
+}
+
+// PeerInfo is a snapshot of a connected peer, as reported to the node operator.
+type PeerInfo struct {
+	// ID identifies the connection for as long as it stays open.
+	ID uint64
+
+	// Address is the address the peer is known by; for outgoing connections this is the phonebook address
+	// and for incoming connections this is the public address the peer advertised, if any.
+	Address string
+
+	// RemoteHost is the host of the remote end of the connection.
+	RemoteHost string
+
+	Outgoing      bool
+	Version       string
+	TelemetryGUID string
+	InstanceName  string
+
+	// Identity is the base64 encoded node identity the peer authenticated with, or empty when the connection
+	// isn't authenticated.
+	Identity string
+
+	// ConnectedSince is the time at which the connection was established.
+	ConnectedSince time.Time
+
+	// PingRoundTripTime is the round trip time of the last answered ping, or zero if none was answered recently.
+	PingRoundTripTime time.Duration
+
+	// Traffic counts the bytes and messages exchanged with the peer, per message tag.
+	Traffic map[protocol.Tag]TagTraffic
+}
+
+// peerTrafficCounters accumulates the per-tag traffic of a single peer. The counters are updated by the
+// peer read and write loops, and read by the network when reporting the peer information.
+type peerTrafficCounters struct {
+	mu   deadlock.Mutex
+	tags map[protocol.Tag]TagTraffic
+}
+
+func (c *peerTrafficCounters) received(tag protocol.Tag, bytes int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tags == nil {
+		c.tags = make(map[protocol.Tag]TagTraffic)
+	}
+	t := c.tags[tag]
+	t.BytesReceived += uint64(bytes)
+	t.MessagesReceived++
+	c.tags[tag] = t
+}
+
+func (c *peerTrafficCounters) sent(tag protocol.Tag, bytes int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tags == nil {
+		c.tags = make(map[protocol.Tag]TagTraffic)
+	}
+	t := c.tags[tag]
+	t.BytesSent += uint64(bytes)
+	t.MessagesSent++
+	c.tags[tag] = t
+}
+
+func (c *peerTrafficCounters) snapshot() map[protocol.Tag]TagTraffic {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make(map[protocol.Tag]TagTraffic, len(c.tags))
+	for tag, t := range c.tags {
+		out[tag] = t
+	}
+	return out
+}
+
+// remoteHost returns the host of the remote end of the connection.
+func (wp *wsPeer) remoteHost() string {
+	if wp.originAddress != "" {
+		return wp.originAddress
+	}
+	if wp.conn != nil {
+		if addr := wp.conn.RemoteAddr(); addr != nil {
+			return justHost(addr.String())
+		}
+	}
+	return ""
+}
+
+// identityString returns the encoded node identity of the peer, or an empty string if it isn't authenticated.
+func (wp *wsPeer) identityString() string {
+	if wp.identity == (crypto.PublicKey{}) {
+		return ""
+	}
+	return IdentityString(wp.identity)
+}
+
+// info returns a snapshot of the peer connection.
+func (wp *wsPeer) info(now time.Time) PeerInfo {
+	lastPingSent, lastPingRoundTripTime := wp.pingTimes()
+	if now.Sub(lastPingSent) > maxPingAge {
+		lastPingRoundTripTime = 0
+	}
+	return PeerInfo{
+		ID:                wp.peerID,
+		Address:           wp.rootURL,
+		RemoteHost:        wp.remoteHost(),
+		Outgoing:          wp.outgoing,
+		Version:           wp.version,
+		TelemetryGUID:     wp.TelemetryGUID,
+		InstanceName:      wp.InstanceName,
+		Identity:          wp.identityString(),
+		ConnectedSince:    wp.createTime,
+		PingRoundTripTime: lastPingRoundTripTime,
+		Traffic:           wp.traffic.snapshot(),
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// The messages received over the incoming connections are rate limited per peer and per tag, with a token bucket
+// for each tag having a configured limit. A message arriving once the bucket is empty holds the read loop of the
+// peer until the bucket allows it, which in turn stops reading from the connection and pushes back on the peer.
+// The messages which would hold the read loop for longer than maxRateLimitDelay are dropped instead, and the peers
+// which keep exceeding their rate limits for IncomingRateLimitDisconnectSeconds are disconnected.
+
+// maxRateLimitDelay is the longest time the read loop of a peer waits for the rate limit of a message tag to allow
+// the next message; the messages which would have to wait for longer are dropped.
+const maxRateLimitDelay = 250 * time.Millisecond
+
+// rateLimitViolationResetTime is the time without held or dropped messages after which a peer is no longer
+// considered to be exceeding its rate limits.
+const rateLimitViolationResetTime = time.Second
+
+var networkRateLimitedMessagesByTag = metrics.NewTagCounter("algod_network_rate_limited_messages_{TAG}", "Number of incoming messages dropped because the peer exceeded the rate limit of the message tag")
+var networkRateLimitDelayMicros = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_rate_limit_delay_micros_total", Description: "microseconds spent holding the read loop of the peers exceeding the rate limit of a message tag"})
+var networkRateLimitDisconnects = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_rate_limit_disconnects_total", Description: "number of peers disconnected for persistently exceeding their rate limits"})
+
+// tagRateLimit is the rate limit of the messages of a single tag.
+type tagRateLimit struct {
+	// rate is the number of messages per second.
+	rate float64
+	// burst is the number of messages which can be received at once.
+	burst float64
+}
+
+// parseRateLimits parses the comma separated list of the IncomingMessageRateLimits configuration, in which each
+// limit is made of a tag, the number of messages per second and an optional burst, e.g. "TX:500:1000,AV:2000".
+// The burst defaults to the number of messages per second, and to a single message for the rates below one per
+// second, since a bucket which never holds a whole token drops every message.
+func parseRateLimits(list string) (map[protocol.Tag]tagRateLimit, error) {
+	limits := make(map[protocol.Tag]tagRateLimit)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		fields := strings.Split(entry, ":")
+		if len(fields) < 2 || len(fields) > 3 || len(fields[0]) != 2 {
+			return nil, fmt.Errorf("invalid rate limit %#v", entry)
+		}
+		rate, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate of the rate limit %#v", entry)
+		}
+		burst := math.Max(rate, 1)
+		if len(fields) == 3 {
+			burst, err = strconv.ParseFloat(fields[2], 64)
+			if err != nil || burst < 1 {
+				return nil, fmt.Errorf("invalid burst of the rate limit %#v", entry)
+			}
+		}
+		limits[protocol.Tag(fields[0])] = tagRateLimit{rate: rate, burst: burst}
+	}
+	return limits, nil
+}
+
+// tokenBucket tracks the messages of a single tag received from a peer.
+type tokenBucket struct {
+	limit  tagRateLimit
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes a token for a message received at the given time, and returns the time to wait until the message
+// fits within the rate limit. No token is taken when the wait would be longer than maxDelay, and false is returned.
+func (b *tokenBucket) reserve(now time.Time, maxDelay time.Duration) (time.Duration, bool) {
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.rate
+	if b.tokens > b.limit.burst {
+		b.tokens = b.limit.burst
+	}
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	delay := time.Duration((1 - b.tokens) / b.limit.rate * float64(time.Second))
+	if delay > maxDelay {
+		return 0, false
+	}
+	b.tokens--
+	return delay, true
+}
+
+// peerRateLimiter rate limits the messages received from a single peer. It is only used by the read loop of the peer.
+type peerRateLimiter struct {
+	limits  map[protocol.Tag]tagRateLimit
+	buckets map[protocol.Tag]*tokenBucket
+
+	// disconnectAfter is the time after which a peer which keeps exceeding its rate limits is disconnected; zero
+	// when such peers are never disconnected.
+	disconnectAfter time.Duration
+
+	// violatingSince is the time at which the peer started exceeding its rate limits, and lastViolation the time
+	// at which it last exceeded these; violatingSince is zero while the peer is within its rate limits.
+	violatingSince time.Time
+	lastViolation  time.Time
+}
+
+func makePeerRateLimiter(limits map[protocol.Tag]tagRateLimit, disconnectAfter time.Duration) *peerRateLimiter {
+	return &peerRateLimiter{
+		limits:          limits,
+		buckets:         make(map[protocol.Tag]*tokenBucket),
+		disconnectAfter: disconnectAfter,
+	}
+}
+
+// admit rate limits a message of the given tag received at the given time. It returns the time to hold the message
+// for, whether the message is to be dropped, and whether the peer has been exceeding its rate limits for long
+// enough to be disconnected.
+func (rl *peerRateLimiter) admit(tag protocol.Tag, now time.Time) (delay time.Duration, drop bool, disconnect bool) {
+	limit, limited := rl.limits[tag]
+	if !limited {
+		return 0, false, false
+	}
+	bucket := rl.buckets[tag]
+	if bucket == nil {
+		bucket = &tokenBucket{limit: limit, tokens: limit.burst, last: now}
+		rl.buckets[tag] = bucket
+	}
+	if !rl.violatingSince.IsZero() && now.Sub(rl.lastViolation) >= rateLimitViolationResetTime {
+		rl.violatingSince = time.Time{}
+	}
+	delay, admitted := bucket.reserve(now, maxRateLimitDelay)
+	if admitted && delay == 0 {
+		return 0, false, false
+	}
+	if rl.violatingSince.IsZero() {
+		rl.violatingSince = now
+	}
+	rl.lastViolation = now
+	return delay, !admitted, rl.disconnectAfter > 0 && now.Sub(rl.violatingSince) >= rl.disconnectAfter
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	limits, err := parseRateLimits("")
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	limits, err = parseRateLimits(" TX:500:1000, AV:2000,")
+	require.NoError(t, err)
+	require.Equal(t, map[protocol.Tag]tagRateLimit{
+		protocol.TxnTag:           {rate: 500, burst: 1000},
+		protocol.AgreementVoteTag: {rate: 2000, burst: 2000},
+	}, limits)
+
+	// a rate below one message per second still lets a message through once the bucket refills.
+	limits, err = parseRateLimits("TX:0.5")
+	require.NoError(t, err)
+	require.Equal(t, map[protocol.Tag]tagRateLimit{protocol.TxnTag: {rate: 0.5, burst: 1}}, limits)
+	now := time.Now()
+	bucket := tokenBucket{limit: limits[protocol.TxnTag], tokens: 1, last: now}
+	_, ok := bucket.reserve(now, maxRateLimitDelay)
+	require.True(t, ok)
+	_, ok = bucket.reserve(now.Add(time.Second), maxRateLimitDelay)
+	require.False(t, ok)
+	_, ok = bucket.reserve(now.Add(2*time.Second), maxRateLimitDelay)
+	require.True(t, ok)
+
+	for _, invalid := range []string{"TX", "TXN:5", "TX:0", "TX:foo", "TX:5:0", "TX:5:5:5"} {
+		_, err = parseRateLimits(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestPeerRateLimiter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rl := makePeerRateLimiter(map[protocol.Tag]tagRateLimit{protocol.TxnTag: {rate: 10, burst: 2}}, time.Second)
+	now := time.Now()
+
+	// the burst goes through, and the messages that follow are held until these fit within the rate
+	for i := 0; i < 2; i++ {
+		delay, drop, disconnect := rl.admit(protocol.TxnTag, now)
+		require.Zero(t, delay)
+		require.False(t, drop)
+		require.False(t, disconnect)
+	}
+	delay, drop, _ := rl.admit(protocol.TxnTag, now)
+	require.Equal(t, 100*time.Millisecond, delay)
+	require.False(t, drop)
+	delay, drop, _ = rl.admit(protocol.TxnTag, now)
+	require.Equal(t, 200*time.Millisecond, delay)
+	require.False(t, drop)
+
+	// unless these would have to be held for too long
+	delay, drop, disconnect := rl.admit(protocol.TxnTag, now)
+	require.Zero(t, delay)
+	require.True(t, drop)
+	require.False(t, disconnect)
+
+	// other tags aren't limited
+	delay, drop, _ = rl.admit(protocol.AgreementVoteTag, now)
+	require.Zero(t, delay)
+	require.False(t, drop)
+
+	// the peer is disconnected once it keeps exceeding the limit for long enough
+	for i := 1; i <= 9; i++ {
+		_, drop, disconnect = rl.admit(protocol.TxnTag, now.Add(time.Duration(i)*100*time.Millisecond))
+		require.False(t, drop)
+		require.False(t, disconnect)
+	}
+	_, _, disconnect = rl.admit(protocol.TxnTag, now.Add(time.Second))
+	require.True(t, disconnect)
+
+	// and the peers which go back within their limits start over
+	later := now.Add(10 * time.Second)
+	for i := 0; i < 3; i++ {
+		_, _, disconnect = rl.admit(protocol.TxnTag, later)
+		require.False(t, disconnect)
+	}
+}
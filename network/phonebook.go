@@ -17,8 +17,11 @@
 package network
 
 import (
+	"encoding/json"
+	"io"
 	"math"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -67,6 +70,60 @@ type Phonebook interface {
 
 	// ExtendPeerList adds unique addresses to this set of addresses
 	ExtendPeerList(more []string, networkName string, role PhoneBookEntryRoles)
+
+	// ReportConnection records the outcome of an attempt to connect to the given address,
+	// along with the time it took to establish the connection.
+	ReportConnection(addr string, success bool, latency time.Duration)
+
+	// ReportMisbehavior records that the peer at the given address misbehaved.
+	ReportMisbehavior(addr string)
+
+	// Weight returns the relative likelihood of the address to be selected by GetAddresses, or 0 if it isn't in the phonebook.
+	Weight(addr string) float64
+
+	// Save writes the addresses of the phonebook along with their scores.
+	Save(w io.Writer) error
+
+	// Load adds the addresses written by Save to the set of addresses of networkName, along with their scores.
+	Load(r io.Reader, networkName string) error
+}
+
+// addressScore accumulates the history of the connections to an address, which is used to prefer
+// the reliable addresses over the others.
+type addressScore struct {
+	Successes    uint32 `json:"successes,omitempty"`
+	Failures     uint32 `json:"failures,omitempty"`
+	Misbehaviors uint32 `json:"misbehaviors,omitempty"`
+
+	// Latency is the moving average of the time it took to establish the successful connections.
+	Latency time.Duration `json:"latency,omitempty"`
+
+	// LastSuccess is the time of the last successful connection.
+	LastSuccess time.Time `json:"lastSuccess,omitempty"`
+}
+
+// scoreLatencyWeight is the moving average weight given to the latency of a new connection.
+const scoreLatencyWeight = 0.25
+
+// scoreLatencyReference is the latency at which the weight of an address is halved.
+const scoreLatencyReference = time.Second
+
+// weight returns the relative likelihood of the address to be selected by GetAddresses. Addresses without any
+// history are weighted as much as addresses with as many successful connections as failed ones.
+func (s addressScore) weight() float64 {
+	w := float64(s.Successes+1) / float64(s.Successes+s.Failures+2)
+	w /= float64(uint64(1) << minUint32(s.Misbehaviors, 16))
+	if s.Latency > 0 {
+		w *= float64(scoreLatencyReference) / float64(scoreLatencyReference+s.Latency)
+	}
+	return w
+}
+
+func minUint32(a, b uint32) uint32 {
+	if a < b {
+		return a
+	}
+	return b
 }
 
 // addressData: holds the information associated with each phonebook address.
@@ -83,6 +140,9 @@ type addressData struct {
 
 	// role is the role that this address serves.
 	role PhoneBookEntryRoles
+
+	// score is the history of the connections to the address.
+	score addressScore
 }
 
 // makePhonebookEntryData creates a new addressData entry for provided network name and role.
@@ -141,14 +201,16 @@ func (e *phonebookImpl) appendTime(addr string, t time.Time) {
 	e.data[addr] = entry
 }
 
-func (e *phonebookImpl) filterRetryTime(t time.Time, role PhoneBookEntryRoles) []string {
-	o := make([]string, 0, len(e.data))
+func (e *phonebookImpl) filterRetryTime(t time.Time, role PhoneBookEntryRoles) (addrs []string, weights []float64) {
+	addrs = make([]string, 0, len(e.data))
+	weights = make([]float64, 0, len(e.data))
 	for addr, entry := range e.data {
 		if t.After(entry.retryAfter) && role == entry.role {
-			o = append(o, addr)
+			addrs = append(addrs, addr)
+			weights = append(weights, entry.score.weight())
 		}
 	}
-	return o
+	return addrs, weights
 }
 
 // ReplacePeerList merges a set of addresses with that passed in.
@@ -278,40 +340,33 @@ func (e *phonebookImpl) UpdateConnectionTime(addr string, provisionalTime time.T
 	return true
 }
 
-func shuffleStrings(set []string) {
-	rand.Shuffle(len(set), func(i, j int) { t := set[i]; set[i] = set[j]; set[j] = t })
-}
-
-func shuffleSelect(set []string, n int) []string {
-	if n >= len(set) || n == getAllAddresses {
-		// return shuffled copy of everything
-		out := make([]string, len(set))
-		copy(out, set)
-		shuffleStrings(out)
-		return out
+// weightedShuffleSelect returns up to n elements of the set, in a random order in which each element is as likely
+// to come before another as its weight is larger. The elements are ordered by decreasing u^(1/weight), where u is
+// uniformly distributed in (0,1), which is the weighted random sampling without replacement scheme of Efraimidis and Spirakis.
+func weightedShuffleSelect(set []string, weights []float64, n int) []string {
+	keys := make([]float64, len(set))
+	order := make([]int, len(set))
+	for i := range set {
+		keys[i] = math.Pow(rand.Float64(), 1/weights[i])
+		order[i] = i
 	}
-	// Pick random indexes from the set
-	indexSample := make([]int, n)
-	for i := range indexSample {
-		indexSample[i] = rand.Intn(len(set)-i) + i
-		for oi, ois := range indexSample[:i] {
-			if ois == indexSample[i] {
-				indexSample[i] = oi
-			}
-		}
+	sort.Slice(order, func(i, j int) bool { return keys[order[i]] > keys[order[j]] })
+	if n > len(set) {
+		n = len(set)
 	}
 	out := make([]string, n)
-	for i, index := range indexSample {
-		out[i] = set[index]
+	for i := range out {
+		out[i] = set[order[i]]
 	}
 	return out
 }
 
-// GetAddresses returns up to N shuffled address
+// GetAddresses returns up to N shuffled address, favoring the addresses with the best connection history
 func (e *phonebookImpl) GetAddresses(n int, role PhoneBookEntryRoles) []string {
 	e.lock.RLock()
 	defer e.lock.RUnlock()
-	return shuffleSelect(e.filterRetryTime(time.Now(), role), n)
+	addrs, weights := e.filterRetryTime(time.Now(), role)
+	return weightedShuffleSelect(addrs, weights, n)
 }
 
 // ExtendPeerList adds unique addresses to this set of addresses
@@ -333,3 +388,122 @@ func (e *phonebookImpl) Length() int {
 	defer e.lock.RUnlock()
 	return len(e.data)
 }
+
+// ReportConnection records the outcome of an attempt to connect to the given address,
+// along with the time it took to establish the connection.
+func (e *phonebookImpl) ReportConnection(addr string, success bool, latency time.Duration) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	entry, found := e.data[addr]
+	if !found {
+		return
+	}
+	if !success {
+		entry.score.Failures++
+		e.data[addr] = entry
+		return
+	}
+	entry.score.Successes++
+	entry.score.LastSuccess = time.Now()
+	if entry.score.Latency == 0 {
+		entry.score.Latency = latency
+	} else {
+		entry.score.Latency = time.Duration((1-scoreLatencyWeight)*float64(entry.score.Latency) + scoreLatencyWeight*float64(latency))
+	}
+	e.data[addr] = entry
+}
+
+// ReportMisbehavior records that the peer at the given address misbehaved.
+func (e *phonebookImpl) ReportMisbehavior(addr string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	entry, found := e.data[addr]
+	if !found {
+		return
+	}
+	entry.score.Misbehaviors++
+	e.data[addr] = entry
+}
+
+// Weight returns the relative likelihood of the address to be selected by GetAddresses, or 0 if it isn't in the phonebook.
+func (e *phonebookImpl) Weight(addr string) float64 {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	entry, found := e.data[addr]
+	if !found {
+		return 0
+	}
+	return entry.score.weight()
+}
+
+// maxSavedPhonebookEntries is the maximal number of addresses written by Save; the addresses with the
+// largest weight are kept.
+const maxSavedPhonebookEntries = 1000
+
+// maxFailuresWithoutSuccess is the number of failed connection attempts after which an address which was never
+// connected to successfully isn't saved anymore.
+const maxFailuresWithoutSuccess = 5
+
+// savedPhonebookEntry is the format in which Save writes the phonebook addresses.
+type savedPhonebookEntry struct {
+	Address string              `json:"address"`
+	Role    PhoneBookEntryRoles `json:"role"`
+	Score   addressScore        `json:"score"`
+}
+
+// Save writes the addresses of the phonebook along with their scores.
+func (e *phonebookImpl) Save(w io.Writer) error {
+	e.lock.RLock()
+	entries := make([]savedPhonebookEntry, 0, len(e.data))
+	for addr, entry := range e.data {
+		if entry.score.Successes == 0 && entry.score.Failures >= maxFailuresWithoutSuccess {
+			continue
+		}
+		if entry.score.Successes == 0 && len(entry.networkNames) == 1 && entry.networkNames[peerExchangeNetworkName] {
+			// the addresses shared by other relays are only kept once they proved to be reachable.
+			continue
+		}
+		entries = append(entries, savedPhonebookEntry{Address: addr, Role: entry.role, Score: entry.score})
+	}
+	e.lock.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		wi, wj := entries[i].Score.weight(), entries[j].Score.weight()
+		if wi != wj {
+			return wi > wj
+		}
+		return entries[i].Address < entries[j].Address
+	})
+	if len(entries) > maxSavedPhonebookEntries {
+		entries = entries[:maxSavedPhonebookEntries]
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(entries)
+}
+
+// Load adds the addresses written by Save to the set of addresses of networkName, along with their scores.
+// The scores of the addresses which are already in the phonebook are replaced with the loaded ones.
+func (e *phonebookImpl) Load(r io.Reader, networkName string) error {
+	var entries []savedPhonebookEntry
+	err := json.NewDecoder(r).Decode(&entries)
+	if err != nil {
+		return err
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for _, saved := range entries {
+		if saved.Address == "" || (saved.Role != PhoneBookEntryRelayRole && saved.Role != PhoneBookEntryArchiverRole) {
+			continue
+		}
+		entry, has := e.data[saved.Address]
+		if !has {
+			entry = makePhonebookEntryData(networkName, saved.Role)
+		} else {
+			entry.networkNames[networkName] = true
+		}
+		entry.score = saved.Score
+		e.data[saved.Address] = entry
+	}
+	return nil
+}
@@ -17,6 +17,7 @@
 package network
 
 import (
+	"bytes"
 	"fmt"
 	"math/rand"
 	"sync"
@@ -416,3 +417,73 @@ func TestPhonebookRoles(t *testing.T) {
 		}
 	}
 }
+
+func TestPhonebookScores(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ph := MakePhonebook(1, 1).(*phonebookImpl)
+	ph.ReplacePeerList([]string{"good", "flaky", "bad", "new"}, "default", PhoneBookEntryRelayRole)
+	for i := 0; i < 10; i++ {
+		ph.ReportConnection("good", true, 100*time.Millisecond)
+		ph.ReportConnection("flaky", i%2 == 0, 100*time.Millisecond)
+		ph.ReportConnection("bad", false, 0)
+	}
+	ph.ReportConnection("unknown", true, time.Millisecond)
+	require.Equal(t, 4, ph.Length())
+
+	require.Equal(t, uint32(10), ph.data["good"].score.Successes)
+	require.Equal(t, 100*time.Millisecond, ph.data["good"].score.Latency)
+	require.Equal(t, addressScore{}.weight(), ph.data["new"].score.weight())
+	require.Greater(t, ph.data["good"].score.weight(), ph.data["new"].score.weight())
+	require.Greater(t, ph.data["new"].score.weight(), ph.data["flaky"].score.weight())
+	require.Greater(t, ph.data["flaky"].score.weight(), ph.data["bad"].score.weight())
+
+	goodWeight := ph.data["good"].score.weight()
+	ph.ReportMisbehavior("good")
+	require.Equal(t, goodWeight/2, ph.data["good"].score.weight())
+
+	// the addresses with better scores are selected first more often
+	firsts := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		firsts[ph.GetAddresses(1, PhoneBookEntryRelayRole)[0]]++
+	}
+	require.Greater(t, firsts["new"], firsts["bad"])
+	require.Greater(t, firsts["flaky"], firsts["bad"])
+	require.Len(t, ph.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole), 4)
+}
+
+func TestPhonebookSaveLoad(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ph := MakePhonebook(1, 1).(*phonebookImpl)
+	ph.ReplacePeerList([]string{"relay1", "relay2", "unreachable"}, "default", PhoneBookEntryRelayRole)
+	ph.ReplacePeerList([]string{"archiver1"}, "default", PhoneBookEntryArchiverRole)
+	ph.ReportConnection("relay1", true, 50*time.Millisecond)
+	ph.ReportConnection("relay2", false, 0)
+	for i := 0; i < maxFailuresWithoutSuccess; i++ {
+		ph.ReportConnection("unreachable", false, 0)
+	}
+	// the shared addresses are only saved once connected to.
+	ph.ExtendPeerList([]string{"shared", "shared-reachable"}, peerExchangeNetworkName, PhoneBookEntryRelayRole)
+	ph.ReportConnection("shared-reachable", true, 50*time.Millisecond)
+
+	var buf bytes.Buffer
+	require.NoError(t, ph.Save(&buf))
+
+	loaded := MakePhonebook(1, 1).(*phonebookImpl)
+	loaded.ReplacePeerList([]string{"relay2", "relay3"}, "default", PhoneBookEntryRelayRole)
+	require.NoError(t, loaded.Load(&buf, "saved"))
+	require.Equal(t, 5, loaded.Length())
+	require.Equal(t, ph.data["relay1"].score.Latency, loaded.data["relay1"].score.Latency)
+	require.Equal(t, ph.data["relay1"].score.Successes, loaded.data["relay1"].score.Successes)
+	require.Equal(t, uint32(1), loaded.data["relay2"].score.Failures)
+	require.Equal(t, PhoneBookEntryRoles(PhoneBookEntryArchiverRole), loaded.data["archiver1"].role)
+	require.NotContains(t, loaded.data, "unreachable")
+	require.NotContains(t, loaded.data, "shared")
+
+	// the saved addresses are kept when the other lists are replaced
+	loaded.ReplacePeerList(nil, "default", PhoneBookEntryRelayRole)
+	require.ElementsMatch(t, []string{"relay1", "relay2", "shared-reachable"}, loaded.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+
+	require.Error(t, loaded.Load(bytes.NewBufferString("not json"), "saved"))
+}
@@ -0,0 +1,542 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// simulatedAddressPrefix is the scheme of the addresses of the simulated nodes.
+const simulatedAddressPrefix = "sim://"
+
+// simulatedMessage is a message on its way to a simulated node.
+type simulatedMessage struct {
+	from      string
+	to        *SimulatedNetwork
+	tag       protocol.Tag
+	data      []byte
+	deliverAt time.Time
+	seq       uint64
+}
+
+// simulatedMessageQueue orders the pending messages of a switchboard by delivery time, and then by the order
+// in which these were sent.
+type simulatedMessageQueue []simulatedMessage
+
+func (q simulatedMessageQueue) Len() int { return len(q) }
+
+func (q simulatedMessageQueue) Less(i, j int) bool {
+	if q[i].deliverAt.Equal(q[j].deliverAt) {
+		return q[i].seq < q[j].seq
+	}
+	return q[i].deliverAt.Before(q[j].deliverAt)
+}
+
+func (q simulatedMessageQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *simulatedMessageQueue) Push(x interface{}) { *q = append(*q, x.(simulatedMessage)) }
+
+func (q *simulatedMessageQueue) Pop() interface{} {
+	old := *q
+	msg := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return msg
+}
+
+// SimulatedNetwork implements GossipNode on top of a Switchboard, for the nodes running in a single process.
+// The messages received by the node are handled one at a time, in the order in which the switchboard delivers them.
+// Simulated nodes don't serve HTTP, and only exchange gossip messages and requests.
+type SimulatedNetwork struct {
+	switchboard *Switchboard
+	log         logging.Logger
+	name        string
+	genesisID   string
+	relay       bool
+
+	handlers *Multiplexer
+
+	mu         deadlock.Mutex
+	peers      map[string]*simulatedPeer
+	lastPeerID uint64
+
+	peerBans peerBanList
+
+	// deliverMu is held while a message is handled, so that Stop waits for the message being handled.
+	deliverMu deadlock.Mutex
+	stopped   bool
+
+	ready  chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func makeSimulatedNetwork(sb *Switchboard, log logging.Logger, name string, genesisID string, relay bool) *SimulatedNetwork {
+	return &SimulatedNetwork{
+		switchboard: sb,
+		log:         log,
+		name:        name,
+		genesisID:   genesisID,
+		relay:       relay,
+		handlers:    MakeMultiplexer(log),
+		peers:       make(map[string]*simulatedPeer),
+		ready:       make(chan struct{}),
+	}
+}
+
+// Name returns the name the node is known by on the switchboard.
+func (n *SimulatedNetwork) Name() string {
+	return n.name
+}
+
+// Address returns the address of the simulated node.
+func (n *SimulatedNetwork) Address() (string, bool) {
+	return simulatedAddressPrefix + n.name, true
+}
+
+// Broadcast sends a message to all the connected peers, except the given one.
+func (n *SimulatedNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	return n.BroadcastArray(ctx, []protocol.Tag{tag}, [][]byte{data}, wait, except)
+}
+
+// BroadcastArray sends an array of messages to all the connected peers, except the given one.
+func (n *SimulatedNetwork) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	if len(tags) != len(data) {
+		return errBcastInvalidArray
+	}
+	for _, peer := range n.connectedPeers() {
+		if peer == except {
+			continue
+		}
+		for i := range tags {
+			n.switchboard.send(n.name, peer.name, tags[i], data[i])
+		}
+	}
+	return nil
+}
+
+// Relay broadcasts a message when the node is a relay.
+func (n *SimulatedNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	if n.relay {
+		return n.Broadcast(ctx, tag, data, wait, except)
+	}
+	return nil
+}
+
+// RelayArray broadcasts an array of messages when the node is a relay.
+func (n *SimulatedNetwork) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	if n.relay {
+		return n.BroadcastArray(ctx, tags, data, wait, except)
+	}
+	return nil
+}
+
+// Disconnect disconnects from the given peer, until either side asks to connect to its peers again.
+func (n *SimulatedNetwork) Disconnect(badnode Peer) {
+	if peer, ok := badnode.(*simulatedPeer); ok {
+		n.switchboard.sever(n.name, peer.name)
+	}
+}
+
+// DisconnectPeers disconnects from all the peers, until the node asks to connect to its peers again.
+func (n *SimulatedNetwork) DisconnectPeers() {
+	for _, peer := range n.connectedPeers() {
+		n.switchboard.sever(n.name, peer.name)
+	}
+}
+
+// Ready returns a channel which is closed once the network is started.
+func (n *SimulatedNetwork) Ready() chan struct{} {
+	return n.ready
+}
+
+// RegisterHTTPHandler does nothing, as the simulated nodes don't serve HTTP.
+func (n *SimulatedNetwork) RegisterHTTPHandler(path string, handler http.Handler) {
+}
+
+// RequestConnectOutgoing reconnects the node to the peers it was disconnected from.
+func (n *SimulatedNetwork) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	n.switchboard.reconnect(n)
+}
+
+// GetPeers returns the connected peers matching the given options. All the simulated relays are connected, so
+// these make up the relays of the phonebook; there are no archivers.
+func (n *SimulatedNetwork) GetPeers(options ...PeerOption) []Peer {
+	var out []Peer
+	peers := n.connectedPeers()
+	for _, option := range options {
+		for _, peer := range peers {
+			switch option {
+			case PeersConnectedOut:
+				if peer.outgoing {
+					out = append(out, peer)
+				}
+			case PeersConnectedIn:
+				if !peer.outgoing {
+					out = append(out, peer)
+				}
+			case PeersPhonebookRelays:
+				if peer.relay {
+					out = append(out, peer)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// Start attaches the node to the switchboard, which starts delivering its messages.
+func (n *SimulatedNetwork) Start() {
+	n.ctx, n.cancel = context.WithCancel(context.Background())
+	n.switchboard.attach(n)
+	close(n.ready)
+}
+
+// Stop detaches the node from the switchboard, and drops the messages which haven't been delivered yet. A stopped
+// network can't start again; a node restarting on the same switchboard makes a new network of the same name.
+func (n *SimulatedNetwork) Stop() {
+	n.switchboard.detach(n)
+	if n.cancel != nil {
+		n.cancel()
+	}
+	n.deliverMu.Lock()
+	n.stopped = true
+	n.deliverMu.Unlock()
+}
+
+// RegisterHandlers adds to the set of given message handlers.
+func (n *SimulatedNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
+	n.handlers.RegisterHandlers(dispatch)
+}
+
+// ClearHandlers deregisters all the existing message handlers.
+func (n *SimulatedNetwork) ClearHandlers() {
+	n.handlers.ClearHandlers([]Tag{})
+}
+
+// GetRoundTripper returns the default transport, as the simulated nodes don't connect over HTTP.
+func (n *SimulatedNetwork) GetRoundTripper() http.RoundTripper {
+	return http.DefaultTransport
+}
+
+// OnNetworkAdvance does nothing, as the simulated connections are never idle.
+func (n *SimulatedNetwork) OnNetworkAdvance() {
+}
+
+// GetHTTPRequestConnection returns nil, as the simulated nodes don't serve HTTP.
+func (n *SimulatedNetwork) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	return nil
+}
+
+// RegisterMessageInterest does nothing; the simulated nodes receive the messages of all the tags.
+func (n *SimulatedNetwork) RegisterMessageInterest(protocol.Tag) error {
+	return nil
+}
+
+// SubstituteGenesisID substitutes the "{genesisID}" with the genesisID of the node.
+func (n *SimulatedNetwork) SubstituteGenesisID(rawURL string) string {
+	return strings.Replace(rawURL, "{genesisID}", n.genesisID, -1)
+}
+
+// GetPeerData returns a value stored by SetPeerData.
+func (n *SimulatedNetwork) GetPeerData(peer Peer, key string) interface{} {
+	if p, ok := peer.(*simulatedPeer); ok {
+		return p.getPeerData(key)
+	}
+	return nil
+}
+
+// SetPeerData attaches a piece of data to a peer.
+func (n *SimulatedNetwork) SetPeerData(peer Peer, key string, value interface{}) {
+	if p, ok := peer.(*simulatedPeer); ok {
+		p.setPeerData(key, value)
+	}
+}
+
+// PeersInfo returns a snapshot of the connected peers. The simulated connections don't count their traffic.
+func (n *SimulatedNetwork) PeersInfo() []PeerInfo {
+	peers := n.connectedPeers()
+	out := make([]PeerInfo, 0, len(peers))
+	for _, peer := range peers {
+		out = append(out, PeerInfo{
+			ID:             peer.peerID,
+			Address:        peer.GetAddress(),
+			RemoteHost:     peer.name,
+			Outgoing:       peer.outgoing,
+			Version:        peer.Version(),
+			InstanceName:   peer.name,
+			ConnectedSince: peer.connectedSince,
+		})
+	}
+	return out
+}
+
+// DisconnectPeer disconnects the connected peer with the given id. It returns false if there is no such peer.
+func (n *SimulatedNetwork) DisconnectPeer(id uint64) bool {
+	for _, peer := range n.connectedPeers() {
+		if peer.peerID == id {
+			n.switchboard.sever(n.name, peer.name)
+			return true
+		}
+	}
+	return false
+}
+
+// BanPeer refuses any connection to or from the given node for the given duration, and disconnects it.
+// It returns the number of disconnected peers.
+func (n *SimulatedNetwork) BanPeer(addr string, duration time.Duration) int {
+	now := n.switchboard.Now()
+	n.peerBans.ban(addr, now.Add(duration))
+	disconnected := 0
+	for _, peer := range n.connectedPeers() {
+		if n.banned(peer.name, now) {
+			n.switchboard.sever(n.name, peer.name)
+			disconnected++
+		}
+	}
+	return disconnected
+}
+
+// UnbanPeer lifts the ban on the given node. It returns false if it wasn't banned.
+func (n *SimulatedNetwork) UnbanPeer(addr string) bool {
+	_, bannedAddrs := n.peerBans.unban(n.switchboard.Now(), addr)
+	return len(bannedAddrs) > 0
+}
+
+// BannedPeers returns the bans that haven't expired yet.
+func (n *SimulatedNetwork) BannedPeers() []PeerBan {
+	return n.peerBans.list(n.switchboard.Now())
+}
+
+// banned tests whether the node of the given name is banned at the given time of the virtual clock.
+func (n *SimulatedNetwork) banned(name string, now time.Time) bool {
+	return n.peerBans.isBanned(now, simulatedAddressPrefix+name)
+}
+
+// connectedPeers returns a snapshot of the connected peers.
+func (n *SimulatedNetwork) connectedPeers() []*simulatedPeer {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	peers := make([]*simulatedPeer, 0, len(n.peers))
+	for _, peer := range n.peers {
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+// addPeer connects the node to the node of the given name, unless these are already connected. As in the websocket
+// network, the non-relays see all their connections as outgoing, while the relays see the connections from the
+// non-relays as incoming; between two relays, the connection is made by the relay whose name comes first.
+func (n *SimulatedNetwork) addPeer(name string, relay bool, now time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.peers[name] != nil {
+		return
+	}
+	n.lastPeerID++
+	n.peers[name] = &simulatedPeer{
+		net:              n,
+		name:             name,
+		relay:            relay,
+		outgoing:         !n.relay || (relay && n.name < name),
+		peerID:           n.lastPeerID,
+		connectedSince:   now,
+		closing:          make(chan struct{}),
+		responseChannels: make(map[uint64]chan *Response),
+		clientDataStore:  make(map[string]interface{}),
+	}
+}
+
+// removePeer disconnects the node from the node of the given name.
+func (n *SimulatedNetwork) removePeer(name string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if peer := n.peers[name]; peer != nil {
+		close(peer.closing)
+		delete(n.peers, name)
+	}
+}
+
+// deliver handles a message delivered by the switchboard, and carries out the action returned by its handler.
+func (n *SimulatedNetwork) deliver(msg simulatedMessage) {
+	n.deliverMu.Lock()
+	defer n.deliverMu.Unlock()
+	n.mu.Lock()
+	peer := n.peers[msg.from]
+	n.mu.Unlock()
+	// the messages sent by a peer which has been disconnected since are lost, and so are the messages of a
+	// stopped node.
+	if peer == nil || n.stopped {
+		return
+	}
+	if msg.tag == protocol.TopicMsgRespTag {
+		peer.handleResponse(msg.data)
+		return
+	}
+	incoming := IncomingMessage{
+		Sender:   peer,
+		Tag:      msg.tag,
+		Data:     msg.data,
+		Net:      n,
+		Received: msg.deliverAt.UnixNano(),
+	}
+	outmsg := n.handlers.Handle(incoming)
+	switch outmsg.Action {
+	case Disconnect:
+		n.Disconnect(peer)
+	case Broadcast:
+		n.Broadcast(n.ctx, incoming.Tag, incoming.Data, false, peer)
+	case Respond:
+		err := peer.Respond(n.ctx, incoming, outmsg.Topics)
+		if err != nil && err != n.ctx.Err() {
+			n.log.Warnf("SimulatedNetwork.deliver: Respond returned unexpected error %v", err)
+		}
+	default:
+	}
+}
+
+// simulatedPeer is the connection of a simulated node to another one.
+type simulatedPeer struct {
+	net      *SimulatedNetwork
+	name     string
+	relay    bool
+	outgoing bool
+
+	peerID         uint64
+	connectedSince time.Time
+
+	// closing is closed once the peer is disconnected.
+	closing chan struct{}
+
+	requestNonce          uint64
+	responseChannelsMutex deadlock.Mutex
+	responseChannels      map[uint64]chan *Response
+
+	clientDataStoreMu deadlock.Mutex
+	clientDataStore   map[string]interface{}
+}
+
+// GetAddress returns the address of the peer.
+func (p *simulatedPeer) GetAddress() string {
+	return simulatedAddressPrefix + p.name
+}
+
+// Unicast sends the given bytes to the peer.
+func (p *simulatedPeer) Unicast(ctx context.Context, data []byte, tag protocol.Tag) error {
+	select {
+	case <-p.closing:
+		return fmt.Errorf("simulated peer %s is disconnected", p.name)
+	default:
+	}
+	p.net.switchboard.send(p.net.name, p.name, tag, data)
+	return nil
+}
+
+// Version returns the current protocol version.
+func (p *simulatedPeer) Version() string {
+	return ProtocolVersion
+}
+
+// Request sends a request to the peer, and waits for its response.
+func (p *simulatedPeer) Request(ctx context.Context, tag Tag, topics Topics) (resp *Response, e error) {
+	nonce := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(nonce, atomic.AddUint64(&p.requestNonce, 1))
+	topics = append(topics, Topic{key: "nonce", data: nonce})
+	serializedMsg := topics.MarshallTopics()
+	hash := hashTopics(serializedMsg)
+
+	responseChannel := make(chan *Response, 1)
+	p.responseChannelsMutex.Lock()
+	p.responseChannels[hash] = responseChannel
+	p.responseChannelsMutex.Unlock()
+	defer func() {
+		p.responseChannelsMutex.Lock()
+		delete(p.responseChannels, hash)
+		p.responseChannelsMutex.Unlock()
+	}()
+
+	if err := p.Unicast(ctx, serializedMsg, tag); err != nil {
+		return nil, err
+	}
+	select {
+	case resp = <-responseChannel:
+		return resp, nil
+	case <-p.closing:
+		return nil, fmt.Errorf("simulated peer %s is disconnected", p.name)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Respond sends the response to a request received from the peer.
+func (p *simulatedPeer) Respond(ctx context.Context, reqMsg IncomingMessage, responseTopics Topics) (e error) {
+	requestHashData := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(requestHashData, hashTopics(reqMsg.Data))
+	responseTopics = append(responseTopics, Topic{key: requestHashKey, data: requestHashData})
+	return p.Unicast(ctx, responseTopics.MarshallTopics(), protocol.TopicMsgRespTag)
+}
+
+// handleResponse passes a response received from the peer on to the request waiting for it.
+func (p *simulatedPeer) handleResponse(data []byte) {
+	topics, err := UnmarshallTopics(data)
+	if err != nil {
+		p.net.log.Warnf("SimulatedNetwork: could not read the topics of a response from %s: %v", p.name, err)
+		return
+	}
+	requestHash, found := topics.GetValue(requestHashKey)
+	if !found {
+		p.net.log.Warnf("SimulatedNetwork: response from %s is missing the %s", p.name, requestHashKey)
+		return
+	}
+	hashKey, _ := binary.Uvarint(requestHash)
+	p.responseChannelsMutex.Lock()
+	responseChannel, found := p.responseChannels[hashKey]
+	delete(p.responseChannels, hashKey)
+	p.responseChannelsMutex.Unlock()
+	if !found {
+		p.net.log.Warnf("SimulatedNetwork: received a response from %s for a stale request", p.name)
+		return
+	}
+	responseChannel <- &Response{Topics: topics}
+}
+
+func (p *simulatedPeer) getPeerData(key string) interface{} {
+	p.clientDataStoreMu.Lock()
+	defer p.clientDataStoreMu.Unlock()
+	return p.clientDataStore[key]
+}
+
+func (p *simulatedPeer) setPeerData(key string, value interface{}) {
+	p.clientDataStoreMu.Lock()
+	defer p.clientDataStoreMu.Unlock()
+	if value == nil {
+		delete(p.clientDataStore, key)
+	} else {
+		p.clientDataStore[key] = value
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"container/heap"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The Switchboard connects the simulated networks of the nodes running in a single process. Every simulated network
+// attached to the switchboard is connected to all the other ones, and their messages are delivered in memory according
+// to the conditions of the links between them: latency, jitter, which reorders the messages, loss and partitions.
+// The random choices of the switchboard are drawn from a seeded source, and the messages are delivered one at a time
+// by a single scheduler, in the order of their delivery time on the virtual clock of the switchboard, so that a test
+// can reproduce them. The virtual clock only moves forward when the test advances it, or while the switchboard runs.
+
+// switchboardEpoch is the time of the virtual clock of a new switchboard.
+var switchboardEpoch = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// LinkConditions describe how the messages sent from one simulated node to another are delivered.
+type LinkConditions struct {
+	// Latency is the time it takes for a message to be delivered.
+	Latency time.Duration
+
+	// Jitter is the longest random time added to the latency of each message. The messages sent over a link
+	// having some jitter might be delivered out of order.
+	Jitter time.Duration
+
+	// LossRate is the probability for a message to be dropped, between 0 and 1.
+	LossRate float64
+}
+
+// switchboardLink identifies the messages sent from one simulated node to another.
+type switchboardLink struct {
+	from string
+	to   string
+}
+
+// Switchboard delivers the messages exchanged by the simulated networks attached to it.
+type Switchboard struct {
+	mu deadlock.Mutex
+
+	rand *rand.Rand
+
+	// nodes holds the started simulated networks, by name.
+	nodes map[string]*SimulatedNetwork
+
+	defaultConditions LinkConditions
+	conditions        map[switchboardLink]LinkConditions
+
+	// partitions holds the partition of each of the nodes listed in the last call to Partition; the nodes which
+	// aren't listed are in partition zero.
+	partitions map[string]int
+
+	// severed holds the links disconnected by one of their ends, in both directions.
+	severed map[switchboardLink]bool
+
+	// now is the time of the virtual clock.
+	now time.Time
+
+	// queue holds the messages which haven't been delivered yet, ordered by delivery time.
+	queue simulatedMessageQueue
+
+	// seq orders the messages which are to be delivered at the same time.
+	seq uint64
+
+	// advanceMu makes sure that the messages are delivered by a single scheduler at a time.
+	advanceMu deadlock.Mutex
+
+	// stop ends the scheduler started by Start.
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// MakeSwitchboard creates a switchboard drawing its random choices from the given seed.
+func MakeSwitchboard(seed int64) *Switchboard {
+	return &Switchboard{
+		rand:       rand.New(rand.NewSource(seed)),
+		nodes:      make(map[string]*SimulatedNetwork),
+		conditions: make(map[switchboardLink]LinkConditions),
+		partitions: make(map[string]int),
+		severed:    make(map[switchboardLink]bool),
+		now:        switchboardEpoch,
+	}
+}
+
+// Now returns the time of the virtual clock of the switchboard.
+func (sb *Switchboard) Now() time.Time {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	return sb.now
+}
+
+// Advance moves the virtual clock forward by the given duration, and delivers the messages which are due by then,
+// including the ones sent while handling these. The messages are delivered on the calling goroutine, in the order of
+// their delivery time, and then in the order in which these were sent.
+func (sb *Switchboard) Advance(duration time.Duration) {
+	sb.advanceMu.Lock()
+	defer sb.advanceMu.Unlock()
+	sb.mu.Lock()
+	until := sb.now.Add(duration)
+	for len(sb.queue) > 0 && !sb.queue[0].deliverAt.After(until) {
+		msg := heap.Pop(&sb.queue).(simulatedMessage)
+		sb.now = msg.deliverAt
+		sb.mu.Unlock()
+		msg.to.deliver(msg)
+		sb.mu.Lock()
+	}
+	sb.now = until
+	sb.mu.Unlock()
+}
+
+// Start runs the scheduler of the switchboard, which advances the virtual clock by the given tick once every tick
+// of wall-clock time, for the nodes whose own timers run on the wall clock. The clock falls behind the wall clock
+// rather than skipping messages when the nodes take longer than a tick to handle them.
+func (sb *Switchboard) Start(tick time.Duration) {
+	sb.stop = make(chan struct{})
+	sb.wg.Add(1)
+	go func() {
+		defer sb.wg.Done()
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-sb.stop:
+				return
+			case <-ticker.C:
+				sb.Advance(tick)
+			}
+		}
+	}()
+}
+
+// Stop stops the scheduler started by Start.
+func (sb *Switchboard) Stop() {
+	if sb.stop == nil {
+		return
+	}
+	close(sb.stop)
+	sb.wg.Wait()
+	sb.stop = nil
+}
+
+// MakeNetwork creates a simulated network for the node of the given name. Relays relay the messages they receive
+// to their other peers, as the relays of the websocket network do. The network is attached to the switchboard once
+// it is started.
+func (sb *Switchboard) MakeNetwork(log logging.Logger, name string, genesisID string, relay bool) *SimulatedNetwork {
+	return makeSimulatedNetwork(sb, log, name, genesisID, relay)
+}
+
+// SetDefaultConditions sets the conditions of the links which don't have conditions of their own.
+func (sb *Switchboard) SetDefaultConditions(conditions LinkConditions) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	sb.defaultConditions = conditions
+}
+
+// SetLinkConditions sets the conditions of the messages sent from one node to another. The messages sent the other
+// way around aren't affected.
+func (sb *Switchboard) SetLinkConditions(from, to string, conditions LinkConditions) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	sb.conditions[switchboardLink{from: from, to: to}] = conditions
+}
+
+// Partition splits the nodes into the given groups, so that the nodes can only reach the other nodes of the same
+// group. The nodes which aren't listed form another group. The previous partitions are replaced.
+func (sb *Switchboard) Partition(groups ...[]string) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	sb.partitions = make(map[string]int)
+	for i, group := range groups {
+		for _, name := range group {
+			sb.partitions[name] = i + 1
+		}
+	}
+}
+
+// Heal removes the partitions, and restores the links disconnected by the nodes.
+func (sb *Switchboard) Heal() {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	sb.partitions = make(map[string]int)
+	sb.severed = make(map[switchboardLink]bool)
+	for _, node := range sb.nodes {
+		sb.connectLocked(node)
+	}
+}
+
+// attach connects a started network to all the other started networks. A network of the same name which is
+// already attached is replaced.
+func (sb *Switchboard) attach(net *SimulatedNetwork) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	if previous := sb.nodes[net.name]; previous != nil && previous != net {
+		net.log.Warnf("simulated network %s replaces a network which wasn't stopped", net.name)
+		sb.detachLocked(previous)
+	}
+	sb.nodes[net.name] = net
+	sb.connectLocked(net)
+}
+
+// detach disconnects a stopped network from all the other networks.
+func (sb *Switchboard) detach(net *SimulatedNetwork) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	if sb.nodes[net.name] == net {
+		sb.detachLocked(net)
+	}
+}
+
+func (sb *Switchboard) detachLocked(net *SimulatedNetwork) {
+	delete(sb.nodes, net.name)
+	for _, other := range sb.nodes {
+		other.removePeer(net.name)
+		net.removePeer(other.name)
+	}
+}
+
+// connectLocked connects the given network to all the other ones, except over the severed links and to the
+// banned nodes.
+func (sb *Switchboard) connectLocked(net *SimulatedNetwork) {
+	for _, other := range sb.nodes {
+		if other == net || sb.severed[switchboardLink{from: net.name, to: other.name}] ||
+			net.banned(other.name, sb.now) || other.banned(net.name, sb.now) {
+			continue
+		}
+		net.addPeer(other.name, other.relay, sb.now)
+		other.addPeer(net.name, net.relay, sb.now)
+	}
+}
+
+// sever disconnects two nodes until either of them asks to connect to its peers again.
+func (sb *Switchboard) sever(from, to string) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	sb.severed[switchboardLink{from: from, to: to}] = true
+	sb.severed[switchboardLink{from: to, to: from}] = true
+	if net := sb.nodes[from]; net != nil {
+		net.removePeer(to)
+	}
+	if net := sb.nodes[to]; net != nil {
+		net.removePeer(from)
+	}
+}
+
+// reconnect restores the links disconnected by or from the given network.
+func (sb *Switchboard) reconnect(net *SimulatedNetwork) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	if sb.nodes[net.name] != net {
+		return
+	}
+	for link := range sb.severed {
+		if link.from == net.name || link.to == net.name {
+			delete(sb.severed, link)
+		}
+	}
+	sb.connectLocked(net)
+}
+
+// send queues a message from one node to another, according to the conditions of the link between them.
+func (sb *Switchboard) send(from string, to string, tag protocol.Tag, data []byte) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	target := sb.nodes[to]
+	if target == nil || sb.partitions[from] != sb.partitions[to] || sb.severed[switchboardLink{from: from, to: to}] {
+		return
+	}
+	conditions, has := sb.conditions[switchboardLink{from: from, to: to}]
+	if !has {
+		conditions = sb.defaultConditions
+	}
+	if conditions.LossRate > 0 && sb.rand.Float64() < conditions.LossRate {
+		return
+	}
+	delay := conditions.Latency
+	if conditions.Jitter > 0 {
+		delay += time.Duration(sb.rand.Int63n(int64(conditions.Jitter) + 1))
+	}
+	sb.seq++
+	heap.Push(&sb.queue, simulatedMessage{
+		from:      from,
+		to:        target,
+		tag:       tag,
+		data:      append([]byte(nil), data...),
+		deliverAt: sb.now.Add(delay),
+		seq:       sb.seq,
+	})
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// simulatedReceiver records the messages received by a simulated node.
+type simulatedReceiver struct {
+	received chan string
+}
+
+func (r *simulatedReceiver) Handle(msg IncomingMessage) OutgoingMessage {
+	r.received <- msg.Sender.(*simulatedPeer).name + ":" + string(msg.Data)
+	return OutgoingMessage{}
+}
+
+func startSimulatedNetworks(t *testing.T, sb *Switchboard, names ...string) (map[string]*SimulatedNetwork, map[string]chan string) {
+	nets := make(map[string]*SimulatedNetwork)
+	received := make(map[string]chan string)
+	for _, name := range names {
+		net := sb.MakeNetwork(logging.TestingLog(t), name, "test", false)
+		received[name] = make(chan string, 100)
+		net.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: &simulatedReceiver{received: received[name]}}})
+		net.Start()
+		t.Cleanup(net.Stop)
+		nets[name] = net
+	}
+	return nets, received
+}
+
+// the messages are delivered by the calls to Advance, so that these were either received by then or won't be.
+func requireReceived(t *testing.T, received chan string, expected string) {
+	select {
+	case msg := <-received:
+		require.Equal(t, expected, msg)
+	default:
+		require.Fail(t, "message not received", expected)
+	}
+}
+
+func requireNothingReceived(t *testing.T, received chan string) {
+	select {
+	case msg := <-received:
+		require.Fail(t, "unexpected message", msg)
+	default:
+	}
+}
+
+func TestSwitchboardDelivery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sb := MakeSwitchboard(1)
+	nets, received := startSimulatedNetworks(t, sb, "a", "b", "c")
+	<-nets["a"].Ready()
+	require.Len(t, nets["a"].GetPeers(PeersConnectedOut, PeersConnectedIn), 2)
+	require.Len(t, nets["a"].PeersInfo(), 2)
+
+	require.NoError(t, nets["a"].Broadcast(context.Background(), protocol.TxnTag, []byte("hello"), false, nil))
+	sb.Advance(0)
+	requireReceived(t, received["b"], "a:hello")
+	requireReceived(t, received["c"], "a:hello")
+	requireNothingReceived(t, received["a"])
+
+	// lossy links drop every message
+	sb.SetLinkConditions("a", "b", LinkConditions{LossRate: 1})
+	require.NoError(t, nets["a"].Broadcast(context.Background(), protocol.TxnTag, []byte("lossy"), false, nil))
+	sb.Advance(0)
+	requireReceived(t, received["c"], "a:lossy")
+	requireNothingReceived(t, received["b"])
+	require.NoError(t, nets["b"].Broadcast(context.Background(), protocol.TxnTag, []byte("back"), false, nil))
+	sb.Advance(0)
+	requireReceived(t, received["a"], "b:back")
+	requireReceived(t, received["c"], "b:back")
+	sb.SetLinkConditions("a", "b", LinkConditions{})
+
+	// the latency holds the messages
+	sb.SetDefaultConditions(LinkConditions{Latency: 200 * time.Millisecond})
+	require.NoError(t, nets["a"].Broadcast(context.Background(), protocol.TxnTag, []byte("late"), false, nil))
+	start := sb.Now()
+	sb.Advance(199 * time.Millisecond)
+	requireNothingReceived(t, received["c"])
+	sb.SetDefaultConditions(LinkConditions{})
+	sb.Advance(time.Millisecond)
+	requireReceived(t, received["b"], "a:late")
+	requireReceived(t, received["c"], "a:late")
+	require.Equal(t, 200*time.Millisecond, sb.Now().Sub(start))
+}
+
+func TestSwitchboardPartition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sb := MakeSwitchboard(1)
+	nets, received := startSimulatedNetworks(t, sb, "a", "b", "c")
+
+	sb.Partition([]string{"a"})
+	require.NoError(t, nets["a"].Broadcast(context.Background(), protocol.TxnTag, []byte("alone"), false, nil))
+	require.NoError(t, nets["b"].Broadcast(context.Background(), protocol.TxnTag, []byte("majority"), false, nil))
+	sb.Advance(0)
+	requireReceived(t, received["c"], "b:majority")
+	requireNothingReceived(t, received["a"])
+	requireNothingReceived(t, received["b"])
+	requireNothingReceived(t, received["c"])
+
+	sb.Heal()
+	require.NoError(t, nets["a"].Broadcast(context.Background(), protocol.TxnTag, []byte("healed"), false, nil))
+	sb.Advance(0)
+	requireReceived(t, received["b"], "a:healed")
+	requireReceived(t, received["c"], "a:healed")
+
+	// disconnected peers stay away until the node connects again
+	var peerB Peer
+	for _, peer := range nets["a"].GetPeers(PeersConnectedOut, PeersConnectedIn) {
+		if peer.(*simulatedPeer).name == "b" {
+			peerB = peer
+		}
+	}
+	require.NotNil(t, peerB)
+	nets["a"].Disconnect(peerB)
+	require.Len(t, nets["a"].GetPeers(PeersConnectedOut, PeersConnectedIn), 1)
+	require.Len(t, nets["b"].GetPeers(PeersConnectedOut, PeersConnectedIn), 1)
+	nets["a"].RequestConnectOutgoing(false, nil)
+	require.Len(t, nets["a"].GetPeers(PeersConnectedOut, PeersConnectedIn), 2)
+
+	// and so do banned peers
+	require.Equal(t, 1, nets["a"].BanPeer("sim://c", time.Hour))
+	nets["a"].RequestConnectOutgoing(false, nil)
+	require.Len(t, nets["a"].GetPeers(PeersConnectedOut, PeersConnectedIn), 1)
+	require.True(t, nets["a"].UnbanPeer("c"))
+	nets["a"].RequestConnectOutgoing(false, nil)
+	require.Len(t, nets["a"].GetPeers(PeersConnectedOut, PeersConnectedIn), 2)
+}
+
+// reorderedMessages returns the order in which a node receives the messages sent over a link having some jitter.
+func reorderedMessages(t *testing.T, seed int64) string {
+	sb := MakeSwitchboard(seed)
+	sb.SetDefaultConditions(LinkConditions{Jitter: 100 * time.Millisecond})
+	nets, received := startSimulatedNetworks(t, sb, "a", "b")
+
+	const count = 20
+	for i := 0; i < count; i++ {
+		require.NoError(t, nets["a"].Broadcast(context.Background(), protocol.TxnTag, []byte{byte('A' + i)}, false, nil))
+	}
+	sb.Advance(100 * time.Millisecond)
+	var order []byte
+	for i := 0; i < count; i++ {
+		select {
+		case msg := <-received["b"]:
+			order = append(order, msg[len(msg)-1])
+		default:
+			require.Fail(t, "message not received")
+		}
+	}
+	return string(order)
+}
+
+func TestSwitchboardReordering(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	order := reorderedMessages(t, 1)
+	require.ElementsMatch(t, []byte("ABCDEFGHIJKLMNOPQRST"), []byte(order))
+	require.NotEqual(t, "ABCDEFGHIJKLMNOPQRST", order)
+
+	// the same seed delivers the messages in the same order
+	require.Equal(t, order, reorderedMessages(t, 1))
+	require.NotEqual(t, order, reorderedMessages(t, 2))
+}
+
+func TestSimulatedPeerRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// the requests wait for their responses, which the scheduler delivers.
+	sb := MakeSwitchboard(1)
+	sb.Start(time.Millisecond)
+	t.Cleanup(sb.Stop)
+	nets, _ := startSimulatedNetworks(t, sb, "a", "b")
+	nets["b"].RegisterHandlers([]TaggedMessageHandler{{
+		Tag: protocol.UniEnsBlockReqTag,
+		MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			topics, err := UnmarshallTopics(msg.Data)
+			require.NoError(t, err)
+			question, _ := topics.GetValue("question")
+			return OutgoingMessage{Action: Respond, Topics: Topics{MakeTopic("answer", append(question, '!'))}}
+		}),
+	}})
+
+	peers := nets["a"].GetPeers(PeersConnectedOut, PeersConnectedIn)
+	require.Len(t, peers, 1)
+	peer := peers[0].(UnicastPeer)
+	require.Equal(t, "sim://b", peer.GetAddress())
+	resp, err := peer.Request(context.Background(), protocol.UniEnsBlockReqTag, Topics{MakeTopic("question", []byte("hi"))})
+	require.NoError(t, err)
+	answer, found := resp.Topics.GetValue("answer")
+	require.True(t, found)
+	require.Equal(t, "hi!", string(answer))
+
+	// requests to peers which can't be reached time out
+	sb.Partition([]string{"a"})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = peer.Request(ctx, protocol.UniEnsBlockReqTag, Topics{MakeTopic("question", []byte("hi"))})
+	require.Equal(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,309 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// Peers which register their interest in the TxnAnnounceTag get the transaction groups announced by their digest
+// rather than sent in full. The peer then requests the groups it hasn't seen yet with a TxnRequestTag message,
+// and gets these sent back with the regular TxnTag. The digest of a transaction group is the digest of its message,
+// tag included, which is the same digest that the message filters use.
+
+// maxTxnAnnouncementDigests is the maximal number of digests carried by a single announcement or request message.
+const maxTxnAnnouncementDigests = 1024
+
+// txnRequestTimeout is the time after which an announced transaction group that was requested but wasn't received
+// is requested again from another peer announcing it.
+const txnRequestTimeout = 2 * time.Second
+
+// maxTxnAnnouncers is the maximal number of peers kept to request an announced transaction group from, once
+// the request to the first peer announcing it times out.
+const maxTxnAnnouncers = 8
+
+// txnAnnouncementCacheSize is the number of announced transaction groups kept to answer the requests of the peers.
+const txnAnnouncementCacheSize = 20000
+
+// txnAnnouncementCacheAge is the time during which an announced transaction group can be requested.
+const txnAnnouncementCacheAge = 30 * time.Second
+
+var networkTxnGroupsAnnounced = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_groups_announced_total", Description: "number of broadcast transaction groups which were announced rather than sent in full to some peer"})
+var networkTxnGroupsRequested = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_groups_requested_total", Description: "number of announced transaction groups requested from peers"})
+var networkTxnGroupsServed = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_groups_served_total", Description: "number of transaction groups sent in response to the requests of peers"})
+var networkTxnGroupsRequestMissed = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_groups_request_missed_total", Description: "number of transaction groups requested by peers which were no longer available"})
+
+// announcedTxnGroup is a transaction group message payload kept to answer the requests of the peers.
+type announcedTxnGroup struct {
+	data  []byte
+	added time.Time
+}
+
+// txnRequest is an announced transaction group requested from a peer.
+type txnRequest struct {
+	// requested is the time at which the group was last requested.
+	requested time.Time
+	// announcers are the other peers which announced the group, in the order they did, to request it from
+	// once the last request times out.
+	announcers []*wsPeer
+}
+
+// txnAnnouncements tracks the transaction groups announced to the peers, and the ones announced by the peers.
+type txnAnnouncements struct {
+	// seen holds the digests of the transaction groups this node received or sent.
+	seen *messageFilter
+
+	mu deadlock.Mutex
+
+	// requested holds the announced transaction groups which were requested from a peer and not received yet.
+	requested map[crypto.Digest]*txnRequest
+
+	// cache holds the payloads of the transaction groups announced to the peers.
+	cache map[crypto.Digest]announcedTxnGroup
+
+	// cacheOrder is a ring of the digests in cache, in the order they were added; cacheNext is the
+	// position of the oldest one, which is evicted once the ring is full.
+	cacheOrder []crypto.Digest
+	cacheNext  int
+}
+
+func makeTxnAnnouncements() *txnAnnouncements {
+	return &txnAnnouncements{
+		seen:       makeMessageFilter(4, txnAnnouncementCacheSize/2),
+		requested:  make(map[crypto.Digest]*txnRequest),
+		cache:      make(map[crypto.Digest]announcedTxnGroup),
+		cacheOrder: make([]crypto.Digest, txnAnnouncementCacheSize),
+	}
+}
+
+// markSeen records that this node has the transaction group of the given digest.
+func (ta *txnAnnouncements) markSeen(digest crypto.Digest) {
+	ta.seen.CheckDigest(digest, true, false)
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	delete(ta.requested, digest)
+}
+
+// add keeps the payload of an announced transaction group, so that the peers can request it.
+func (ta *txnAnnouncements) add(digest crypto.Digest, data []byte, now time.Time) {
+	ta.seen.CheckDigest(digest, true, false)
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	if _, has := ta.cache[digest]; has {
+		return
+	}
+	if evicted := ta.cacheOrder[ta.cacheNext]; !evicted.IsZero() {
+		delete(ta.cache, evicted)
+	}
+	ta.cache[digest] = announcedTxnGroup{data: data, added: now}
+	ta.cacheOrder[ta.cacheNext] = digest
+	ta.cacheNext = (ta.cacheNext + 1) % len(ta.cacheOrder)
+}
+
+// lookup returns the payload of an announced transaction group, if it's still available.
+func (ta *txnAnnouncements) lookup(digest crypto.Digest, now time.Time) ([]byte, bool) {
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	group, has := ta.cache[digest]
+	if !has || now.Sub(group.added) > txnAnnouncementCacheAge {
+		return nil, false
+	}
+	return group.data, true
+}
+
+// unseen returns the digests announced by the peer of the transaction groups that this node doesn't have and
+// hasn't requested yet, and records these as requested. The groups already requested are to be requested from
+// the peer if the pending request times out.
+func (ta *txnAnnouncements) unseen(peer *wsPeer, digests []crypto.Digest, now time.Time) (out []crypto.Digest) {
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	for _, digest := range digests {
+		if ta.seen.CheckDigest(digest, false, false) {
+			continue
+		}
+		if request, has := ta.requested[digest]; has {
+			if len(request.announcers) < maxTxnAnnouncers && !request.announcedBy(peer) {
+				request.announcers = append(request.announcers, peer)
+			}
+			continue
+		}
+		if len(ta.requested) >= txnAnnouncementCacheSize {
+			// too many groups are pending, let a later announcement request this one.
+			continue
+		}
+		ta.requested[digest] = &txnRequest{requested: now}
+		out = append(out, digest)
+	}
+	return out
+}
+
+func (r *txnRequest) announcedBy(peer *wsPeer) bool {
+	for _, announcer := range r.announcers {
+		if announcer == peer {
+			return true
+		}
+	}
+	return false
+}
+
+// timedOut returns the transaction groups whose request timed out, grouped by the next peer announcing them to
+// request these from, and records these as requested again. The groups which no other peer announced are dropped,
+// so that they would be requested from the next peer announcing them.
+func (ta *txnAnnouncements) timedOut(now time.Time) map[*wsPeer][]crypto.Digest {
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	var retries map[*wsPeer][]crypto.Digest
+	for digest, request := range ta.requested {
+		if now.Sub(request.requested) < txnRequestTimeout {
+			continue
+		}
+		// skip the announcers which disconnected since.
+		for len(request.announcers) > 0 && atomic.LoadInt32(&request.announcers[0].didSignalClose) != 0 {
+			request.announcers = request.announcers[1:]
+		}
+		if len(request.announcers) == 0 {
+			delete(ta.requested, digest)
+			continue
+		}
+		peer := request.announcers[0]
+		request.announcers = request.announcers[1:]
+		request.requested = now
+		if retries == nil {
+			retries = make(map[*wsPeer][]crypto.Digest)
+		}
+		retries[peer] = append(retries[peer], digest)
+	}
+	return retries
+}
+
+// encodeTxnDigests encodes the payload of an announcement or request message.
+func encodeTxnDigests(digests []crypto.Digest) []byte {
+	data := make([]byte, 0, len(digests)*crypto.DigestSize)
+	for _, digest := range digests {
+		data = append(data, digest[:]...)
+	}
+	return data
+}
+
+// decodeTxnDigests decodes the payload of an announcement or request message.
+func decodeTxnDigests(data []byte) ([]crypto.Digest, error) {
+	if len(data) == 0 || len(data)%crypto.DigestSize != 0 {
+		return nil, fmt.Errorf("invalid transaction digests length %d", len(data))
+	}
+	count := len(data) / crypto.DigestSize
+	if count > maxTxnAnnouncementDigests {
+		return nil, fmt.Errorf("too many transaction digests %d > %d", count, maxTxnAnnouncementDigests)
+	}
+	digests := make([]crypto.Digest, count)
+	for i := range digests {
+		copy(digests[i][:], data[i*crypto.DigestSize:])
+	}
+	return digests, nil
+}
+
+// announceTxnGroups returns the given messages, with the transaction groups replaced by their announcement.
+func (wn *WebsocketNetwork) announceTxnGroups(tags []protocol.Tag, data [][]byte) [][]byte {
+	now := time.Now()
+	out := make([][]byte, len(data))
+	for i, mbytes := range data {
+		out[i] = mbytes
+		if tags[i] != protocol.TxnTag {
+			continue
+		}
+		digest := crypto.Hash(mbytes)
+		wn.txnAnnouncements.add(digest, mbytes[len(protocol.TxnTag):], now)
+		out[i] = append([]byte(protocol.TxnAnnounceTag), digest[:]...)
+		networkTxnGroupsAnnounced.Inc(nil)
+	}
+	return out
+}
+
+// handleTxnAnnouncement requests the announced transaction groups that this node doesn't have from the announcing peer.
+func (wp *wsPeer) handleTxnAnnouncement(msg IncomingMessage) {
+	digests, err := decodeTxnDigests(msg.Data)
+	if err != nil {
+		wp.net.log.Warnf("wsPeer handleTxnAnnouncement: bad announcement from %s: %v", wp.conn.RemoteAddr().String(), err)
+		return
+	}
+	wp.requestTxnGroups(wp.net.txnAnnouncements.unseen(wp, digests, time.Now()))
+}
+
+// requestTxnGroups requests the transaction groups of the given digests from the peer.
+func (wp *wsPeer) requestTxnGroups(digests []crypto.Digest) {
+	for len(digests) > 0 {
+		batch := digests
+		if len(batch) > maxTxnAnnouncementDigests {
+			batch = batch[:maxTxnAnnouncementDigests]
+		}
+		digests = digests[len(batch):]
+		networkTxnGroupsRequested.AddUint64(uint64(len(batch)), nil)
+		err := wp.Unicast(wp.net.ctx, encodeTxnDigests(batch), protocol.TxnRequestTag)
+		if err != nil {
+			wp.net.log.Debugf("wsPeer requestTxnGroups: %v", err)
+			return
+		}
+	}
+}
+
+// txnRequestRetryThread requests the announced transaction groups again from the other peers announcing them,
+// once their pending request times out.
+func (wn *WebsocketNetwork) txnRequestRetryThread() {
+	defer wn.wg.Done()
+	ticker := time.NewTicker(txnRequestTimeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			for peer, digests := range wn.txnAnnouncements.timedOut(now) {
+				peer.requestTxnGroups(digests)
+			}
+		case <-wn.ctx.Done():
+			return
+		}
+	}
+}
+
+// handleTxnRequest sends the requested transaction groups back to the peer.
+func (wp *wsPeer) handleTxnRequest(msg IncomingMessage) {
+	digests, err := decodeTxnDigests(msg.Data)
+	if err != nil {
+		wp.net.log.Warnf("wsPeer handleTxnRequest: bad request from %s: %v", wp.conn.RemoteAddr().String(), err)
+		return
+	}
+	now := time.Now()
+	for _, digest := range digests {
+		data, found := wp.net.txnAnnouncements.lookup(digest, now)
+		if !found {
+			networkTxnGroupsRequestMissed.Inc(nil)
+			continue
+		}
+		err = wp.Unicast(wp.net.ctx, data, protocol.TxnTag)
+		if err != nil {
+			wp.net.log.Debugf("wsPeer handleTxnRequest: %v", err)
+			return
+		}
+		networkTxnGroupsServed.Inc(nil)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxnAnnouncementsTracking(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ta := makeTxnAnnouncements()
+	now := time.Now()
+	d1 := crypto.Hash([]byte("group1"))
+	d2 := crypto.Hash([]byte("group2"))
+	d3 := crypto.Hash([]byte("group3"))
+
+	p1, p2, p3 := &wsPeer{}, &wsPeer{}, &wsPeer{}
+
+	// groups which were seen aren't requested, and the others are requested once from the first peer announcing them
+	ta.markSeen(d1)
+	require.Equal(t, []crypto.Digest{d2, d3}, ta.unseen(p1, []crypto.Digest{d1, d2, d3}, now))
+	require.Empty(t, ta.unseen(p2, []crypto.Digest{d1, d2, d3}, now.Add(time.Second)))
+	require.Empty(t, ta.unseen(p2, []crypto.Digest{d3}, now.Add(time.Second)))
+	require.Empty(t, ta.unseen(p3, []crypto.Digest{d3}, now.Add(time.Second)))
+	ta.markSeen(d2)
+
+	// once the request times out, the group is requested from the next peer announcing it, skipping the disconnected ones
+	require.Empty(t, ta.timedOut(now.Add(time.Second)))
+	require.Equal(t, map[*wsPeer][]crypto.Digest{p2: {d3}}, ta.timedOut(now.Add(txnRequestTimeout)))
+	p3.didSignalClose = 1
+	require.Empty(t, ta.timedOut(now.Add(txnRequestTimeout+time.Second)))
+	require.Empty(t, ta.timedOut(now.Add(2*txnRequestTimeout)))
+	require.Empty(t, ta.requested)
+
+	// a group which no other peer announced is requested from the next peer announcing it
+	require.Equal(t, []crypto.Digest{d3}, ta.unseen(p1, []crypto.Digest{d1, d2, d3}, now.Add(2*txnRequestTimeout)))
+
+	// announced groups are kept for a while, and count as seen
+	d4 := crypto.Hash([]byte("group4"))
+	ta.add(d4, []byte("group4"), now)
+	data, found := ta.lookup(d4, now.Add(time.Second))
+	require.True(t, found)
+	require.Equal(t, []byte("group4"), data)
+	_, found = ta.lookup(d4, now.Add(txnAnnouncementCacheAge+time.Second))
+	require.False(t, found)
+	require.Empty(t, ta.unseen(p1, []crypto.Digest{d4}, now))
+
+	// the oldest groups are evicted once the cache is full
+	for i := 0; i < txnAnnouncementCacheSize; i++ {
+		ta.add(crypto.Hash([]byte{byte(i), byte(i >> 8), byte(i >> 16)}), nil, now)
+	}
+	_, found = ta.lookup(d4, now)
+	require.False(t, found)
+	require.Len(t, ta.cache, txnAnnouncementCacheSize)
+}
+
+func TestTxnDigestsEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	digests := []crypto.Digest{crypto.Hash([]byte("a")), crypto.Hash([]byte("b"))}
+	decoded, err := decodeTxnDigests(encodeTxnDigests(digests))
+	require.NoError(t, err)
+	require.Equal(t, digests, decoded)
+
+	_, err = decodeTxnDigests(nil)
+	require.Error(t, err)
+	_, err = decodeTxnDigests(make([]byte, crypto.DigestSize+1))
+	require.Error(t, err)
+	_, err = decodeTxnDigests(make([]byte, crypto.DigestSize*(maxTxnAnnouncementDigests+1)))
+	require.Error(t, err)
+}
@@ -210,6 +210,22 @@ type GossipNode interface {
 	// SetPeerData attaches a piece of data to a peer.
 	// Other services inside go-algorand may attach data to a peer that gets garbage collected when the peer is closed.
 	SetPeerData(peer Peer, key string, value interface{})
+
+	// PeersInfo returns a snapshot of the connected peers, along with their traffic statistics.
+	PeersInfo() []PeerInfo
+
+	// DisconnectPeer disconnects the connected peer with the given id. It returns false if there is no such peer.
+	DisconnectPeer(id uint64) bool
+
+	// BanPeer refuses any connection to or from the given address or host for the given duration,
+	// and disconnects the connected peers matching it. It returns the number of disconnected peers.
+	BanPeer(addr string, duration time.Duration) int
+
+	// UnbanPeer lifts the ban on the given address or host. It returns false if it wasn't banned.
+	UnbanPeer(addr string) bool
+
+	// BannedPeers returns the bans that haven't expired yet.
+	BannedPeers() []PeerBan
 }
 
 // IncomingMessage represents a message arriving from some peer in our p2p network
@@ -371,6 +387,31 @@ type WebsocketNetwork struct {
 	// connPerfMonitor is used on outgoing connections to measure their relative message timing
 	connPerfMonitor *connectionPerformanceMonitor
 
+	// compressor compresses the outgoing messages sent to the peers which accept compressed messages.
+	// It's nil when the compression of the outgoing messages is disabled.
+	compressor *messageCompressor
+
+	// txnAnnouncements tracks the transaction groups announced to and by the peers.
+	txnAnnouncements *txnAnnouncements
+
+	// phonebookFile is the file in which the phonebook is kept across restarts; it's empty when the phonebook isn't kept.
+	phonebookFile string
+
+	// exchangedPeers holds the addresses added to the phonebook through the peer exchange, along with the last
+	// time a relay shared them.
+	exchangedPeers   map[string]time.Time
+	exchangedPeersMu deadlock.Mutex
+
+	// identity is the node identity the gossip connections are authenticated with; it's nil when the connections
+	// aren't authenticated.
+	identity *crypto.SignatureSecrets
+
+	// incomingRateLimits holds the rate limits of the messages received over the incoming connections, per message tag.
+	incomingRateLimits map[protocol.Tag]tagRateLimit
+
+	// identityAllowlist holds the node identities of the peers allowed to connect; it's nil when any peer is allowed.
+	identityAllowlist map[crypto.PublicKey]bool
+
 	// lastNetworkAdvanceMu syncronized the access to lastNetworkAdvance
 	lastNetworkAdvanceMu deadlock.Mutex
 
@@ -408,6 +449,12 @@ type WebsocketNetwork struct {
 	// is consumed by any of the messageHandlerThread(s). The ticker itself is created during
 	// Start(), and being shut down when Stop() is called.
 	peersConnectivityCheckTicker *time.Ticker
+
+	// lastPeerID is the id assigned to the last added peer. Locked by peersLock.
+	lastPeerID uint64
+
+	// peerBans holds the addresses and hosts banned by the node operator.
+	peerBans peerBanList
 }
 
 type broadcastRequest struct {
@@ -544,10 +591,88 @@ func (wn *WebsocketNetwork) disconnect(badnode Peer, reason disconnectReason) {
 		return
 	}
 	peer := badnode.(*wsPeer)
+	if peer.outgoing && (reason == disconnectBadData || reason == disconnectBanned) {
+		wn.phonebook.ReportMisbehavior(peer.rootURL)
+	}
 	peer.CloseAndWait()
 	wn.removePeer(peer, reason)
 }
 
+// PeersInfo returns a snapshot of the connected peers, along with their traffic statistics.
+func (wn *WebsocketNetwork) PeersInfo() []PeerInfo {
+	wn.peersLock.RLock()
+	defer wn.peersLock.RUnlock()
+	now := time.Now()
+	infos := make([]PeerInfo, 0, len(wn.peers))
+	for _, peer := range wn.peers {
+		infos = append(infos, peer.info(now))
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// DisconnectPeer disconnects the connected peer with the given id. It returns false if there is no such peer.
+func (wn *WebsocketNetwork) DisconnectPeer(id uint64) bool {
+	var target *wsPeer
+	wn.peersLock.RLock()
+	for _, peer := range wn.peers {
+		if peer.peerID == id {
+			target = peer
+			break
+		}
+	}
+	wn.peersLock.RUnlock()
+	if target == nil {
+		return false
+	}
+	wn.disconnect(target, disconnectOperatorRequest)
+	return true
+}
+
+// BanPeer refuses any connection to or from the given address or host for the given duration,
+// and disconnects the connected peers matching it. It returns the number of disconnected peers.
+func (wn *WebsocketNetwork) BanPeer(addr string, duration time.Duration) int {
+	until := time.Now().Add(duration)
+	// let the phonebook skip the banned address as well, so that it won't be handed over to the other services.
+	wn.phonebook.UpdateRetryAfter(strings.TrimSpace(addr), until)
+	addr = wn.peerBans.ban(addr, until)
+	wn.phonebook.UpdateRetryAfter(addr, until)
+	wn.log.Infof("banned peer %s until %v", addr, until)
+
+	var banned []*wsPeer
+	now := time.Now()
+	wn.peersLock.RLock()
+	for _, peer := range wn.peers {
+		if wn.peerBans.isBanned(now, peer.rootURL, peer.remoteHost()) {
+			banned = append(banned, peer)
+		}
+	}
+	wn.peersLock.RUnlock()
+	for _, peer := range banned {
+		wn.disconnect(peer, disconnectBanned)
+	}
+	return len(banned)
+}
+
+// UnbanPeer lifts the ban on the given address or host. It returns false if it wasn't banned.
+func (wn *WebsocketNetwork) UnbanPeer(addr string) bool {
+	addr, bannedAddrs := wn.peerBans.unban(time.Now(), addr)
+	if len(bannedAddrs) == 0 {
+		return false
+	}
+	// only clear the retry-after of the phonebook entries delayed by the ban.
+	for _, bannedAddr := range bannedAddrs {
+		wn.phonebook.UpdateRetryAfter(bannedAddr, time.Time{})
+	}
+	wn.log.Infof("lifted the ban on peer %s", addr)
+	return true
+}
+
+// BannedPeers returns the bans that haven't expired yet.
+func (wn *WebsocketNetwork) BannedPeers() []PeerBan {
+	return wn.peerBans.list(time.Now())
+}
+
 func closeWaiter(wg *sync.WaitGroup, peer *wsPeer) {
 	defer wg.Done()
 	peer.CloseAndWait()
@@ -615,6 +740,9 @@ func (wn *WebsocketNetwork) GetPeers(options ...PeerOption) []Peer {
 			var addrs []string
 			addrs = wn.phonebook.GetAddresses(1000, PhoneBookEntryRelayRole)
 			for _, addr := range addrs {
+				if wn.peerBans.isBanned(time.Now(), addr) {
+					continue
+				}
 				peerCore := makePeerCore(wn, addr, wn.GetRoundTripper(), "" /*origin address*/)
 				outPeers = append(outPeers, &peerCore)
 			}
@@ -623,6 +751,9 @@ func (wn *WebsocketNetwork) GetPeers(options ...PeerOption) []Peer {
 			var addrs []string
 			addrs = wn.phonebook.GetAddresses(1000, PhoneBookEntryArchiverRole)
 			for _, addr := range addrs {
+				if wn.peerBans.isBanned(time.Now(), addr) {
+					continue
+				}
 				peerCore := makePeerCore(wn, addr, wn.GetRoundTripper(), "" /*origin address*/)
 				outPeers = append(outPeers, &peerCore)
 			}
@@ -726,9 +857,39 @@ func (wn *WebsocketNetwork) setup() {
 		SupportedProtocolVersions = []string{wn.config.NetworkProtocolVersion}
 	}
 
+	compressedTags, err := parseCompressedTags(wn.config.GossipCompressionTags)
+	if err != nil {
+		wn.log.Errorf("disabling the compression of outgoing messages: %v", err)
+	} else if len(compressedTags) > 0 {
+		wn.compressor = makeMessageCompressor(compressedTags)
+	}
+
+	wn.txnAnnouncements = makeTxnAnnouncements()
+
+	wn.incomingRateLimits, err = parseRateLimits(wn.config.IncomingMessageRateLimits)
+	if err != nil {
+		wn.log.Errorf("disabling the rate limits of the incoming messages: %v", err)
+	}
+
 	if wn.relayMessages {
 		wn.RegisterMessageInterest(protocol.CompactCertSigTag)
 	}
+	if wn.config.EnableTxnAnnouncements {
+		wn.RegisterMessageInterest(protocol.TxnAnnounceTag)
+	}
+	wn.exchangedPeers = make(map[string]time.Time)
+	if wn.config.EnablePeerExchange {
+		wn.RegisterMessageInterest(protocol.PeerExchangeTag)
+	}
+
+	if wn.config.GossipIdentityAllowlist != "" {
+		wn.identityAllowlist, err = parseIdentityAllowlist(wn.config.GossipIdentityAllowlist)
+		if err != nil {
+			// an allowlist restricts the peers, so refuse all of these rather than none.
+			wn.log.Errorf("refusing all the peers: %v", err)
+			wn.identityAllowlist = make(map[crypto.PublicKey]bool)
+		}
+	}
 }
 
 // Start makes network connections and threads
@@ -778,6 +939,16 @@ func (wn *WebsocketNetwork) Start() {
 	} else {
 		wn.scheme = "http"
 	}
+	if wn.identity != nil {
+		wn.log.Infof("authenticating the gossip connections with the node identity %s", IdentityString(wn.identity.SignatureVerifier))
+	} else if wn.identityAllowlist != nil {
+		wn.log.Error("the gossip identity allowlist is set without a node identity, so all the peers are refused")
+	}
+	if wn.phonebookFile != "" {
+		wn.loadPhonebook()
+		wn.wg.Add(1)
+		go wn.phonebookSaveThread()
+	}
 	wn.meshUpdateRequests <- meshRequest{false, nil}
 	if wn.config.EnablePingHandler {
 		wn.RegisterHandlers(pingHandlers)
@@ -785,6 +956,13 @@ func (wn *WebsocketNetwork) Start() {
 	if wn.prioScheme != nil {
 		wn.RegisterHandlers(prioHandlers)
 	}
+	if wn.config.EnablePeerExchange {
+		wn.RegisterHandlers(peerExchangeHandlers)
+		if wn.relayMessages {
+			wn.wg.Add(1)
+			go wn.peerExchangeThread()
+		}
+	}
 	if wn.listener != nil {
 		wn.wg.Add(1)
 		go wn.httpdThread()
@@ -811,6 +989,10 @@ func (wn *WebsocketNetwork) Start() {
 		wn.wg.Add(1)
 		go wn.prioWeightRefresh()
 	}
+	if wn.config.EnableTxnAnnouncements {
+		wn.wg.Add(1)
+		go wn.txnRequestRetryThread()
+	}
 	wn.log.Infof("serving genesisID=%s on %#v with RandomID=%s", wn.GenesisID, wn.PublicAddress(), wn.RandomID)
 }
 
@@ -866,6 +1048,9 @@ func (wn *WebsocketNetwork) Stop() {
 	if wn.listener != nil {
 		wn.log.Debugf("closed %s", listenAddr)
 	}
+	if wn.phonebookFile != "" {
+		wn.savePhonebook()
+	}
 
 	// Wait for the requestsTracker to finish up to avoid potential race condition
 	<-wn.requestsTracker.getWaitUntilNoConnectionsChannel(5 * time.Millisecond)
@@ -938,6 +1123,20 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 
 // checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	if wn.peerBans.isBanned(time.Now(), remoteHost) {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "banned"})
+		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+			telemetryspec.ConnectPeerFailEventDetails{
+				Address:      remoteHost,
+				HostName:     otherTelemetryGUID,
+				Incoming:     true,
+				InstanceName: otherInstanceName,
+				Reason:       "Banned",
+			})
+		response.WriteHeader(http.StatusForbidden)
+		return http.StatusForbidden
+	}
+
 	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
@@ -1097,12 +1296,30 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		challenge = wn.prioScheme.NewPrioChallenge()
 		responseHeader.Set(PriorityChallengeHeader, challenge)
 	}
+	identityHandshake, status := wn.checkIncomingIdentity(response, request, responseHeader)
+	if status != http.StatusOK {
+		// we've already logged and written all response(s).
+		return
+	}
 	conn, err := wn.upgrader.Upgrade(response, request, responseHeader)
 	if err != nil {
 		wn.log.Info("ws upgrade fail ", err)
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "ws upgrade fail"})
 		return
 	}
+	var peerConn wsPeerWebsocketConn = conn
+	var peerIdentity crypto.PublicKey
+	if identityHandshake != nil {
+		peerConn, err = completeIncomingIdentity(conn, identityHandshake)
+		if err != nil {
+			wn.log.Warnf("new peer %s failed the identity handshake: %v", trackedRequest.remoteAddr, err)
+			networkIdentityHandshakeFailures.Inc(nil)
+			networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "identity"})
+			conn.Close()
+			return
+		}
+		peerIdentity = identityHandshake.peerIdentity
+	}
 
 	// we want to tell the response object that the status was changed to 101 ( switching protocols ) so that it will be logged.
 	if wn.requestsLogger != nil {
@@ -1111,13 +1328,14 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 
 	peer := &wsPeer{
 		wsPeerCore:        makePeerCore(wn, trackedRequest.otherPublicAddr, wn.GetRoundTripper(), trackedRequest.remoteHost),
-		conn:              conn,
+		conn:              peerConn,
 		outgoing:          false,
 		InstanceName:      trackedRequest.otherInstanceName,
 		incomingMsgFilter: wn.incomingMsgFilter,
 		prioChallenge:     challenge,
 		createTime:        trackedRequest.created,
 		version:           matchingVersion,
+		identity:          peerIdentity,
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -1405,8 +1623,18 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 		if request.tags[i] != protocol.MsgDigestSkipTag && len(d) >= messageFilterSize {
 			digests[i] = crypto.Hash(mbytes)
 		}
+		if request.tags[i] == protocol.TxnTag && wn.config.EnableTxnAnnouncements {
+			// avoid requesting our own transaction groups back from the peers announcing them.
+			wn.txnAnnouncements.markSeen(crypto.Hash(mbytes))
+		}
 	}
 
+	// the messages are compressed at most once, and only if there is any peer accepting compressed messages.
+	var compressedData [][]byte
+	// likewise, the transaction groups are announced only if there is any peer asking for announcements.
+	var announcedData [][]byte
+	var compressedAnnouncedData [][]byte
+
 	// first send to all the easy outbound peers who don't block, get them started.
 	sentMessageCount := 0
 	for _, peer := range peers {
@@ -1416,7 +1644,27 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 		if peer == request.except {
 			continue
 		}
-		ok := peer.writeNonBlockMsgs(request.ctx, data, prio, digests, request.enqueueTime)
+		peerData := data
+		compress := wn.compressor != nil && peer.compressedMessages
+		if peer.wantsTxnAnnouncements() {
+			if announcedData == nil {
+				announcedData = wn.announceTxnGroups(request.tags, data)
+			}
+			peerData = announcedData
+			if compress {
+				// the other messages of the broadcast are still worth compressing.
+				if compressedAnnouncedData == nil {
+					compressedAnnouncedData = wn.compressor.compressAll(announcedData)
+				}
+				peerData = compressedAnnouncedData
+			}
+		} else if compress {
+			if compressedData == nil {
+				compressedData = wn.compressor.compressAll(data)
+			}
+			peerData = compressedData
+		}
+		ok := peer.writeNonBlockMsgs(request.ctx, peerData, prio, digests, request.enqueueTime)
 		if ok {
 			sentMessageCount++
 			continue
@@ -1590,6 +1838,9 @@ func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
 			// filter out self-public address, so we won't try to connect to outselves.
 			continue
 		}
+		if wn.peerBans.isBanned(time.Now(), na) {
+			continue
+		}
 		gossipAddr, ok := wn.tryConnectReserveAddr(na)
 		if ok {
 			wn.wg.Add(1)
@@ -1866,14 +2117,15 @@ const ProtocolVersionHeader = "X-Algorand-Version"
 const ProtocolAcceptVersionHeader = "X-Algorand-Accept-Version"
 
 // SupportedProtocolVersions contains the list of supported protocol versions by this node ( in order of preference ).
-var SupportedProtocolVersions = []string{"2.1"}
+var SupportedProtocolVersions = []string{"2.2", "2.1"}
 
 // ProtocolVersion is the current version attached to the ProtocolVersionHeader header
 /* Version history:
  *  1   Catchup service over websocket connections with unicast messages between peers
  *  2.1 Introduced topic key/data pairs and enabled services over the gossip connections
+ *  2.2 Introduced compressed messages
  */
-const ProtocolVersion = "2.1"
+const ProtocolVersion = "2.2"
 
 // TelemetryIDHeader HTTP header for telemetry-id for logging
 const TelemetryIDHeader = "X-Algorand-TelId"
@@ -2047,6 +2299,19 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
+	var identityHandshake *identityHandshake
+	if wn.identity != nil {
+		var err error
+		identityHandshake, err = makeIdentityHandshake(wn.identity)
+		if err != nil {
+			wn.log.Warnf("ws connect(%s) fail: %v", gossipAddr, err)
+			return
+		}
+		identityHandshake.setHeaders(requestHeader)
+	} else if wn.identityAllowlist != nil {
+		// without a node identity, the allowed relays can't be told apart from the others.
+		return
+	}
 	var websocketDialer = websocket.Dialer{
 		Proxy:             http.ProxyFromEnvironment,
 		HandshakeTimeout:  45 * time.Second,
@@ -2055,6 +2320,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		NetDial:           wn.dialer.Dial,
 	}
 
+	dialStart := time.Now()
 	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
 	if err != nil {
 		if err == websocket.ErrBadHandshake {
@@ -2082,12 +2348,15 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 					retryAfterTime := time.Now().Add(time.Duration(retryAfter) * time.Second)
 					wn.phonebook.UpdateRetryAfter(addr, retryAfterTime)
 				}
+				// the relay is busy rather than unreliable, so this doesn't count as a failure.
+				return
 			default:
 				wn.log.Warnf("ws connect(%s) fail - bad handshake, Status code = %d, Headers = %#v, Body = %s", gossipAddr, response.StatusCode, response.Header, errString)
 			}
 		} else {
 			wn.log.Warnf("ws connect(%s) fail: %s", gossipAddr, err)
 		}
+		wn.phonebook.ReportConnection(addr, false, 0)
 		return
 	}
 
@@ -2096,8 +2365,21 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	responseHeaderOk, matchingVersion := wn.checkServerResponseVariables(response.Header, gossipAddr)
 	if !responseHeaderOk {
 		// The error was already logged, so no need to log again.
+		wn.phonebook.ReportConnection(addr, false, 0)
 		return
 	}
+	peerConn, err := wn.completeOutgoingIdentity(conn, identityHandshake, response.Header)
+	if err != nil {
+		wn.log.Warnf("ws connect(%s) fail - identity handshake: %v", gossipAddr, err)
+		networkIdentityHandshakeFailures.Inc(nil)
+		conn.Close()
+		if err == errIdentityProofInvalid {
+			wn.phonebook.ReportMisbehavior(addr)
+		}
+		wn.phonebook.ReportConnection(addr, false, 0)
+		return
+	}
+	wn.phonebook.ReportConnection(addr, true, time.Since(dialStart))
 
 	throttledConnection := false
 	if atomic.AddInt32(&wn.throttledOutgoingConnections, int32(-1)) >= 0 {
@@ -2108,7 +2390,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 
 	peer := &wsPeer{
 		wsPeerCore:                  makePeerCore(wn, addr, wn.GetRoundTripper(), "" /* origin */),
-		conn:                        conn,
+		conn:                        peerConn,
 		outgoing:                    true,
 		incomingMsgFilter:           wn.incomingMsgFilter,
 		createTime:                  time.Now(),
@@ -2116,6 +2398,9 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		throttledOutgoingConnection: throttledConnection,
 		version:                     matchingVersion,
 	}
+	if _, encrypted := peerConn.(*encryptedConn); encrypted {
+		peer.identity = identityHandshake.peerIdentity
+	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
@@ -2130,6 +2415,17 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 			Endpoint:     peer.GetAddress(),
 		})
 
+	// the messages of interest are sent over the outgoing connections as well, as the relays we connect to can't
+	// know otherwise whether to announce the transaction groups rather than send them in full, nor whether to
+	// share their peers. These are only encoded once any tag is registered, and list the defaultSendMessageTags
+	// along with the registered tags, so they never keep the relays from sending the messages they send by default.
+	if wn.messagesOfInterestEnc != nil {
+		err = peer.Unicast(wn.ctx, wn.messagesOfInterestEnc, protocol.MsgOfInterestTag)
+		if err != nil {
+			wn.log.Infof("ws send msgOfInterest: %v", err)
+		}
+	}
+
 	peers.Set(float64(wn.NumPeers()), nil)
 	outgoingPeers.Set(float64(wn.numOutgoingPeers()), nil)
 
@@ -2269,6 +2565,8 @@ func (wn *WebsocketNetwork) addPeer(peer *wsPeer) {
 			return
 		}
 	}
+	wn.lastPeerID++
+	peer.peerID = wn.lastPeerID
 	heap.Push(peersHeap{wn}, peer)
 	wn.prioTracker.setPriority(peer, peer.prioAddress, peer.prioWeight)
 	atomic.AddInt32(&wn.peersChangeCounter, 1)
@@ -24,8 +24,10 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"strings"
@@ -335,6 +337,471 @@ func TestWebsocketPeerData(t *testing.T) {
 	require.Equal(t, nil, netA.GetPeerData(peerB, "foo"))
 }
 
+// Check that the peers are reported along with their traffic, and that the operator can disconnect and ban them
+func TestWebsocketNetworkPeerManagement(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer func() { t.Log("stopping A"); netA.Stop(); t.Log("A done") }()
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer func() { t.Log("stopping B"); netB.Stop(); t.Log("B done") }()
+	counter := newMessageCounter(t, 1)
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), false, nil)
+	select {
+	case <-counter.done:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timeout, count=%d, wanted 1", counter.count)
+	}
+
+	peersB := netB.PeersInfo()
+	require.Len(t, peersB, 1)
+	require.True(t, peersB[0].Outgoing)
+	require.Equal(t, addrA, peersB[0].Address)
+	require.Equal(t, TagTraffic{BytesReceived: 5, MessagesReceived: 1}, peersB[0].Traffic[protocol.TxnTag])
+
+	var peersA []PeerInfo
+	require.Eventually(t, func() bool {
+		peersA = netA.PeersInfo()
+		return len(peersA) == 1 && peersA[0].Traffic[protocol.TxnTag].MessagesSent == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	require.False(t, peersA[0].Outgoing)
+	require.NotEmpty(t, peersA[0].RemoteHost)
+	require.NotZero(t, peersA[0].ID)
+
+	require.False(t, netA.DisconnectPeer(peersA[0].ID+1))
+	require.True(t, netA.DisconnectPeer(peersA[0].ID))
+	require.Empty(t, netA.PeersInfo())
+
+	// banning the remote host refuses any further incoming connection from it
+	require.Equal(t, 0, netA.BanPeer(peersA[0].RemoteHost, time.Minute))
+	require.Equal(t, []string{peersA[0].RemoteHost}, banAddresses(netA.BannedPeers()))
+	response := httptest.NewRecorder()
+	status := netA.checkIncomingConnectionLimits(response, nil, peersA[0].RemoteHost, "", "")
+	require.Equal(t, http.StatusForbidden, status)
+	require.Equal(t, http.StatusForbidden, response.Code)
+
+	require.True(t, netA.UnbanPeer(peersA[0].RemoteHost))
+	require.False(t, netA.UnbanPeer(peersA[0].RemoteHost))
+	require.Empty(t, netA.BannedPeers())
+	status = netA.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, peersA[0].RemoteHost, "", "")
+	require.Equal(t, http.StatusOK, status)
+
+	// banning the relay address disconnects it, and removes it from the phonebook addresses
+	require.Eventually(t, func() bool { return len(netB.PeersInfo()) == 0 }, 2*time.Second, 10*time.Millisecond)
+	netB.RequestConnectOutgoing(false, nil)
+	require.Eventually(t, func() bool { return len(netB.PeersInfo()) == 1 }, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, netB.BanPeer(addrA, time.Minute))
+	require.Empty(t, netB.PeersInfo())
+	require.Equal(t, []string{strings.TrimPrefix(addrA, "http://")}, banAddresses(netB.BannedPeers()))
+	require.Empty(t, netB.GetPeers(PeersPhonebookRelays))
+
+	require.True(t, netB.UnbanPeer(addrA))
+	require.Len(t, netB.GetPeers(PeersPhonebookRelays), 1)
+
+	// lifting a missing ban leaves the retry-after set by the relay itself in place
+	netB.phonebook.UpdateRetryAfter(addrA, time.Now().Add(time.Minute))
+	require.False(t, netB.UnbanPeer(addrA))
+	require.Empty(t, netB.GetPeers(PeersPhonebookRelays))
+}
+
+func banAddresses(bans []PeerBan) []string {
+	addrs := make([]string, len(bans))
+	for i, ban := range bans {
+		addrs[i] = ban.Address
+	}
+	return addrs
+}
+
+// Check that the transactions are compressed when both peers support compressed messages, and sent as is otherwise
+func TestWebsocketNetworkCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// note - this test changes the SupportedProtocolVersions global variable ( SupportedProtocolVersions ) and therefore cannot be parallelized.
+	originalSupportedProtocolVersions := SupportedProtocolVersions
+	defer func() {
+		SupportedProtocolVersions = originalSupportedProtocolVersions
+	}()
+
+	payload := makeTestTxnMessage(32)
+	for _, versions := range [][]string{{"2.2", "2.1"}, {"2.1"}} {
+		SupportedProtocolVersions = versions
+		compressed := versions[0] == compressedMessagesProtocolVersion
+
+		netA := makeTestWebsocketNode(t)
+		netA.config.GossipFanout = 1
+		netA.Start()
+		netB := makeTestWebsocketNode(t)
+		netB.config.GossipFanout = 1
+		addrA, postListen := netA.Address()
+		require.True(t, postListen)
+		netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+		netB.Start()
+
+		received := make(chan []byte, 1)
+		netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			received <- msg.Data
+			return OutgoingMessage{}
+		})}})
+
+		readyTimeout := time.NewTimer(2 * time.Second)
+		waitReady(t, netA, readyTimeout.C)
+		waitReady(t, netB, readyTimeout.C)
+
+		netA.Broadcast(context.Background(), protocol.TxnTag, payload, false, nil)
+		select {
+		case data := <-received:
+			require.Equal(t, payload, data)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timeout waiting for the transactions, versions=%v", versions)
+		}
+
+		peersB := netB.PeersInfo()
+		require.Len(t, peersB, 1)
+		require.Equal(t, versions[0], peersB[0].Version)
+		traffic := peersB[0].Traffic[protocol.TxnTag]
+		require.Equal(t, uint64(1), traffic.MessagesReceived)
+		if compressed {
+			require.Less(t, traffic.BytesReceived, uint64(len(payload)))
+		} else {
+			require.Equal(t, uint64(len(payload)+2), traffic.BytesReceived)
+		}
+
+		netB.Stop()
+		netA.Stop()
+	}
+}
+
+// Check that a node asking for transaction announcements gets the transaction groups announced, and fetches them once
+func TestWebsocketNetworkTxnAnnouncements(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer func() { t.Log("stopping A"); netA.Stop(); t.Log("A done") }()
+	bConfig := defaultConfig
+	bConfig.GossipFanout = 1
+	bConfig.EnableTxnAnnouncements = true
+	netB := makeTestWebsocketNodeWithConfig(t, bConfig)
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer func() { t.Log("stopping B"); netB.Stop(); t.Log("B done") }()
+
+	received := make(chan []byte, 2)
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		received <- msg.Data
+		return OutgoingMessage{}
+	})}})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	// B lets A know about its messages of interest over its outgoing connection.
+	require.Eventually(t, func() bool {
+		peers := netA.GetPeers(PeersConnectedIn)
+		return len(peers) == 1 && peers[0].(*wsPeer).wantsTxnAnnouncements()
+	}, 2*time.Second, 10*time.Millisecond)
+
+	payload := makeTestTxnMessage(1)
+	netA.Broadcast(context.Background(), protocol.TxnTag, payload, false, nil)
+	select {
+	case data := <-received:
+		require.Equal(t, payload, data)
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timeout waiting for the transaction group")
+	}
+
+	// announcing the same group again doesn't get it requested again.
+	netA.Broadcast(context.Background(), protocol.TxnTag, payload, false, nil)
+	require.Eventually(t, func() bool {
+		return netB.PeersInfo()[0].Traffic[protocol.TxnAnnounceTag].MessagesReceived == 2
+	}, 2*time.Second, 10*time.Millisecond)
+	select {
+	case <-received:
+		t.Fatalf("transaction group was fetched twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	traffic := netB.PeersInfo()[0].Traffic
+	require.Equal(t, uint64(2*(crypto.DigestSize+2)), traffic[protocol.TxnAnnounceTag].BytesReceived)
+	require.Equal(t, uint64(1), traffic[protocol.TxnRequestTag].MessagesSent)
+	require.Equal(t, uint64(1), traffic[protocol.TxnTag].MessagesReceived)
+
+	// the other messages broadcast to a peer asking for announcements are still compressed.
+	proposal := makeTestTxnMessage(32)
+	netA.Broadcast(context.Background(), protocol.ProposalPayloadTag, proposal, false, nil)
+	require.Eventually(t, func() bool {
+		return netB.PeersInfo()[0].Traffic[protocol.ProposalPayloadTag].MessagesReceived == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Less(t, netB.PeersInfo()[0].Traffic[protocol.ProposalPayloadTag].BytesReceived, uint64(len(proposal)))
+}
+
+// Check that a node sends its messages of interest over its outgoing connections only if it registered any,
+// and that these only extend the messages the relay sends by default.
+func TestWebsocketNetworkOutgoingMessagesOfInterest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, announcements := range []bool{false, true} {
+		netA := makeTestWebsocketNode(t)
+		netA.config.GossipFanout = 1
+		netA.Start()
+		bConfig := defaultConfig
+		bConfig.GossipFanout = 1
+		bConfig.EnableTxnAnnouncements = announcements
+		// a non-relay node, which only has outgoing connections.
+		bConfig.NetAddress = ""
+		netB := makeTestWebsocketNodeWithConfig(t, bConfig)
+		addrA, postListen := netA.Address()
+		require.True(t, postListen)
+		netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+		netB.Start()
+
+		readyTimeout := time.NewTimer(2 * time.Second)
+		waitReady(t, netA, readyTimeout.C)
+		waitReady(t, netB, readyTimeout.C)
+
+		if announcements {
+			require.Eventually(t, func() bool {
+				peers := netA.GetPeers(PeersConnectedIn)
+				return len(peers) == 1 && peers[0].(*wsPeer).wantsTxnAnnouncements()
+			}, 2*time.Second, 10*time.Millisecond)
+			require.Equal(t, uint64(1), netB.PeersInfo()[0].Traffic[protocol.MsgOfInterestTag].MessagesSent)
+
+			expectedTags := map[protocol.Tag]bool{protocol.TxnAnnounceTag: true}
+			for tag, flag := range defaultSendMessageTags {
+				expectedTags[tag] = flag
+			}
+			sentTags, err := unmarshallMessageOfInterest(netB.messagesOfInterestEnc)
+			require.NoError(t, err)
+			require.Equal(t, expectedTags, sentTags)
+		} else {
+			require.Nil(t, netB.messagesOfInterestEnc)
+			require.Zero(t, netB.PeersInfo()[0].Traffic[protocol.MsgOfInterestTag].MessagesSent)
+			require.False(t, netA.GetPeers(PeersConnectedIn)[0].(*wsPeer).wantsTxnAnnouncements())
+		}
+
+		netB.Stop()
+		netA.Stop()
+	}
+}
+
+// Check that a relay shares the relay addresses with the peers asking for these, and that the phonebook is kept across restarts
+func TestWebsocketNetworkPeerExchange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	aConfig := defaultConfig
+	aConfig.GossipFanout = 1
+	aConfig.EnablePeerExchange = true
+	aConfig.PublicAddress = "r1.example.com:4160"
+	netA := makeTestWebsocketNodeWithConfig(t, aConfig)
+	netA.Start()
+	defer func() { t.Log("stopping A"); netA.Stop(); t.Log("A done") }()
+
+	bConfig := defaultConfig
+	bConfig.GossipFanout = 1
+	bConfig.EnablePeerExchange = true
+	netB := makeTestWebsocketNodeWithConfig(t, bConfig)
+	phonebookFile := filepath.Join(t.TempDir(), "peers.json")
+	netB.SetPhonebookFile(phonebookFile)
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	require.Eventually(t, func() bool {
+		return len(netB.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole)) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+	require.ElementsMatch(t, []string{addrA, aConfig.PublicAddress}, netB.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+
+	// the relay ignores the addresses shared by its incoming peers.
+	require.Eventually(t, func() bool {
+		return netA.PeersInfo()[0].Traffic[protocol.PeerExchangeTag].MessagesReceived > 0
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Empty(t, netA.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+
+	netB.Stop()
+	_, err := os.Stat(phonebookFile)
+	require.NoError(t, err)
+
+	// a restarted node starts with the saved addresses, along with their scores, leaving out the shared
+	// addresses it never connected to.
+	netC := makeTestWebsocketNodeWithConfig(t, bConfig)
+	netC.SetPhonebookFile(phonebookFile)
+	netC.loadPhonebook()
+	require.Equal(t, []string{addrA}, netC.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+	require.Equal(t, uint32(1), netC.phonebook.(*phonebookImpl).data[addrA].score.Successes)
+}
+
+// Check that the addresses shared by the relays are dropped once stale, or to make room for new ones once
+// weighted below the addresses without history.
+func TestExchangedPeersEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	wn := makeTestWebsocketNode(t)
+	now := time.Now()
+	addrs := make([]string, maxExchangedPeers)
+	for i := range addrs {
+		addrs[i] = fmt.Sprintf("r%d.example.com:4160", i)
+	}
+	wn.addExchangedPeers(addrs, now)
+	require.Len(t, wn.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole), maxExchangedPeers)
+
+	// there is no room for new addresses while the others have no history.
+	wn.addExchangedPeers([]string{"new1.example.com:4160"}, now)
+	require.NotContains(t, wn.exchangedPeers, "new1.example.com:4160")
+
+	wn.phonebook.ReportConnection(addrs[0], true, time.Millisecond)
+	wn.phonebook.ReportConnection(addrs[1], false, 0)
+	wn.addExchangedPeers([]string{"new1.example.com:4160", "new2.example.com:4160"}, now)
+	require.Contains(t, wn.exchangedPeers, "new1.example.com:4160")
+	require.NotContains(t, wn.exchangedPeers, "new2.example.com:4160")
+	require.NotContains(t, wn.exchangedPeers, addrs[1])
+	require.Zero(t, wn.phonebook.Weight(addrs[1]))
+	require.Len(t, wn.exchangedPeers, maxExchangedPeers)
+
+	// the addresses which aren't shared again are dropped once stale.
+	later := now.Add(exchangedPeerMaxAge + time.Second)
+	wn.addExchangedPeers([]string{addrs[0]}, later)
+	require.Equal(t, []string{addrs[0]}, wn.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+	require.Equal(t, uint32(1), wn.phonebook.(*phonebookImpl).data[addrs[0]].score.Successes)
+}
+
+func TestWebsocketNetworkIdentity(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	identityA := makeTestIdentity()
+	identityB := makeTestIdentity()
+	identityC := makeTestIdentity()
+
+	aConfig := defaultConfig
+	aConfig.GossipFanout = 1
+	aConfig.GossipIdentityAllowlist = IdentityString(identityB.SignatureVerifier) + "," + IdentityString(identityC.SignatureVerifier)
+	netA := makeTestWebsocketNodeWithConfig(t, aConfig)
+	netA.SetIdentity(identityA)
+	netA.Start()
+	defer func() { t.Log("stopping A"); netA.Stop(); t.Log("A done") }()
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+
+	bConfig := defaultConfig
+	bConfig.GossipFanout = 1
+	bConfig.GossipIdentityAllowlist = IdentityString(identityA.SignatureVerifier)
+	netB := makeTestWebsocketNodeWithConfig(t, bConfig)
+	netB.SetIdentity(identityB)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer func() { t.Log("stopping B"); netB.Stop(); t.Log("B done") }()
+
+	counter := newMessageCounter(t, 1)
+	counterDone := counter.done
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	// the connection is authenticated on both sides, and its traffic encrypted
+	peerA := netB.peers[0]
+	peerB := netA.peers[0]
+	require.IsType(t, &encryptedConn{}, peerA.conn)
+	require.IsType(t, &encryptedConn{}, peerB.conn)
+	require.Equal(t, identityA.SignatureVerifier, peerA.identity)
+	require.Equal(t, identityB.SignatureVerifier, peerB.identity)
+	require.Equal(t, IdentityString(identityB.SignatureVerifier), peerB.info(time.Now()).Identity)
+
+	netB.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), false, nil)
+	select {
+	case <-counterDone:
+	case <-time.After(2 * time.Second):
+		t.Errorf("timeout, count=%d, wanted 1", counter.count)
+	}
+
+	// nodes without an allowed identity are refused
+	netNoIdentity := makeTestWebsocketNodeWithConfig(t, defaultConfig)
+	netOtherIdentity := makeTestWebsocketNodeWithConfig(t, defaultConfig)
+	netOtherIdentity.SetIdentity(makeTestIdentity())
+	// and so are the relays which don't have the expected identity
+	cConfig := defaultConfig
+	cConfig.GossipIdentityAllowlist = IdentityString(identityB.SignatureVerifier)
+	netC := makeTestWebsocketNodeWithConfig(t, cConfig)
+	netC.SetIdentity(identityC)
+	for _, net := range []*WebsocketNetwork{netNoIdentity, netOtherIdentity, netC} {
+		net.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+		net.Start()
+		pb := net.phonebook.(*phonebookImpl)
+		require.Eventually(t, func() bool {
+			pb.lock.RLock()
+			defer pb.lock.RUnlock()
+			return pb.data[addrA].score.Failures > 0
+		}, 2*time.Second, 10*time.Millisecond)
+		require.Equal(t, 0, net.NumPeers())
+		net.Stop()
+	}
+	require.Equal(t, 1, netA.NumPeers())
+}
+
+func TestWebsocketNetworkRateLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	aConfig := defaultConfig
+	aConfig.GossipFanout = 1
+	aConfig.IncomingMessageRateLimits = "TX:1:2"
+	aConfig.IncomingRateLimitDisconnectSeconds = 1
+	netA := makeTestWebsocketNodeWithConfig(t, aConfig)
+	netA.Start()
+	defer func() { t.Log("stopping A"); netA.Stop(); t.Log("A done") }()
+	counter := newMessageCounter(t, 3)
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+
+	netB := makeTestWebsocketNodeWithConfig(t, defaultConfig)
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer func() { t.Log("stopping B"); netB.Stop(); t.Log("B done") }()
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	// only the burst goes through, and the peer flooding the relay ends up being disconnected
+	peerB := netA.peers[0]
+	var sent int
+	require.Eventually(t, func() bool {
+		netB.Broadcast(context.Background(), protocol.TxnTag, []byte(fmt.Sprintf("txn %d", sent)), false, nil)
+		sent++
+		netA.peersLock.RLock()
+		defer netA.peersLock.RUnlock()
+		for _, peer := range netA.peers {
+			if peer == peerB {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Less(t, counter.Count(), sent)
+}
+
 // Test sending array of messages
 func TestWebsocketNetworkArray(t *testing.T) {
 	partitiontest.PartitionTest(t)
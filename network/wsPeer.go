@@ -77,6 +77,7 @@ var defaultSendMessageTags = map[protocol.Tag]bool{
 	protocol.TopicMsgRespTag:    true,
 	protocol.MsgOfInterestTag:   true,
 	protocol.TxnTag:             true,
+	protocol.TxnRequestTag:      true,
 	protocol.UniCatchupReqTag:   true,
 	protocol.UniEnsBlockReqTag:  true,
 	protocol.VoteBundleTag:      true,
@@ -122,6 +123,9 @@ const disconnectLeastPerformingPeer disconnectReason = "LeastPerformingPeer"
 const disconnectCliqueResolve disconnectReason = "CliqueResolving"
 const disconnectRequestReceived disconnectReason = "DisconnectRequest"
 const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
+const disconnectOperatorRequest disconnectReason = "OperatorRequest"
+const disconnectBanned disconnectReason = "Banned"
+const disconnectRateLimited disconnectReason = "RateLimited"
 
 // Response is the structure holding the response from the server
 type Response struct {
@@ -191,9 +195,28 @@ type wsPeer struct {
 	// createTime is the time at which the connection was established with the peer.
 	createTime time.Time
 
+	// peerID identifies the connection; it's assigned by the network once the peer is added.
+	peerID uint64
+
+	// traffic counts the bytes and messages exchanged with the peer, per message tag.
+	traffic peerTrafficCounters
+
 	// peer version ( this is one of the version supported by the current node and listed in SupportedProtocolVersions )
 	version string
 
+	// identity is the node identity the peer authenticated with; it's zero when the connection isn't authenticated.
+	identity crypto.PublicKey
+
+	// rateLimiter rate limits the messages received from the peer; it's nil when these aren't rate limited.
+	rateLimiter *peerRateLimiter
+
+	// compressedMessages is set when the negotiated version allows sending compressed messages to the peer.
+	compressedMessages bool
+
+	// txnAnnouncements is set to 1 when the peer asked, through its messages of interest, to get the transaction
+	// groups announced rather than sent in full. It's accessed atomically.
+	txnAnnouncements int32
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -283,6 +306,11 @@ func (wp *wsPeer) Unicast(ctx context.Context, msg []byte, tag protocol.Tag) err
 	if tag != protocol.MsgDigestSkipTag && len(msg) >= messageFilterSize {
 		digest = crypto.Hash(mbytes)
 	}
+	if wp.compressedMessages && wp.net.compressor != nil {
+		if compressed := wp.net.compressor.compress(mbytes); compressed != nil {
+			mbytes = compressed
+		}
+	}
 
 	ok := wp.writeNonBlock(ctx, mbytes, false, digest, time.Now())
 	if !ok {
@@ -337,6 +365,7 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 	wp.responseChannels = make(map[uint64]chan *Response)
 	wp.sendMessageTag = defaultSendMessageTags
 	wp.clientDataStore = make(map[string]interface{})
+	wp.compressedMessages = versionAtLeast(wp.version, compressedMessagesProtocolVersion)
 
 	// processed is a channel that messageHandlerThread writes to
 	// when it's done with one of our messages, so that we can queue
@@ -351,6 +380,10 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 		wp.outgoingMsgFilter = makeMessageFilter(config.OutgoingMessageFilterBucketCount, config.OutgoingMessageFilterBucketSize)
 	}
 
+	if !wp.outgoing && len(wp.net.incomingRateLimits) > 0 {
+		wp.rateLimiter = makePeerRateLimiter(wp.net.incomingRateLimits, time.Duration(config.IncomingRateLimitDisconnectSeconds)*time.Second)
+	}
+
 	wp.wg.Add(2)
 	go wp.readLoop()
 	go wp.writeLoop()
@@ -423,12 +456,47 @@ func (wp *wsPeer) readLoop() {
 		msg.Data = slurper.Bytes()
 		msg.Net = wp.net
 		atomic.StoreInt64(&wp.lastPacketTime, msg.Received)
-		networkReceivedBytesTotal.AddUint64(uint64(len(msg.Data)+2), nil)
+		// the traffic is accounted for with the size of the message on the wire, and with the tag of the original message.
+		receivedBytes := len(msg.Data) + 2
+		if msg.Tag == protocol.CompressedMsgTag {
+			msg.Tag, msg.Data, err = decompressMessage(msg.Data)
+			if err != nil {
+				wp.net.log.Warnf("wsPeer readLoop: could not decompress the message from: %s %s", wp.conn.RemoteAddr().String(), err)
+				networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "protocol"})
+				return
+			}
+		}
+		networkReceivedBytesTotal.AddUint64(uint64(receivedBytes), nil)
 		networkMessageReceivedTotal.AddUint64(1, nil)
-		networkReceivedBytesByTag.Add(string(tag[:]), uint64(len(msg.Data)+2))
-		networkMessageReceivedByTag.Add(string(tag[:]), 1)
+		networkReceivedBytesByTag.Add(string(msg.Tag), uint64(receivedBytes))
+		networkMessageReceivedByTag.Add(string(msg.Tag), 1)
+		wp.traffic.received(msg.Tag, receivedBytes)
 		msg.Sender = wp
 
+		if wp.rateLimiter != nil {
+			delay, drop, disconnect := wp.rateLimiter.admit(msg.Tag, time.Now())
+			if disconnect {
+				wp.net.log.Infof("wsPeer readLoop: disconnecting %s for persistently exceeding its rate limits", wp.conn.RemoteAddr().String())
+				networkRateLimitDisconnects.Inc(nil)
+				cleanupCloseError = disconnectRateLimited
+				return
+			}
+			if drop {
+				networkRateLimitedMessagesByTag.Add(string(msg.Tag), 1)
+				continue
+			}
+			if delay > 0 {
+				// holding the read loop stops reading from the connection, which pushes back on the peer.
+				select {
+				case <-time.After(delay):
+				case <-wp.closing:
+					wp.net.log.Debugf("peer closing %s", wp.conn.RemoteAddr().String())
+					return
+				}
+				networkRateLimitDelayMicros.AddUint64(uint64(delay/time.Microsecond), nil)
+			}
+		}
+
 		// for outgoing connections, we want to notify the connection monitor that we've received
 		// a message. The connection monitor would update it's statistics accordingly.
 		if wp.connMonitor != nil {
@@ -471,6 +539,14 @@ func (wp *wsPeer) readLoop() {
 			// network maintenance message handled immediately instead of handing off to general handlers
 			wp.handleFilterMessage(msg)
 			continue
+		case protocol.TxnAnnounceTag:
+			wp.handleTxnAnnouncement(msg)
+			continue
+		case protocol.TxnRequestTag:
+			wp.handleTxnRequest(msg)
+			continue
+		case protocol.TxnTag:
+			wp.net.txnAnnouncements.markSeen(generateMessageDigest(msg.Tag, msg.Data))
 		}
 		if len(msg.Data) > 0 && wp.incomingMsgFilter != nil && dedupSafeTag(msg.Tag) {
 			if wp.incomingMsgFilter.CheckIncomingMessage(msg.Tag, msg.Data, true, true) {
@@ -501,6 +577,11 @@ func (wp *wsPeer) readLoop() {
 	}
 }
 
+// wantsTxnAnnouncements returns whether the peer asked to get the transaction groups announced rather than sent in full.
+func (wp *wsPeer) wantsTxnAnnouncements() bool {
+	return atomic.LoadInt32(&wp.txnAnnouncements) != 0
+}
+
 func (wp *wsPeer) handleMessageOfInterest(msg IncomingMessage) (shutdown bool) {
 	shutdown = false
 	// decode the message, and ensure it's a valid message.
@@ -509,6 +590,21 @@ func (wp *wsPeer) handleMessageOfInterest(msg IncomingMessage) (shutdown bool) {
 		wp.net.log.Warnf("wsPeer handleMessageOfInterest: could not unmarshall message from: %s %v", wp.conn.RemoteAddr().String(), err)
 		return
 	}
+	var txnAnnouncements int32
+	if msgTagsMap[protocol.TxnAnnounceTag] {
+		txnAnnouncements = 1
+	}
+	atomic.StoreInt32(&wp.txnAnnouncements, txnAnnouncements)
+
+	if msgTagsMap[protocol.PeerExchangeTag] && wp.net.relayMessages && wp.net.config.EnablePeerExchange {
+		// the peer exchange message is sent only once the new message filter is queued, as it would be filtered out otherwise.
+		defer func() {
+			if !shutdown {
+				wp.net.sendPeerExchange(wp)
+			}
+		}()
+	}
+
 	msgs := make([]sendMessage, 1, 1)
 	msgs[0] = sendMessage{
 		data:         nil,
@@ -591,6 +687,10 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	}
 	// the tags are always 2 char long; note that this is safe since it's only being used for messages that we have generated locally.
 	tag := protocol.Tag(msg.data[:2])
+	if tag == protocol.CompressedMsgTag {
+		// compressed messages are filtered and accounted for by the tag of the original message.
+		tag = protocol.Tag(msg.data[2:4])
+	}
 	if !wp.sendMessageTag[tag] {
 		// the peer isn't interested in this message.
 		return disconnectReasonNone
@@ -618,6 +718,7 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
 	networkMessageSentTotal.AddUint64(1, nil)
 	networkMessageSentByTag.Add(string(tag), 1)
+	wp.traffic.sent(tag, len(msg.data))
 	networkMessageQueueMicrosTotal.AddUint64(uint64(time.Now().Sub(msg.peerEnqueued).Nanoseconds()/1000), nil)
 	return disconnectReasonNone
 }
@@ -673,7 +774,8 @@ func (wp *wsPeer) writeNonBlock(ctx context.Context, data []byte, highPrio bool,
 func (wp *wsPeer) writeNonBlockMsgs(ctx context.Context, data [][]byte, highPrio bool, digest []crypto.Digest, msgEnqueueTime time.Time) bool {
 	includeIndices := make([]int, 0, len(data))
 	for i := range data {
-		if wp.outgoingMsgFilter != nil && len(data[i]) > messageFilterSize && wp.outgoingMsgFilter.CheckDigest(digest[i], false, false) {
+		// the digests are only calculated for messages longer than messageFilterSize, before these get compressed.
+		if wp.outgoingMsgFilter != nil && !digest[i].IsZero() && wp.outgoingMsgFilter.CheckDigest(digest[i], false, false) {
 			//wp.net.log.Debugf("msg drop as outbound dup %s(%d) %v", string(data[:2]), len(data)-2, digest)
 			// peer has notified us it doesn't need this message
 			outgoingNetworkMessageFilteredOutTotal.Inc(nil)
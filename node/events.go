@@ -0,0 +1,322 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// EventType identifies the kind of an event streamed by the node.
+type EventType string
+
+const (
+	// BlockEvent is sent once a block is committed, after the events of its transactions.
+	BlockEvent EventType = "block"
+	// TransactionEvent is sent for each transaction of a committed block.
+	TransactionEvent EventType = "transaction"
+	// PendingTransactionEvent is sent for each transaction admitted to the transaction pool.
+	PendingTransactionEvent EventType = "pending-transaction"
+)
+
+// errEventHubClosed is returned when subscribing to the events of a node which is shutting down.
+var errEventHubClosed = errors.New("the node is shutting down")
+
+// eventSubscriptionBacklog is the number of events buffered for a subscriber
+// before it is considered too slow and gets disconnected.  Subscribers replay
+// the committed rounds from the ledger before subscribing, so the backlog only
+// needs to hold the events of the blocks committed while they process them.
+const eventSubscriptionBacklog = 4096
+
+// Event is a notification about a committed block, one of its transactions, or
+// a transaction admitted to the transaction pool.
+type Event struct {
+	Type EventType
+
+	// Round is the round of the block, and zero for pending transactions.
+	Round basics.Round
+
+	// Header is set for block events.
+	Header bookkeeping.BlockHeader
+
+	// Txn is set for transaction and pending transaction events.
+	Txn transactions.SignedTxnWithAD
+}
+
+// EventFilter selects the events delivered to a subscription. A transaction
+// matches when it involves one of the addresses, one of the applications and one
+// of the assets of the filter; empty lists match every transaction.
+type EventFilter struct {
+	// Types holds the requested event types, or nil for all of them.
+	Types map[EventType]bool
+
+	Addresses      []basics.Address
+	ApplicationIDs []basics.AppIndex
+	AssetIDs       []basics.AssetIndex
+}
+
+// MatchType checks whether events of the given type were requested.
+func (f EventFilter) MatchType(t EventType) bool {
+	return len(f.Types) == 0 || f.Types[t]
+}
+
+// MatchTxn checks whether the transaction, or one of its inner transactions, matches the filter.
+func (f EventFilter) MatchTxn(stxn transactions.SignedTxnWithAD) bool {
+	if f.matchAddress(stxn) && f.matchApplication(stxn) && f.matchAsset(stxn) {
+		return true
+	}
+	for _, inner := range stxn.ApplyData.EvalDelta.InnerTxns {
+		if f.MatchTxn(inner) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f EventFilter) matchAddress(stxn transactions.SignedTxnWithAD) bool {
+	if len(f.Addresses) == 0 {
+		return true
+	}
+
+	// MatchAddress uses this to check FeeSink, we don't care about that here.
+	spec := transactions.SpecialAddresses{}
+	txn := stxn.Txn
+	for _, addr := range f.Addresses {
+		if txn.MatchAddress(addr, spec) || txn.FreezeAccount == addr {
+			return true
+		}
+		for _, acct := range txn.Accounts {
+			if acct == addr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f EventFilter) matchApplication(stxn transactions.SignedTxnWithAD) bool {
+	if len(f.ApplicationIDs) == 0 {
+		return true
+	}
+	for _, appID := range f.ApplicationIDs {
+		if stxn.Txn.ApplicationID == appID || stxn.ApplyData.ApplicationID == appID {
+			return true
+		}
+	}
+	return false
+}
+
+func (f EventFilter) matchAsset(stxn transactions.SignedTxnWithAD) bool {
+	if len(f.AssetIDs) == 0 {
+		return true
+	}
+	txn := stxn.Txn
+	for _, assetID := range f.AssetIDs {
+		if txn.XferAsset == assetID || txn.ConfigAsset == assetID || txn.FreezeAsset == assetID || stxn.ApplyData.ConfigAsset == assetID {
+			return true
+		}
+	}
+	return false
+}
+
+// EventSubscription receives the events selected by its filter. Block events are
+// always delivered, so that subscribers can keep track of the committed rounds.
+type EventSubscription struct {
+	filter EventFilter
+	events chan Event
+	hub    *eventHub
+
+	// lagged is set before events is closed when the subscriber fell behind.
+	lagged bool
+}
+
+// Events returns the channel on which the events are delivered. The channel is
+// closed when the subscription is closed, or when the subscriber falls too far behind.
+func (s *EventSubscription) Events() <-chan Event {
+	return s.events
+}
+
+// Lagged returns true if the events channel was closed because the subscriber
+// fell too far behind, and events were lost.  It may only be called once the
+// events channel is closed.
+func (s *EventSubscription) Lagged() bool {
+	return s.lagged
+}
+
+// Close cancels the subscription.
+func (s *EventSubscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// eventHub dispatches the node's block and transaction pool events to the subscriptions.
+type eventHub struct {
+	mu     deadlock.Mutex
+	subs   map[*EventSubscription]bool
+	closed bool
+	log    logging.Logger
+}
+
+func makeEventHub(log logging.Logger) *eventHub {
+	return &eventHub{
+		subs: make(map[*EventSubscription]bool),
+		log:  log,
+	}
+}
+
+func (h *eventHub) subscribe(filter EventFilter) (*EventSubscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil, errEventHubClosed
+	}
+
+	sub := &EventSubscription{
+		filter: filter,
+		events: make(chan Event, eventSubscriptionBacklog),
+		hub:    h,
+	}
+	h.subs[sub] = true
+	return sub, nil
+}
+
+func (h *eventHub) unsubscribe(sub *EventSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[sub] {
+		delete(h.subs, sub)
+		close(sub.events)
+	}
+}
+
+// close ends all the subscriptions, and rejects new ones.
+func (h *eventHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for sub := range h.subs {
+		delete(h.subs, sub)
+		close(sub.events)
+	}
+}
+
+// publish delivers the events to the subscriptions without blocking; subscribers whose
+// backlog is full are dropped. publish assumes that h.mu is locked.
+func (h *eventHub) publish(events []Event) {
+	for sub := range h.subs {
+		for _, ev := range events {
+			if ev.Type != BlockEvent && (!sub.filter.MatchType(ev.Type) || !sub.filter.MatchTxn(ev.Txn)) {
+				continue
+			}
+			select {
+			case sub.events <- ev:
+			default:
+				h.log.Infof("eventHub: dropping event subscription which fell behind at round %d", ev.Round)
+				sub.lagged = true
+				delete(h.subs, sub)
+				close(sub.events)
+			}
+			if !h.subs[sub] {
+				break
+			}
+		}
+	}
+}
+
+// OnNewBlock implements the ledger.BlockListener interface.
+func (h *eventHub) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subs) == 0 {
+		return
+	}
+
+	events, err := blockEvents(block)
+	if err != nil {
+		h.log.Warnf("eventHub: unable to decode the payset of block %d: %v", block.Round(), err)
+	}
+	h.publish(events)
+}
+
+// OnTxGroupAdmitted implements the pools.AdmissionListener interface.
+func (h *eventHub) OnTxGroupAdmitted(txgroup []transactions.SignedTxn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subs) == 0 {
+		return
+	}
+
+	events := make([]Event, len(txgroup))
+	for i, stxn := range txgroup {
+		events[i] = Event{
+			Type: PendingTransactionEvent,
+			Txn:  transactions.SignedTxnWithAD{SignedTxn: stxn},
+		}
+	}
+	h.publish(events)
+}
+
+// blockEvents returns the transaction events of the block, followed by the block event.
+func blockEvents(block bookkeeping.Block) ([]Event, error) {
+	payset, err := block.DecodePaysetFlat()
+	events := make([]Event, 0, len(payset)+1)
+	for _, stxn := range payset {
+		events = append(events, Event{
+			Type:  TransactionEvent,
+			Round: block.Round(),
+			Txn:   stxn,
+		})
+	}
+	events = append(events, Event{
+		Type:   BlockEvent,
+		Round:  block.Round(),
+		Header: block.BlockHeader,
+	})
+	return events, err
+}
+
+// SubscribeEvents subscribes to the node's events matching the filter, starting with the next committed block.
+func (node *AlgorandFullNode) SubscribeEvents(filter EventFilter) (*EventSubscription, error) {
+	return node.eventHub.subscribe(filter)
+}
+
+// BlockEvents returns the events of a committed block matching the filter, so that
+// subscribers can catch up with the rounds committed before they subscribed.
+func (node *AlgorandFullNode) BlockEvents(round basics.Round, filter EventFilter) ([]Event, error) {
+	block, err := node.ledger.Block(round)
+	if err != nil {
+		return nil, err
+	}
+	events, err := blockEvents(block)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := events[:0]
+	for _, ev := range events {
+		if ev.Type == BlockEvent || (filter.MatchType(ev.Type) && filter.MatchTxn(ev.Txn)) {
+			matching = append(matching, ev)
+		}
+	}
+	return matching, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestEventFilterMatchTxn(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sender := basics.Address{1}
+	receiver := basics.Address{2}
+	other := basics.Address{3}
+
+	pay := transactions.SignedTxnWithAD{}
+	pay.Txn.Type = protocol.PaymentTx
+	pay.Txn.Sender = sender
+	pay.Txn.Receiver = receiver
+
+	axfer := transactions.SignedTxnWithAD{}
+	axfer.Txn.Type = protocol.AssetTransferTx
+	axfer.Txn.Sender = sender
+	axfer.Txn.AssetReceiver = receiver
+	axfer.Txn.XferAsset = 7
+
+	appl := transactions.SignedTxnWithAD{}
+	appl.Txn.Type = protocol.ApplicationCallTx
+	appl.Txn.Sender = sender
+	appl.Txn.Accounts = []basics.Address{other}
+	appl.ApplyData.ApplicationID = 9
+	appl.ApplyData.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{axfer}
+
+	require.True(t, EventFilter{}.MatchTxn(pay))
+	require.True(t, EventFilter{Addresses: []basics.Address{receiver}}.MatchTxn(pay))
+	require.False(t, EventFilter{Addresses: []basics.Address{other}}.MatchTxn(pay))
+	require.True(t, EventFilter{Addresses: []basics.Address{other}}.MatchTxn(appl))
+
+	require.True(t, EventFilter{AssetIDs: []basics.AssetIndex{7}}.MatchTxn(axfer))
+	require.False(t, EventFilter{AssetIDs: []basics.AssetIndex{8}}.MatchTxn(axfer))
+	require.False(t, EventFilter{Addresses: []basics.Address{sender}, AssetIDs: []basics.AssetIndex{7}}.MatchTxn(pay))
+
+	// created applications and inner transactions are matched too.
+	require.True(t, EventFilter{ApplicationIDs: []basics.AppIndex{9}}.MatchTxn(appl))
+	require.True(t, EventFilter{AssetIDs: []basics.AssetIndex{7}}.MatchTxn(appl))
+	require.False(t, EventFilter{ApplicationIDs: []basics.AppIndex{9}}.MatchTxn(pay))
+
+	require.True(t, EventFilter{}.MatchType(PendingTransactionEvent))
+	require.False(t, EventFilter{Types: map[EventType]bool{BlockEvent: true}}.MatchType(PendingTransactionEvent))
+}
+
+func TestEventHub(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	hub := makeEventHub(logging.TestingLog(t))
+
+	pay := transactions.SignedTxn{}
+	pay.Txn.Type = protocol.PaymentTx
+	pay.Txn.Sender = basics.Address{1}
+
+	all, err := hub.subscribe(EventFilter{})
+	require.NoError(t, err)
+	blocksOnly, err := hub.subscribe(EventFilter{Types: map[EventType]bool{BlockEvent: true}})
+	require.NoError(t, err)
+
+	hub.OnTxGroupAdmitted([]transactions.SignedTxn{pay})
+	ev := <-all.Events()
+	require.Equal(t, PendingTransactionEvent, ev.Type)
+	require.Equal(t, pay, ev.Txn.SignedTxn)
+	require.Len(t, blocksOnly.Events(), 0)
+
+	// a subscriber which falls behind is dropped.
+	for i := 0; i <= eventSubscriptionBacklog; i++ {
+		hub.OnTxGroupAdmitted([]transactions.SignedTxn{pay})
+	}
+	count := 0
+	for range all.Events() {
+		count++
+	}
+	require.Equal(t, eventSubscriptionBacklog, count)
+	require.True(t, all.Lagged())
+	all.Close()
+
+	blocksOnly.Close()
+	_, ok := <-blocksOnly.Events()
+	require.False(t, ok)
+	require.False(t, blocksOnly.Lagged())
+
+	hub.close()
+	_, err = hub.subscribe(EventFilter{})
+	require.Equal(t, errEventHubClosed, err)
+}
@@ -20,8 +20,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/util/db"
 )
 
@@ -30,6 +32,9 @@ const (
 	maxRows = 100
 )
 
+// schemaVersion is the version of the transactions table, stored as the user_version of the database.
+const schemaVersion = 2
+
 var schema = `
 	CREATE TABLE IF NOT EXISTS transactions(
 		txid CHAR(52) PRIMARY KEY NOT NULL,
@@ -54,6 +59,28 @@ var schema = `
 	);
 `
 
+// schemaUpgrades are the statements upgrading the database from each schema version to the next one.
+var schemaUpgrades = []string{
+	// version 1 indexes the transaction type, asset, application and note, along with the
+	// position of the transaction in its block. Transactions indexed by a previous version
+	// have a NULL txn_type until they are backfilled.
+	`
+	ALTER TABLE transactions ADD COLUMN intra INTEGER DEFAULT NULL;
+	ALTER TABLE transactions ADD COLUMN txn_type CHAR(6) DEFAULT NULL;
+	ALTER TABLE transactions ADD COLUMN asset_id INTEGER DEFAULT NULL;
+	ALTER TABLE transactions ADD COLUMN app_id INTEGER DEFAULT NULL;
+	ALTER TABLE transactions ADD COLUMN note BLOB DEFAULT NULL;
+
+	CREATE INDEX IF NOT EXISTS round_idx ON transactions (round, intra);
+	CREATE INDEX IF NOT EXISTS asset_idx ON transactions (asset_id, round, intra);
+	CREATE INDEX IF NOT EXISTS app_idx ON transactions (app_id, round, intra);
+	`,
+	// version 2 indexes the note, so that searching by note prefix doesn't scan the whole table.
+	`
+	CREATE INDEX IF NOT EXISTS note_idx ON transactions (note);
+	`,
+}
+
 // Transaction represents a transaction in the system
 type Transaction struct {
 	TXID      string
@@ -61,6 +88,56 @@ type Transaction struct {
 	To        string `db:"to_addr_r"`
 	Round     uint32
 	CreatedAt uint32 `db:"created_at"`
+	Intra     uint32
+	Type      string `db:"txn_type"`
+	AssetID   uint64 `db:"asset_id"`
+	AppID     uint64 `db:"app_id"`
+}
+
+// TransactionFilter selects the transactions returned by GetTransactions. Zero
+// values match every transaction.
+type TransactionFilter struct {
+	Address    string
+	Type       string
+	AssetID    uint64
+	AppID      uint64
+	NotePrefix []byte
+	MinRound   uint64
+	MaxRound   uint64
+
+	// After is the position of the last transaction of the previous page, if any.
+	After *TransactionPosition
+
+	// Limit is the maximum number of transactions to return, at most 100.
+	Limit uint64
+}
+
+// TransactionPosition is the position of a transaction in the chain.
+type TransactionPosition struct {
+	Round uint64
+	Intra uint64
+}
+
+// transactionAttributes returns the indexed asset and application of a transaction,
+// including the ones it created.
+func transactionAttributes(txad transactions.SignedTxnWithAD) (assetID uint64, appID uint64) {
+	txn := txad.Txn
+	switch {
+	case txn.XferAsset != 0:
+		assetID = uint64(txn.XferAsset)
+	case txn.ConfigAsset != 0:
+		assetID = uint64(txn.ConfigAsset)
+	case txn.FreezeAsset != 0:
+		assetID = uint64(txn.FreezeAsset)
+	default:
+		assetID = uint64(txad.ApplyData.ConfigAsset)
+	}
+
+	appID = uint64(txn.ApplicationID)
+	if appID == 0 {
+		appID = uint64(txad.ApplyData.ApplicationID)
+	}
+	return
 }
 
 // DB is a the db access layer for Indexer
@@ -96,9 +173,32 @@ func MakeIndexerDB(dbPath string, inMemory bool) (*DB, error) {
 		return &DB{}, err
 	}
 
+	err = dbw.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return upgradeSchema(ctx, tx)
+	})
+	if err != nil {
+		return &DB{}, err
+	}
+
 	return idb, nil
 }
 
+// upgradeSchema brings the database schema to the current version.
+func upgradeSchema(ctx context.Context, tx *sql.Tx) error {
+	version, err := db.GetUserVersion(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for ; version < schemaVersion; version++ {
+		_, err = tx.ExecContext(ctx, schemaUpgrades[version])
+		if err != nil {
+			return fmt.Errorf("unable to upgrade the indexer schema to version %d: %v", version+1, err)
+		}
+	}
+	_, err = db.SetUserVersion(ctx, tx, schemaVersion)
+	return err
+}
+
 // AddBlock takes an Algorand block and stores its transactions in the DB.
 func (idb *DB) AddBlock(b bookkeeping.Block) error {
 	err := idb.dbw.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -113,7 +213,7 @@ func (idb *DB) AddBlock(b bookkeeping.Block) error {
 			return fmt.Errorf("tryign to add a future block %d, where the last one is %d", b.Round(), rnd)
 		}
 
-		stmt, err := tx.Prepare("INSERT INTO transactions (txid, from_addr, to_addr, round, created_at) VALUES($1,  $2, $3, $4, $5);")
+		stmt, err := tx.Prepare("INSERT INTO transactions (txid, from_addr, to_addr, round, created_at, intra, txn_type, asset_id, app_id, note) VALUES($1,  $2, $3, $4, $5, $6, $7, $8, $9, $10);")
 		if err != nil {
 			return err
 		}
@@ -123,9 +223,10 @@ func (idb *DB) AddBlock(b bookkeeping.Block) error {
 		if err != nil {
 			return err
 		}
-		for _, txad := range payset {
+		for intra, txad := range payset {
 			txn := txad.SignedTxn
-			_, err = stmt.Exec(txn.ID().String(), txn.Txn.Sender.String(), txn.Txn.GetReceiverAddress().String(), b.Round(), b.TimeStamp)
+			assetID, appID := transactionAttributes(txad)
+			_, err = stmt.Exec(txn.ID().String(), txn.Txn.Sender.String(), txn.Txn.GetReceiverAddress().String(), b.Round(), b.TimeStamp, intra, string(txn.Txn.Type), assetID, appID, txn.Txn.Note)
 			if err != nil {
 				return err
 			}
@@ -263,6 +364,171 @@ func (idb *DB) GetTransactionsRoundsByAddrAndDate(addr string, top uint64, from,
 	return rounds, nil
 }
 
+// GetTransactions returns the transactions matching the filter, ordered by round and
+// by position within their round.
+func (idb *DB) GetTransactions(filter TransactionFilter) ([]Transaction, error) {
+	// the rows which aren't backfilled yet have a NULL txn_type, and the ones missing from their block
+	// when it was backfilled have an empty txn_type and a NULL intra.
+	conditions := []string{"txn_type IS NOT NULL", "txn_type <> ''", "intra IS NOT NULL"}
+	var args []interface{}
+	addCondition := func(condition string, conditionArgs ...interface{}) {
+		for _, arg := range conditionArgs {
+			args = append(args, arg)
+			condition = strings.Replace(condition, "?", fmt.Sprintf("$%d", len(args)), 1)
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if filter.Address != "" {
+		addCondition("(from_addr = ? OR to_addr = ?)", filter.Address, filter.Address)
+	}
+	if filter.Type != "" {
+		addCondition("txn_type = ?", filter.Type)
+	}
+	if filter.AssetID != 0 {
+		addCondition("asset_id = ?", filter.AssetID)
+	}
+	if filter.AppID != 0 {
+		addCondition("app_id = ?", filter.AppID)
+	}
+	if len(filter.NotePrefix) > 0 {
+		// the range lets sqlite search note_idx, which it won't do for substr alone.
+		addCondition("note >= ?", filter.NotePrefix)
+		if upper := prefixUpperBound(filter.NotePrefix); upper != nil {
+			addCondition("note < ?", upper)
+		}
+		addCondition(fmt.Sprintf("substr(note, 1, %d) = ?", len(filter.NotePrefix)), filter.NotePrefix)
+	}
+	if filter.MinRound != 0 {
+		addCondition("round >= ?", filter.MinRound)
+	}
+	if filter.MaxRound != 0 {
+		addCondition("round <= ?", filter.MaxRound)
+	}
+	if filter.After != nil {
+		addCondition("(round > ? OR (round = ? AND intra > ?))", filter.After.Round, filter.After.Round, filter.After.Intra)
+	}
+
+	limit := filter.Limit
+	if limit == 0 || limit > maxRows {
+		limit = maxRows
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			txid,
+			from_addr,
+			to_addr,
+			round,
+			created_at,
+			intra,
+			txn_type,
+			asset_id,
+			app_id
+		FROM
+			transactions
+		WHERE
+		%s
+		ORDER BY round, intra
+		LIMIT %d;
+	`, strings.Join(conditions, " AND "), limit)
+
+	rows, err := idb.dbr.Handle.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var txns []Transaction
+	for rows.Next() {
+		var txn Transaction
+		var assetID, appID sql.NullInt64
+		err = rows.Scan(&txn.TXID, &txn.From, &txn.To, &txn.Round, &txn.CreatedAt, &txn.Intra, &txn.Type, &assetID, &appID)
+		if err != nil {
+			return nil, err
+		}
+		txn.AssetID = uint64(assetID.Int64)
+		txn.AppID = uint64(appID.Int64)
+		txns = append(txns, txn)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return txns, nil
+}
+
+// prefixUpperBound returns the smallest value greater than every value starting with
+// prefix, or nil if there is none.
+func prefixUpperBound(prefix []byte) []byte {
+	upper := append([]byte(nil), prefix...)
+	for i := len(upper) - 1; i >= 0; i-- {
+		if upper[i] != 0xff {
+			upper[i]++
+			return upper[:i+1]
+		}
+	}
+	return nil
+}
+
+// RoundsToBackfill returns up to max of the rounds holding transactions which were
+// indexed before the current schema version, and lack some of the indexed attributes.
+func (idb *DB) RoundsToBackfill(max uint64) ([]uint64, error) {
+	rows, err := idb.dbr.Handle.Query("SELECT DISTINCT round FROM transactions WHERE txn_type IS NULL ORDER BY round LIMIT $1;", max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rounds []uint64
+	var rnd uint64
+	for rows.Next() {
+		err = rows.Scan(&rnd)
+		if err != nil {
+			return nil, err
+		}
+		rounds = append(rounds, rnd)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return rounds, nil
+}
+
+// BackfillBlock sets the attributes of the transactions of a block which was indexed
+// before the current schema version.
+func (idb *DB) BackfillBlock(b bookkeeping.Block) error {
+	return idb.dbw.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		stmt, err := tx.Prepare("UPDATE transactions SET intra = $1, txn_type = $2, asset_id = $3, app_id = $4, note = $5 WHERE txid = $6;")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		payset, err := b.DecodePaysetFlat()
+		if err != nil {
+			return err
+		}
+		for intra, txad := range payset {
+			txn := txad.SignedTxn
+			assetID, appID := transactionAttributes(txad)
+			_, err = stmt.Exec(intra, string(txn.Txn.Type), assetID, appID, txn.Txn.Note, txn.ID().String())
+			if err != nil {
+				return err
+			}
+		}
+
+		// make sure that the round isn't backfilled again, even if it held transactions missing from the block.
+		_, err = tx.Exec("UPDATE transactions SET txn_type = '' WHERE round = $1 AND txn_type IS NULL;", b.Round())
+		return err
+	})
+}
+
 // MaxRound returns the latest block in the DB
 func (idb *DB) MaxRound() (uint64, error) {
 	var rnd uint64
@@ -89,6 +89,13 @@ func (idx *Indexer) GetRoundsByAddress(addr string, top uint64) ([]uint64, error
 	return rounds, nil
 }
 
+// GetTransactions returns the transactions matching the filter, ordered by their
+// position in the chain. Transactions indexed by a previous version of the indexer
+// are only returned once they have been backfilled.
+func (idx *Indexer) GetTransactions(filter TransactionFilter) ([]Transaction, error) {
+	return idx.IDB.GetTransactions(filter)
+}
+
 // NewBlock takes a block and updates the DB
 // If the block exists, return nil.the block must be the next block
 func (idx *Indexer) NewBlock(b bookkeeping.Block) error {
@@ -117,10 +124,46 @@ func (idx *Indexer) Start() error {
 	}
 
 	go idx.update(round)
+	go idx.backfill()
 
 	return nil
 }
 
+// backfillBatch is the number of rounds looked up at once by the backfill.
+const backfillBatch = 100
+
+// backfill indexes the attributes of the transactions which were indexed before the
+// current schema version, from the oldest round to the newest one.
+func (idx *Indexer) backfill() {
+	for {
+		rounds, err := idx.IDB.RoundsToBackfill(backfillBatch)
+		if err != nil {
+			logging.Base().Errorf("indexer: failed listing the rounds to backfill: %v", err)
+			return
+		}
+		if len(rounds) == 0 {
+			return
+		}
+
+		for _, rnd := range rounds {
+			select {
+			case <-idx.ctx.Done():
+				return
+			default:
+			}
+
+			b, err := idx.l.Block(basics.Round(rnd))
+			if err == nil {
+				err = idx.IDB.BackfillBlock(b)
+			}
+			if err != nil {
+				logging.Base().Errorf("indexer: failed backfilling block %d: %v", rnd, err)
+				return
+			}
+		}
+	}
+}
+
 func (idx *Indexer) update(round basics.Round) {
 	for {
 		select {
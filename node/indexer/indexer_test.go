@@ -17,6 +17,7 @@
 package indexer
 
 import (
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"testing"
@@ -32,6 +33,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
 )
 
 const testGenesisID string = "foo"
@@ -158,12 +160,138 @@ func (s *IndexSuite) TestIndexer_Asset() {
 
 }
 
+func (s *IndexSuite) TestIndexer_GetTransactions() {
+	// filter by type, and page through the results.
+	filter := TransactionFilter{Type: string(protocol.AssetTransferTx), Limit: 10}
+	page, err := s.idx.GetTransactions(filter)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), page, 10)
+	for i, txn := range page {
+		require.Equal(s.T(), string(protocol.AssetTransferTx), txn.Type)
+		if i > 0 {
+			prev := page[i-1]
+			require.True(s.T(), prev.Round < txn.Round || (prev.Round == txn.Round && prev.Intra < txn.Intra))
+		}
+	}
+
+	last := page[len(page)-1]
+	filter.After = &TransactionPosition{Round: uint64(last.Round), Intra: uint64(last.Intra)}
+	next, err := s.idx.GetTransactions(filter)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), next, 10)
+	require.True(s.T(), next[0].Round > last.Round || next[0].Intra > last.Intra)
+
+	// filter by asset and round.
+	stxn := s.txns[len(s.txns)-1]
+	require.Equal(s.T(), protocol.AssetTransferTx, stxn.Txn.Type)
+	res, err := s.idx.GetTransactions(TransactionFilter{AssetID: uint64(stxn.Txn.XferAsset), MinRound: 11})
+	require.NoError(s.T(), err)
+	found := false
+	for _, txn := range res {
+		require.Equal(s.T(), uint64(stxn.Txn.XferAsset), txn.AssetID)
+		require.Equal(s.T(), uint32(11), txn.Round)
+		found = found || txn.TXID == stxn.ID().String()
+	}
+	require.True(s.T(), found)
+}
+
 func TestExampleTestSuite(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
 	suite.Run(t, new(IndexSuite))
 }
 
+type backfillTestLedger struct {
+	blocks map[basics.Round]bookkeeping.Block
+}
+
+func (l *backfillTestLedger) Block(rnd basics.Round) (blk bookkeeping.Block, err error) {
+	return l.blocks[rnd], nil
+}
+
+func (l *backfillTestLedger) Wait(r basics.Round) chan struct{} {
+	return nil
+}
+
+func TestIndexerBackfill(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, txns, _, _ := generateTestObjects(4, 2)
+	txns[1].Txn.Note = []byte("hello world")
+	blk := bookkeeping.Block{
+		BlockHeader: bookkeeping.BlockHeader{
+			Round:       2,
+			TimeStamp:   time.Now().Unix(),
+			GenesisID:   testGenesisID,
+			GenesisHash: genesisHash,
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusFuture,
+			},
+		},
+	}
+	for _, stxn := range txns {
+		txib, err := blk.EncodeSignedTxn(stxn, transactions.ApplyData{})
+		require.NoError(t, err)
+		blk.Payset = append(blk.Payset, txib)
+	}
+
+	// index the block with the original schema.
+	dbw, err := db.MakeAccessor(dir+"/"+dbName, false, false)
+	require.NoError(t, err)
+	_, err = dbw.Handle.Exec(schema)
+	require.NoError(t, err)
+	for _, stxn := range txns {
+		_, err = dbw.Handle.Exec("INSERT INTO transactions (txid, from_addr, to_addr, round, created_at) VALUES($1,  $2, $3, $4, $5);",
+			stxn.ID().String(), stxn.Txn.Sender.String(), stxn.Txn.GetReceiverAddress().String(), blk.Round(), blk.TimeStamp)
+		require.NoError(t, err)
+	}
+	// a transaction missing from the block is not returned after the backfill.
+	_, err = dbw.Handle.Exec("INSERT INTO transactions (txid, from_addr, to_addr, round, created_at) VALUES('orphan', $1, $2, $3, $4);",
+		txns[0].Txn.Sender.String(), txns[0].Txn.GetReceiverAddress().String(), blk.Round(), blk.TimeStamp)
+	require.NoError(t, err)
+	_, err = dbw.Handle.Exec("UPDATE params SET v = 2 WHERE k = 'maxRound';")
+	require.NoError(t, err)
+	dbw.Close()
+
+	idx, err := MakeIndexer(dir, &backfillTestLedger{blocks: map[basics.Round]bookkeeping.Block{2: blk}}, false)
+	require.NoError(t, err)
+	defer idx.Shutdown()
+
+	filter := TransactionFilter{NotePrefix: []byte("hello")}
+	res, err := idx.GetTransactions(filter)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	idx.backfill()
+	res, err = idx.GetTransactions(filter)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, txns[1].ID().String(), res[0].TXID)
+	require.Equal(t, uint32(1), res[0].Intra)
+
+	res, err = idx.GetTransactions(TransactionFilter{})
+	require.NoError(t, err)
+	require.Len(t, res, len(txns))
+	for i, txn := range res {
+		require.Equal(t, txns[i].ID().String(), txn.TXID)
+		require.Equal(t, uint32(i), txn.Intra)
+	}
+	res, err = idx.GetTransactions(TransactionFilter{Address: txns[0].Txn.Sender.String()})
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+	for _, txn := range res {
+		require.NotEqual(t, "orphan", txn.TXID)
+	}
+
+	rounds, err := idx.IDB.RoundsToBackfill(backfillBatch)
+	require.NoError(t, err)
+	require.Empty(t, rounds)
+}
+
 func BenchmarkORM_AddTransactions(b *testing.B) {
 	idx, _ := MakeIndexer(".", &TestLedger{}, false)
 	_, txns, _, _ := generateTestObjects(5000, 100)
@@ -285,3 +413,11 @@ func (l *TestLedger) Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 func (l *TestLedger) Wait(r basics.Round) chan struct{} {
 	return nil
 }
+
+func TestPrefixUpperBound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, []byte("hellp"), prefixUpperBound([]byte("hello")))
+	require.Equal(t, []byte{0x01}, prefixUpperBound([]byte{0x00, 0xff}))
+	require.Nil(t, prefixUpperBound([]byte{0xff, 0xff}))
+}
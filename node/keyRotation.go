@@ -0,0 +1,307 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ParticipationKeyExpiry describes an online account whose registered
+// participation key is about to expire.
+type ParticipationKeyExpiry struct {
+	Address         basics.Address
+	VoteLastValid   basics.Round
+	RoundsRemaining uint64
+
+	// RenewalInstalled is set when a participation key of the account which
+	// remains valid past VoteLastValid is installed on this node.
+	RenewalInstalled bool
+
+	// RenewalScheduledRound is the first valid round of a scheduled key
+	// registration which renews the account's key, or zero if there is none.
+	RenewalScheduledRound basics.Round
+}
+
+// scheduledKeyreg is a signed key registration transaction placed in the
+// genesis directory, which the node submits once its first valid round
+// is reached.
+type scheduledKeyreg struct {
+	filename  string
+	stxn      transactions.SignedTxn
+	submitted bool
+}
+
+// keyRotationState tracks the scheduled key registrations and the expiry
+// warnings of the node's participating accounts.
+type keyRotationState struct {
+	mu deadlock.Mutex
+
+	// scheduled holds the scheduled key registrations, keyed by file name.
+	scheduled map[string]*scheduledKeyreg
+
+	// expiring is the latest list of accounts whose keys are about to expire.
+	expiring []ParticipationKeyExpiry
+
+	// reported holds the voting keys for which an expiry warning was already
+	// emitted, so that the warning is only sent once per key.
+	reported map[crypto.OneTimeSignatureVerifier]bool
+}
+
+func makeKeyRotationState() *keyRotationState {
+	return &keyRotationState{
+		scheduled: make(map[string]*scheduledKeyreg),
+		reported:  make(map[crypto.OneTimeSignatureVerifier]bool),
+	}
+}
+
+// loadScheduledKeyregs reads the scheduled key registration files from the genesis directory.
+func (node *AlgorandFullNode) loadScheduledKeyregs() error {
+	genesisDir := filepath.Join(node.rootDir, node.genesisID)
+	files, err := ioutil.ReadDir(genesisDir)
+	if err != nil {
+		return fmt.Errorf("AlgorandFullNode.loadScheduledKeyregs: could not read directory %v: %v", genesisDir, err)
+	}
+
+	node.keyRotation.mu.Lock()
+	defer node.keyRotation.mu.Unlock()
+
+	for _, info := range files {
+		filename := info.Name()
+		if !config.IsKeyregFilename(filename) {
+			continue
+		}
+		if _, has := node.keyRotation.scheduled[filename]; has {
+			continue
+		}
+
+		fullname := filepath.Join(genesisDir, filename)
+		stxn, err := readScheduledKeyreg(fullname, node.genesisID, node.genesisHash)
+		if err != nil {
+			node.log.Warnf("loadScheduledKeyregs: ignoring %s: %v", filename, err)
+			continue
+		}
+
+		node.keyRotation.scheduled[filename] = &scheduledKeyreg{filename: filename, stxn: stxn}
+		node.log.Infof("Loaded scheduled key registration for %s from %s: first valid round %d", stxn.Txn.Sender, filename, stxn.Txn.FirstValid)
+	}
+	return nil
+}
+
+// readScheduledKeyreg decodes and sanity-checks a scheduled key registration file.
+func readScheduledKeyreg(filename string, genesisID string, genesisHash crypto.Digest) (stxn transactions.SignedTxn, err error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(data, &stxn)
+	if err != nil {
+		return
+	}
+
+	if stxn.Txn.Type != protocol.KeyRegistrationTx {
+		err = fmt.Errorf("transaction type is %s rather than %s", stxn.Txn.Type, protocol.KeyRegistrationTx)
+		return
+	}
+	if stxn.Txn.GenesisHash != genesisHash || (stxn.Txn.GenesisID != "" && stxn.Txn.GenesisID != genesisID) {
+		err = fmt.Errorf("transaction is not for genesis %s", genesisID)
+		return
+	}
+	if stxn.Sig.Blank() && stxn.Msig.Blank() && stxn.Lsig.Blank() {
+		err = fmt.Errorf("transaction is not signed")
+		return
+	}
+	return
+}
+
+// submitScheduledKeyregs broadcasts the scheduled key registrations whose first valid round has been
+// reached, and removes the ones which are already reflected in the ledger.
+func (node *AlgorandFullNode) submitScheduledKeyregs(latest basics.Round) {
+	genesisDir := filepath.Join(node.rootDir, node.genesisID)
+
+	// the transactions are broadcast after releasing the lock, since broadcasting
+	// takes the node lock in developer mode.
+	var pending []*scheduledKeyreg
+	node.keyRotation.mu.Lock()
+	for filename, entry := range node.keyRotation.scheduled {
+		txn := entry.stxn.Txn
+		if latest+1 < txn.FirstValid {
+			continue
+		}
+
+		acctData, _, err := node.ledger.LookupWithoutRewards(latest, txn.Sender)
+		if err != nil {
+			node.log.Warnf("submitScheduledKeyregs: cannot look up account %v: %v", txn.Sender, err)
+			continue
+		}
+
+		fullname := filepath.Join(genesisDir, filename)
+		if acctData.VoteID == txn.VotePK && acctData.SelectionID == txn.SelectionPK &&
+			acctData.VoteFirstValid == txn.VoteFirst && acctData.VoteLastValid == txn.VoteLast {
+			node.log.Infof("Scheduled key registration %s for %s has been applied, removing it", filename, txn.Sender)
+			err = os.Remove(fullname)
+			if err != nil && !os.IsNotExist(err) {
+				node.log.Warnf("submitScheduledKeyregs: unable to remove %s: %v", fullname, err)
+			}
+			delete(node.keyRotation.scheduled, filename)
+			continue
+		}
+
+		if latest >= txn.LastValid {
+			node.log.Errorf("Scheduled key registration %s for %s expired at round %d without being applied", filename, txn.Sender, txn.LastValid)
+			err = os.Rename(fullname, fullname+".expired")
+			if err != nil && !os.IsNotExist(err) {
+				node.log.Warnf("submitScheduledKeyregs: unable to rename %s: %v", fullname, err)
+			}
+			delete(node.keyRotation.scheduled, filename)
+			continue
+		}
+
+		if _, _, inPool := node.transactionPool.Lookup(txn.ID()); inPool {
+			continue
+		}
+		pending = append(pending, entry)
+	}
+	node.keyRotation.mu.Unlock()
+
+	for _, entry := range pending {
+		txn := entry.stxn.Txn
+		txid := txn.ID()
+		err := node.BroadcastSignedTxGroup([]transactions.SignedTxn{entry.stxn})
+		if err != nil {
+			node.log.Warnf("submitScheduledKeyregs: unable to submit %s (%s): %v", entry.filename, txid, err)
+			continue
+		}
+
+		node.keyRotation.mu.Lock()
+		firstSubmission := !entry.submitted
+		entry.submitted = true
+		node.keyRotation.mu.Unlock()
+
+		if firstSubmission {
+			node.log.Infof("Submitted scheduled key registration %s for %s: %s", entry.filename, txn.Sender, txid)
+			node.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.KeyRegSubmittedEvent, telemetryspec.KeyRegSubmittedEventDetails{
+				Address:    txn.Sender.String(),
+				TxID:       txid.String(),
+				Round:      uint64(latest),
+				FirstValid: uint64(txn.VoteFirst),
+				LastValid:  uint64(txn.VoteLast),
+			})
+		}
+	}
+}
+
+// checkParticipationKeyExpiry looks for online accounts with participation keys on this node whose
+// registered key expires within the configured number of rounds, and warns about them once per key.
+func (node *AlgorandFullNode) checkParticipationKeyExpiry(latest basics.Round) {
+	warningRounds := node.config.ParticipationKeysExpiryWarningRounds
+
+	var expiring []ParticipationKeyExpiry
+	voteIDs := make(map[basics.Address]crypto.OneTimeSignatureVerifier)
+	if warningRounds > 0 {
+		records := node.accountManager.Registry().GetAll()
+		latestValid := make(map[basics.Address]basics.Round)
+		for _, record := range records {
+			if record.LastValid > latestValid[record.Account] {
+				latestValid[record.Account] = record.LastValid
+			}
+		}
+
+		for addr, lastValid := range latestValid {
+			acctData, _, err := node.ledger.LookupWithoutRewards(latest, addr)
+			if err != nil {
+				node.log.Warnf("checkParticipationKeyExpiry: cannot look up account %v: %v", addr, err)
+				continue
+			}
+			if acctData.Status != basics.Online {
+				continue
+			}
+
+			remaining := uint64(acctData.VoteLastValid.SubSaturate(latest))
+			if remaining > warningRounds {
+				continue
+			}
+
+			voteIDs[addr] = acctData.VoteID
+			expiring = append(expiring, ParticipationKeyExpiry{
+				Address:          addr,
+				VoteLastValid:    acctData.VoteLastValid,
+				RoundsRemaining:  remaining,
+				RenewalInstalled: lastValid > acctData.VoteLastValid,
+			})
+		}
+	}
+	sort.Slice(expiring, func(i, j int) bool {
+		return expiring[i].VoteLastValid < expiring[j].VoteLastValid
+	})
+
+	node.keyRotation.mu.Lock()
+	defer node.keyRotation.mu.Unlock()
+
+	for i := range expiring {
+		for _, entry := range node.keyRotation.scheduled {
+			txn := entry.stxn.Txn
+			if txn.Sender == expiring[i].Address && txn.VoteLast > expiring[i].VoteLastValid {
+				expiring[i].RenewalScheduledRound = txn.FirstValid
+			}
+		}
+
+		voteID := voteIDs[expiring[i].Address]
+		if node.keyRotation.reported[voteID] {
+			continue
+		}
+		node.keyRotation.reported[voteID] = true
+
+		exp := expiring[i]
+		switch {
+		case exp.RenewalScheduledRound != 0:
+			node.log.Infof("Participation key of %s expires at round %d (in %d rounds); a renewal is scheduled for round %d", exp.Address, exp.VoteLastValid, exp.RoundsRemaining, exp.RenewalScheduledRound)
+		case exp.RenewalInstalled:
+			node.log.Warnf("Participation key of %s expires at round %d (in %d rounds); a renewed key is installed but not registered", exp.Address, exp.VoteLastValid, exp.RoundsRemaining)
+		default:
+			node.log.Warnf("Participation key of %s expires at round %d (in %d rounds) and has not been renewed", exp.Address, exp.VoteLastValid, exp.RoundsRemaining)
+		}
+		node.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.PartKeyExpiringEvent, telemetryspec.PartKeyExpiringEventDetails{
+			Address:               exp.Address.String(),
+			VoteLastValid:         uint64(exp.VoteLastValid),
+			RoundsRemaining:       exp.RoundsRemaining,
+			RenewalInstalled:      exp.RenewalInstalled,
+			RenewalScheduledRound: uint64(exp.RenewalScheduledRound),
+		})
+	}
+
+	node.keyRotation.expiring = expiring
+}
+
+// ExpiringParticipationKeys returns the online accounts whose registered participation key is about to expire.
+func (node *AlgorandFullNode) ExpiringParticipationKeys() []ParticipationKeyExpiry {
+	node.keyRotation.mu.Lock()
+	defer node.keyRotation.mu.Unlock()
+	return append([]ParticipationKeyExpiry(nil), node.keyRotation.expiring...)
+}
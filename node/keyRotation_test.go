@@ -0,0 +1,348 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestReadScheduledKeyreg(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	genesisID := "test-v1"
+	genesisHash := crypto.Hash([]byte(genesisID))
+	secrets := keypair()
+	sender := basics.Address(secrets.SignatureVerifier)
+
+	txn := transactions.Transaction{
+		Type: protocol.KeyRegistrationTx,
+		Header: transactions.Header{
+			Sender:      sender,
+			FirstValid:  100,
+			LastValid:   1100,
+			GenesisID:   genesisID,
+			GenesisHash: genesisHash,
+		},
+		KeyregTxnFields: transactions.KeyregTxnFields{
+			VoteFirst:       100,
+			VoteLast:        10000,
+			VoteKeyDilution: 100,
+		},
+	}
+	crypto.RandBytes(txn.VotePK[:])
+	crypto.RandBytes(txn.SelectionPK[:])
+
+	write := func(stxn transactions.SignedTxn) string {
+		filename := filepath.Join(dir, config.KeyregFilename(sender.String(), uint64(stxn.Txn.FirstValid)))
+		require.NoError(t, ioutil.WriteFile(filename, protocol.Encode(&stxn), 0600))
+		return filename
+	}
+
+	// a properly signed key registration is accepted.
+	filename := write(txn.Sign(secrets))
+	require.True(t, config.IsKeyregFilename(filepath.Base(filename)))
+	stxn, err := readScheduledKeyreg(filename, genesisID, genesisHash)
+	require.NoError(t, err)
+	require.Equal(t, txn, stxn.Txn)
+
+	// unsigned transactions cannot be submitted.
+	filename = write(transactions.SignedTxn{Txn: txn})
+	_, err = readScheduledKeyreg(filename, genesisID, genesisHash)
+	require.Error(t, err)
+
+	// transactions for another network are rejected.
+	_, err = readScheduledKeyreg(write(txn.Sign(secrets)), "other-v1", crypto.Hash([]byte("other-v1")))
+	require.Error(t, err)
+
+	// only key registrations may be scheduled.
+	payment := txn
+	payment.Type = protocol.PaymentTx
+	payment.KeyregTxnFields = transactions.KeyregTxnFields{}
+	_, err = readScheduledKeyreg(write(payment.Sign(secrets)), genesisID, genesisHash)
+	require.Error(t, err)
+
+	// garbage is not a transaction.
+	require.NoError(t, ioutil.WriteFile(filename, []byte("not a transaction"), 0600))
+	_, err = readScheduledKeyreg(filename, genesisID, genesisHash)
+	require.Error(t, err)
+}
+
+func TestKeyregFilename(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.True(t, config.IsKeyregFilename(config.KeyregFilename("addr", 5)))
+	require.False(t, config.IsKeyregFilename("addr.keyreg"))
+	require.False(t, config.IsKeyregFilename("addr.five.keyreg"))
+	require.False(t, config.IsKeyregFilename(config.PartKeyFilename("addr", 5, 10)))
+	require.False(t, config.IsKeyregFilename(config.KeyregFilename("addr", 5)+".expired"))
+}
+
+// keyRotationTestAccount is a genesis account of a key rotation test node.
+type keyRotationTestAccount struct {
+	secrets *crypto.SignatureSecrets
+	data    basics.AccountData
+}
+
+func (acct keyRotationTestAccount) address() basics.Address {
+	return basics.Address(acct.secrets.SignatureVerifier)
+}
+
+func makeOnlineTestAccount(voteLast basics.Round) keyRotationTestAccount {
+	acct := keyRotationTestAccount{
+		secrets: keypair(),
+		data: basics.AccountData{
+			Status:          basics.Online,
+			MicroAlgos:      basics.MicroAlgos{Raw: 10000000000000},
+			VoteLastValid:   voteLast,
+			VoteKeyDilution: 100,
+		},
+	}
+	crypto.RandBytes(acct.data.VoteID[:])
+	crypto.RandBytes(acct.data.SelectionID[:])
+	return acct
+}
+
+func makeOfflineTestAccount() keyRotationTestAccount {
+	return keyRotationTestAccount{
+		secrets: keypair(),
+		data: basics.AccountData{
+			Status:     basics.Offline,
+			MicroAlgos: basics.MicroAlgos{Raw: 10000000000000},
+		},
+	}
+}
+
+// makeKeyRotationTestNode makes a node with an in-memory ledger holding the
+// given accounts, and only the components used by key rotation.
+func makeKeyRotationTestNode(t *testing.T, log logging.Logger, accts []keyRotationTestAccount) *AlgorandFullNode {
+	genesis := make(map[basics.Address]basics.AccountData)
+	for _, acct := range accts {
+		genesis[acct.address()] = acct.data
+	}
+	genesis[poolAddr] = basics.AccountData{
+		Status:     basics.NotParticipating,
+		MicroAlgos: basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinBalance},
+	}
+	genBal := bookkeeping.MakeGenesisBalances(genesis, sinkAddr, poolAddr)
+
+	cfg := config.GetDefaultLocal()
+	ledger, err := data.LoadLedger(log, t.Name(), true, protocol.ConsensusCurrentVersion, genBal, genesisID, genesisHash, nil, cfg)
+	require.NoError(t, err)
+
+	registryDB, err := db.OpenPair(fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64()), true)
+	require.NoError(t, err)
+	registry, err := account.MakeParticipationRegistry(registryDB, log)
+	require.NoError(t, err)
+
+	rootDir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	require.NoError(t, os.Mkdir(filepath.Join(rootDir, genesisID), 0700))
+	t.Cleanup(func() {
+		os.RemoveAll(rootDir)
+		registry.Close()
+		ledger.Close()
+	})
+
+	return &AlgorandFullNode{
+		ledger:          ledger,
+		net:             &mocks.MockNetwork{},
+		transactionPool: pools.MakeTransactionPool(ledger.Ledger, cfg, log),
+		accountManager:  data.MakeAccountManager(log, registry),
+		keyRotation:     makeKeyRotationState(),
+		config:          cfg,
+		rootDir:         rootDir,
+		genesisID:       genesisID,
+		genesisHash:     genesisHash,
+		log:             log,
+	}
+}
+
+// scheduleKeyreg writes a signed key registration of the account to the genesis directory of the node.
+func scheduleKeyreg(t *testing.T, node *AlgorandFullNode, acct keyRotationTestAccount, fv, lv basics.Round, keys basics.AccountData) transactions.SignedTxn {
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	txn := transactions.Transaction{
+		Type: protocol.KeyRegistrationTx,
+		Header: transactions.Header{
+			Sender:      acct.address(),
+			Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+			FirstValid:  fv,
+			LastValid:   lv,
+			GenesisID:   genesisID,
+			GenesisHash: genesisHash,
+		},
+		KeyregTxnFields: transactions.KeyregTxnFields{
+			VotePK:          keys.VoteID,
+			SelectionPK:     keys.SelectionID,
+			VoteFirst:       keys.VoteFirstValid,
+			VoteLast:        keys.VoteLastValid,
+			VoteKeyDilution: keys.VoteKeyDilution,
+		},
+	}
+	stxn := txn.Sign(acct.secrets)
+	filename := filepath.Join(node.rootDir, genesisID, config.KeyregFilename(acct.address().String(), uint64(fv)))
+	require.NoError(t, ioutil.WriteFile(filename, protocol.Encode(&stxn), 0600))
+	return stxn
+}
+
+func TestSubmitScheduledKeyregs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	online := makeOnlineTestAccount(1000)
+	offline := makeOfflineTestAccount()
+	late := makeOfflineTestAccount()
+	node := makeKeyRotationTestNode(t, logging.TestingLog(t), []keyRotationTestAccount{online, offline, late})
+	genesisDir := filepath.Join(node.rootDir, genesisID)
+
+	newKeys := makeOnlineTestAccount(5000).data
+	newKeys.VoteFirstValid = 1
+
+	// already reflected in the ledger
+	scheduleKeyreg(t, node, online, 0, 100, online.data)
+	// due for submission
+	due := scheduleKeyreg(t, node, offline, 1, 100, newKeys)
+	// not due yet
+	notDue := scheduleKeyreg(t, node, offline, 50, 100, newKeys)
+	// expired without being applied
+	scheduleKeyreg(t, node, late, 0, 0, newKeys)
+
+	require.NoError(t, node.loadScheduledKeyregs())
+	require.Len(t, node.keyRotation.scheduled, 4)
+
+	node.submitScheduledKeyregs(0)
+
+	_, _, inPool := node.transactionPool.Lookup(due.ID())
+	require.True(t, inPool)
+	_, _, inPool = node.transactionPool.Lookup(notDue.ID())
+	require.False(t, inPool)
+
+	files, err := ioutil.ReadDir(genesisDir)
+	require.NoError(t, err)
+	var names []string
+	for _, info := range files {
+		names = append(names, info.Name())
+	}
+	require.ElementsMatch(t, []string{
+		config.KeyregFilename(offline.address().String(), 1),
+		config.KeyregFilename(offline.address().String(), 50),
+		config.KeyregFilename(late.address().String(), 0) + ".expired",
+	}, names)
+
+	require.Len(t, node.keyRotation.scheduled, 2)
+	require.True(t, node.keyRotation.scheduled[config.KeyregFilename(offline.address().String(), 1)].submitted)
+	require.False(t, node.keyRotation.scheduled[config.KeyregFilename(offline.address().String(), 50)].submitted)
+
+	// a transaction still in the pool is not submitted again, and expired or
+	// removed files are not loaded again.
+	node.submitScheduledKeyregs(0)
+	require.Equal(t, 1, node.transactionPool.PendingCount())
+	require.NoError(t, node.loadScheduledKeyregs())
+	require.Len(t, node.keyRotation.scheduled, 2)
+}
+
+func TestCheckParticipationKeyExpiry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	warnings := 0
+	baseLog := logging.TestingLog(t)
+	log := &callbackLogger{
+		Logger: baseLog,
+		WarnfCallback: func(s string, args ...interface{}) {
+			if strings.HasPrefix(s, "Participation key of") {
+				warnings++
+			}
+		},
+	}
+
+	expiring := makeOnlineTestAccount(1000)
+	renewed := makeOnlineTestAccount(1100)
+	scheduled := makeOnlineTestAccount(1500)
+	valid := makeOnlineTestAccount(100000)
+	offline := makeOfflineTestAccount()
+	node := makeKeyRotationTestNode(t, log, []keyRotationTestAccount{expiring, renewed, scheduled, valid, offline})
+	node.config.ParticipationKeysExpiryWarningRounds = 2000
+
+	install := func(acct keyRotationTestAccount, first, last basics.Round) {
+		part := account.Participation{
+			Parent:      acct.address(),
+			FirstValid:  first,
+			LastValid:   last,
+			KeyDilution: 100,
+			Voting:      &crypto.OneTimeSignatureSecrets{},
+			VRF:         &crypto.VRFSecrets{},
+		}
+		crypto.RandBytes(part.Voting.OneTimeSignatureVerifier[:])
+		crypto.RandBytes(part.VRF.PK[:])
+		_, err := node.accountManager.Registry().Insert(part, "")
+		require.NoError(t, err)
+	}
+	install(expiring, 0, 1000)
+	install(renewed, 0, 1100)
+	install(renewed, 1000, 5000)
+	install(scheduled, 0, 1500)
+	install(valid, 0, 100000)
+	install(offline, 0, 1000)
+
+	renewal := makeOnlineTestAccount(9000).data
+	renewal.VoteFirstValid = 800
+	scheduleKeyreg(t, node, scheduled, 800, 900, renewal)
+	require.NoError(t, node.loadScheduledKeyregs())
+
+	node.checkParticipationKeyExpiry(0)
+	require.Equal(t, []ParticipationKeyExpiry{
+		{Address: expiring.address(), VoteLastValid: 1000, RoundsRemaining: 1000},
+		{Address: renewed.address(), VoteLastValid: 1100, RoundsRemaining: 1100, RenewalInstalled: true},
+		{Address: scheduled.address(), VoteLastValid: 1500, RoundsRemaining: 1500, RenewalScheduledRound: 800},
+	}, node.ExpiringParticipationKeys())
+	// the scheduled renewal is only reported at the info level
+	require.Equal(t, 2, warnings)
+	require.Len(t, node.keyRotation.reported, 3)
+
+	// each key is only warned about once
+	node.checkParticipationKeyExpiry(0)
+	require.Equal(t, 2, warnings)
+	require.Len(t, node.ExpiringParticipationKeys(), 3)
+
+	// no warnings when disabled
+	node.config.ParticipationKeysExpiryWarningRounds = 0
+	node.checkParticipationKeyExpiry(0)
+	require.Empty(t, node.ExpiringParticipationKeys())
+}
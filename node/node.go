@@ -19,6 +19,7 @@ package node
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -43,6 +44,7 @@ import (
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/node/indexer"
@@ -74,6 +76,18 @@ type StatusReport struct {
 	CatchpointCatchupVerifiedAccounts  uint64
 	CatchpointCatchupTotalBlocks       uint64
 	CatchpointCatchupAcquiredBlocks    uint64
+	ExpiringParticipationKeys          []ParticipationKeyExpiry // online accounts of this node whose registered participation key is about to expire.
+}
+
+// DetailedStatusReport extends the StatusReport with the state of the node's peers, catchup, transaction pool and ledger.
+type DetailedStatusReport struct {
+	StatusReport
+	InboundPeers       int
+	OutboundPeers      int
+	CatchupPeers       catchup.PeerSelectorStats
+	PendingTxns        int
+	TxPoolSize         int
+	LastCommittedRound basics.Round // the last round flushed to persistent storage.
 }
 
 // TimeSinceLastRound returns the time since the last block was approved (locally), or 0 if no blocks seen
@@ -85,6 +99,23 @@ func (status StatusReport) TimeSinceLastRound() time.Duration {
 	return time.Since(status.LastRoundTimestamp)
 }
 
+// ReadinessError returns the reason a node with this status should not be served requests, or nil if it is
+// ready: the node is not ready while it catches up, including from a catchpoint, once it stopped at an
+// unsupported round, or when its last round is older than maxRoundAge. A zero maxRoundAge disables that check.
+func (status StatusReport) ReadinessError(maxRoundAge time.Duration) error {
+	switch {
+	case status.Catchpoint != "":
+		return fmt.Errorf("catching up to catchpoint %s", status.Catchpoint)
+	case status.StoppedAtUnsupportedRound:
+		return fmt.Errorf("stopped at unsupported round %d", status.LastRound+1)
+	case status.CatchupTime > 0:
+		return fmt.Errorf("catching up since %v", status.CatchupTime)
+	case maxRoundAge > 0 && status.TimeSinceLastRound() > maxRoundAge:
+		return fmt.Errorf("last round %d is %v old", status.LastRound, status.TimeSinceLastRound())
+	}
+	return nil
+}
+
 // AlgorandFullNode specifies and implements a full Algorand node.
 type AlgorandFullNode struct {
 	mu        deadlock.Mutex
@@ -108,6 +139,8 @@ type AlgorandFullNode struct {
 
 	indexer *indexer.Indexer
 
+	eventHub *eventHub
+
 	rootDir     string
 	genesisID   string
 	genesisHash crypto.Digest
@@ -127,6 +160,7 @@ type AlgorandFullNode struct {
 	catchupBlockAuth                   blockAuthenticatorImpl
 
 	oldKeyDeletionNotify        chan struct{}
+	keyRotation                 *keyRotationState
 	monitoringRoutinesWaitGroup sync.WaitGroup
 
 	tracer messagetracer.MessageTracer
@@ -156,7 +190,36 @@ type TxnWithStatus struct {
 // MakeFull sets up an Algorand full node
 // (i.e., it returns a node that participates in consensus)
 func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis) (*AlgorandFullNode, error) {
+	return makeFull(log, rootDir, cfg, genesis, func(node *AlgorandFullNode, genesisDir string) (network.GossipNode, error) {
+		p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network)
+		if err != nil {
+			log.Errorf("could not create websocket node: %v", err)
+			return nil, err
+		}
+		p2pNode.SetPrioScheme(node)
+		p2pNode.SetPhonebookFile(filepath.Join(genesisDir, config.PeersFilename))
+		if node.config.EnableGossipIdentity {
+			identity, err := network.LoadNodeIdentity(filepath.Join(genesisDir, config.NodeIdentityFilename))
+			if err != nil {
+				log.Errorf("Unable to load the node identity: %v", err)
+				return nil, err
+			}
+			p2pNode.SetIdentity(identity)
+		}
+		return p2pNode, nil
+	})
+}
+
+// MakeFullWithNetwork sets up an Algorand full node which uses the given network rather than connecting to
+// its peers over websockets, e.g. a simulated network shared by several nodes running in a single process.
+func MakeFullWithNetwork(log logging.Logger, rootDir string, cfg config.Local, genesis bookkeeping.Genesis, net network.GossipNode) (*AlgorandFullNode, error) {
+	return makeFull(log, rootDir, cfg, genesis, func(*AlgorandFullNode, string) (network.GossipNode, error) {
+		return net, nil
+	})
+}
 
+// makeFull sets up an Algorand full node, using makeNetwork to create its network once the genesis directory exists.
+func makeFull(log logging.Logger, rootDir string, cfg config.Local, genesis bookkeeping.Genesis, makeNetwork func(node *AlgorandFullNode, genesisDir string) (network.GossipNode, error)) (*AlgorandFullNode, error) {
 	node := new(AlgorandFullNode)
 	node.rootDir = rootDir
 	node.log = log.With("name", cfg.NetAddress)
@@ -169,16 +232,6 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 	node.config = cfg
 
-	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network)
-	if err != nil {
-		log.Errorf("could not create websocket node: %v", err)
-		return nil, err
-	}
-	p2pNode.SetPrioScheme(node)
-	node.net = p2pNode
-	node.accountManager = data.MakeAccountManager(log)
-
 	accountListener := makeTopAccountListener(log)
 
 	// load stored data
@@ -186,11 +239,25 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	ledgerPathnamePrefix := filepath.Join(genesisDir, config.LedgerFilenamePrefix)
 
 	// create initial ledger, if it doesn't exist
-	err = os.Mkdir(genesisDir, 0700)
+	err := os.Mkdir(genesisDir, 0700)
 	if err != nil && !os.IsExist(err) {
 		log.Errorf("Unable to create genesis directory: %v", err)
 		return nil, err
 	}
+
+	// tie network, block fetcher, and agreement services together
+	p2pNode, err := makeNetwork(node, genesisDir)
+	if err != nil {
+		return nil, err
+	}
+	node.net = p2pNode
+
+	registry, err := ensureParticipationDB(genesisDir, node.log)
+	if err != nil {
+		log.Errorf("unable to initialize the participation registry database: %v", err)
+		return nil, err
+	}
+	node.accountManager = data.MakeAccountManager(log, registry)
 	var genalloc bookkeeping.GenesisBalances
 	genalloc, err = bootstrapData(genesis, log)
 	if err != nil {
@@ -209,9 +276,13 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log)
 
+	node.eventHub = makeEventHub(node.log)
+	node.transactionPool.RegisterAdmissionListeners([]pools.AdmissionListener{node.eventHub})
+
 	blockListeners := []ledger.BlockListener{
 		node.transactionPool,
 		node,
+		node.eventHub,
 	}
 
 	if node.config.EnableTopAccountsReporting {
@@ -273,6 +344,13 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 
+	node.keyRotation = makeKeyRotationState()
+	err = node.loadScheduledKeyregs()
+	if err != nil {
+		log.Errorf("Cannot load scheduled key registrations: %v", err)
+		return nil, err
+	}
+
 	node.oldKeyDeletionNotify = make(chan struct{}, 1)
 
 	catchpointCatchupState, err := node.ledger.GetCatchpointCatchupState(context.Background())
@@ -429,6 +507,7 @@ func (node *AlgorandFullNode) Stop() {
 		// call to LatestSigsFromThisNode.
 		node.compactCert.Shutdown()
 		node.compactCert = nil
+		node.accountManager.Registry().Close()
 	}()
 
 	node.net.ClearHandlers()
@@ -450,11 +529,27 @@ func (node *AlgorandFullNode) Stop() {
 	node.lowPriorityCryptoVerificationPool.Shutdown()
 	node.cryptoPool.Shutdown()
 	node.cancelCtx()
+	node.eventHub.close()
 	if node.indexer != nil {
 		node.indexer.Shutdown()
 	}
 }
 
+// ensureParticipationDB opens (creating if needed) the participation registry database.
+func ensureParticipationDB(genesisDir string, log logging.Logger) (account.ParticipationRegistry, error) {
+	accessorFile := filepath.Join(genesisDir, config.ParticipationRegistryFilename)
+	accessor, err := db.OpenPair(accessorFile, false)
+	if err != nil {
+		return nil, err
+	}
+	registry, err := account.MakeParticipationRegistry(accessor, log)
+	if err != nil {
+		accessor.Close()
+		return nil, err
+	}
+	return registry, nil
+}
+
 // note: unlike the other two functions, this accepts a whole filename
 func (node *AlgorandFullNode) getExistingPartHandle(filename string) (db.Accessor, error) {
 	filename = filepath.Join(node.rootDir, node.genesisID, filename)
@@ -514,6 +609,45 @@ func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.Sign
 		return err
 	}
 
+	return node.rememberAndBroadcast(txgroup)
+}
+
+// BroadcastSignedTxGroups verifies a batch of independent transaction groups in parallel,
+// and then adds each of the valid ones to the transaction pool and broadcasts it.
+// It returns the error of each group, nil for the groups that were accepted.
+func (node *AlgorandFullNode) BroadcastSignedTxGroups(txgroups [][]transactions.SignedTxn) []error {
+	errs := make([]error, len(txgroups))
+	if node.devMode {
+		// each group needs to render into its own block.
+		for i, txgroup := range txgroups {
+			errs[i] = node.BroadcastSignedTxGroup(txgroup)
+		}
+		return errs
+	}
+
+	lastRound := node.ledger.Latest()
+	b, err := node.ledger.BlockHdr(lastRound)
+	if err != nil {
+		node.log.Errorf("could not get block header from last round %v: %v", lastRound, err)
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	errs = verify.TxnGroups(node.ctx, txgroups, b, node.lowPriorityCryptoVerificationPool, node.ledger.VerifiedTransactionCache())
+	for i, txgroup := range txgroups {
+		if errs[i] != nil {
+			node.log.Warnf("malformed transaction: %v", errs[i])
+			continue
+		}
+		errs[i] = node.rememberAndBroadcast(txgroup)
+	}
+	return errs
+}
+
+// rememberAndBroadcast adds a verified transaction group to the transaction pool and broadcasts it to the network.
+func (node *AlgorandFullNode) rememberAndBroadcast(txgroup []transactions.SignedTxn) (err error) {
 	err = node.transactionPool.Remember(txgroup)
 	if err != nil {
 		node.log.Infof("rejected by local pool: %v - transaction group was %+v", err, txgroup)
@@ -621,33 +755,33 @@ func (node *AlgorandFullNode) GetPendingTransaction(txID transactions.Txid) (res
 		// Keep looking in the ledger..
 	}
 
-	var maxLife basics.Round
-	latest := node.ledger.Latest()
-	proto, err := node.ledger.ConsensusParams(latest)
-	if err == nil {
-		maxLife = basics.Round(proto.MaxTxnLife)
-	} else {
-		node.log.Errorf("node.GetPendingTransaction: cannot get consensus params for latest round %v", latest)
-	}
-	maxRound := latest
-	minRound := maxRound.SubSaturate(maxLife)
-
-	for r := minRound; r <= maxRound; r++ {
-		tx, found, err := node.ledger.LookupTxid(txID, r)
-		if err != nil || !found {
-			continue
-		}
-		return TxnWithStatus{
-			Txn:            tx.SignedTxn,
-			ConfirmedRound: r,
-			ApplyData:      tx.ApplyData,
-		}, true
+	if confirmed, ok := node.GetConfirmedTransaction(txID); ok {
+		return confirmed, true
 	}
 
 	// Return whatever we found in the pool (if anything).
 	return
 }
 
+// GetConfirmedTransaction looks for the required txID among the transactions
+// committed in the recent rounds tracked by the ledger for duplicate detection.
+// It does not require the node indexer.
+func (node *AlgorandFullNode) GetConfirmedTransaction(txID transactions.Txid) (res TxnWithStatus, found bool) {
+	tx, r, found, err := node.ledger.LookupConfirmedTxn(txID)
+	if err != nil {
+		node.log.Warnf("node.GetConfirmedTransaction: cannot look up transaction %v in round %d: %v", txID, r, err)
+		return TxnWithStatus{}, false
+	}
+	if !found {
+		return TxnWithStatus{}, false
+	}
+	return TxnWithStatus{
+		Txn:            tx.SignedTxn,
+		ConfirmedRound: r,
+		ApplyData:      tx.ApplyData,
+	}, true
+}
+
 // Status returns a StatusReport structure reporting our status as Active and with our ledger's LastRound
 func (node *AlgorandFullNode) Status() (s StatusReport, err error) {
 	node.syncStatusMu.Lock()
@@ -693,11 +827,64 @@ func (node *AlgorandFullNode) Status() (s StatusReport, err error) {
 		s.LastCatchpoint = node.ledger.GetLastCatchpointLabel()
 		s.SynchronizingTime = node.catchupService.SynchronizingTime()
 		s.CatchupTime = node.catchupService.SynchronizingTime()
+		s.ExpiringParticipationKeys = node.ExpiringParticipationKeys()
 	}
 
 	return
 }
 
+// DetailedStatus returns the node status along with the state of its peers, catchup, transaction pool and ledger.
+func (node *AlgorandFullNode) DetailedStatus() (s DetailedStatusReport, err error) {
+	s.StatusReport, err = node.Status()
+	if err != nil {
+		return
+	}
+
+	s.InboundPeers = len(node.net.GetPeers(network.PeersConnectedIn))
+	s.OutboundPeers = len(node.net.GetPeers(network.PeersConnectedOut))
+	s.CatchupPeers, _ = node.catchupService.PeerSelectorStats()
+	s.PendingTxns = node.transactionPool.PendingCount()
+	s.TxPoolSize = node.config.TxPoolSize
+	s.LastCommittedRound, _ = node.ledger.LatestCommitted()
+	return
+}
+
+// PeersInfo returns a snapshot of the peers the node is connected to.
+func (node *AlgorandFullNode) PeersInfo() []network.PeerInfo {
+	return node.net.PeersInfo()
+}
+
+// DisconnectPeer disconnects the peer with the given id, returning false if no such peer is connected.
+func (node *AlgorandFullNode) DisconnectPeer(id uint64) bool {
+	return node.net.DisconnectPeer(id)
+}
+
+// BanPeer bans the given address or host for the given duration, and returns the number of peers it disconnected.
+func (node *AlgorandFullNode) BanPeer(addr string, duration time.Duration) int {
+	return node.net.BanPeer(addr, duration)
+}
+
+// UnbanPeer lifts the ban on the given address or host, returning false if it wasn't banned.
+func (node *AlgorandFullNode) UnbanPeer(addr string) bool {
+	return node.net.UnbanPeer(addr)
+}
+
+// BannedPeers returns the peer bans which haven't expired yet.
+func (node *AlgorandFullNode) BannedPeers() []network.PeerBan {
+	return node.net.BannedPeers()
+}
+
+// Equivocations returns the evidence of the vote equivocations detected by the agreement service, most recent first.
+func (node *AlgorandFullNode) Equivocations() []agreement.EquivocationEvidence {
+	return node.agreementService.Equivocations()
+}
+
+// AgreementTimeline returns the agreement timelines of at most max of the latest rounds, most recent first. All of the
+// kept timelines are returned when max is zero.
+func (node *AlgorandFullNode) AgreementTimeline(max int) []agreement.RoundTimeline {
+	return node.agreementService.RoundTimelines(max)
+}
+
 // GenesisID returns the ID of the genesis node.
 func (node *AlgorandFullNode) GenesisID() string {
 	node.mu.Lock()
@@ -736,6 +923,16 @@ func (node *AlgorandFullNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{Raw: node.transactionPool.FeePerByte()}
 }
 
+// AdmissionFee returns the fees a transaction currently needs to pay to get into the transaction pool.
+func (node *AlgorandFullNode) AdmissionFee() pools.AdmissionFee {
+	return node.transactionPool.AdmissionFee()
+}
+
+// EstimateFees estimates the fee per byte a transaction needs to pay in order to be committed within each of the given numbers of rounds.
+func (node *AlgorandFullNode) EstimateFees(targets []uint64) []pools.FeeEstimate {
+	return node.transactionPool.EstimateFees(targets)
+}
+
 // GetPendingTxnsFromPool returns a snapshot of every pending transactions from the node's transaction pool in a slice.
 // Transactions are sorted in decreasing order. If no transactions, returns an empty slice.
 func (node *AlgorandFullNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn, error) {
@@ -753,6 +950,10 @@ func (node *AlgorandFullNode) checkForParticipationKeys() {
 			if err != nil {
 				node.log.Errorf("Could not refresh participation keys: %v", err)
 			}
+			err = node.loadScheduledKeyregs()
+			if err != nil {
+				node.log.Errorf("Could not refresh scheduled key registrations: %v", err)
+			}
 		case <-node.ctx.Done():
 			ticker.Stop()
 			return
@@ -807,9 +1008,10 @@ func (node *AlgorandFullNode) loadParticipationKeys() error {
 			}
 		} else {
 			// Tell the AccountManager about the Participation (dupes don't matter)
-			added := node.accountManager.AddParticipation(part)
+			added := node.accountManager.AddParticipation(part, filename)
 			if added {
 				node.log.Infof("Loaded participation keys from storage: %s %s", part.Address(), info.Name())
+				node.registerOnlineParticipation(part.Participation)
 			} else {
 				part.Close()
 			}
@@ -819,6 +1021,101 @@ func (node *AlgorandFullNode) loadParticipationKeys() error {
 	return nil
 }
 
+// registerOnlineParticipation marks the participation key as registered in the
+// participation registry if the account's on-chain keys currently match it.
+func (node *AlgorandFullNode) registerOnlineParticipation(part account.Participation) {
+	registry := node.accountManager.Registry()
+	id := part.ID()
+	record, err := registry.Get(id)
+	if err != nil {
+		return
+	}
+
+	latest := node.ledger.Latest()
+	on := latest
+	if on < part.FirstValid {
+		on = part.FirstValid
+	}
+	if on > part.LastValid || record.IsActive(on) {
+		return
+	}
+
+	acctData, _, err := node.ledger.LookupWithoutRewards(latest, part.Parent)
+	if err != nil {
+		node.log.Warnf("registerOnlineParticipation: cannot look up account %v: %v", part.Parent, err)
+		return
+	}
+	if acctData.VoteID != part.Voting.OneTimeSignatureVerifier || acctData.SelectionID != part.VRF.PK {
+		return
+	}
+
+	err = registry.Register(id, on)
+	if err != nil {
+		node.log.Warnf("registerOnlineParticipation: unable to register participation key %s: %v", id, err)
+	}
+}
+
+// recordKeyRegistrations updates the participation registry with the key
+// registration transactions in the block that refer to installed keys.
+func (node *AlgorandFullNode) recordKeyRegistrations(block bookkeeping.Block) {
+	registry := node.accountManager.Registry()
+	records := registry.GetAll()
+	if len(records) == 0 {
+		return
+	}
+
+	payset, err := block.DecodePaysetFlat()
+	if err != nil {
+		node.log.Warnf("recordKeyRegistrations: unable to decode payset of round %d: %v", block.Round(), err)
+		return
+	}
+
+	// a key registration becomes effective once its round is used as the
+	// balance round for agreement.
+	proto := config.Consensus[block.CurrentProtocol]
+	effective := block.Round() + basics.Round(2*proto.SeedRefreshInterval*proto.SeedLookback)
+
+	for _, txn := range payset {
+		if txn.Txn.Type != protocol.KeyRegistrationTx {
+			continue
+		}
+		installed := false
+		registered := false
+		for _, record := range records {
+			if record.Account != txn.Txn.Sender {
+				continue
+			}
+			installed = true
+			if record.VoteID != txn.Txn.VotePK || record.SelectionID != txn.Txn.SelectionPK {
+				continue
+			}
+			if effective > record.LastValid {
+				continue
+			}
+			registered = true
+			err = registry.Register(record.ParticipationID, effective)
+			if err != nil {
+				node.log.Warnf("recordKeyRegistrations: unable to register participation key %s: %v", record.ParticipationID, err)
+				continue
+			}
+			node.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.PartKeyRegisteredEvent, telemetryspec.PartKeyRegisteredEventDetails{
+				Address:    record.Account.String(),
+				FirstValid: uint64(record.FirstValid),
+				LastValid:  uint64(record.LastValid),
+			})
+		}
+
+		// an offline key registration, or one of a key which isn't installed,
+		// ends the registration of the account's installed key.
+		if installed && !registered {
+			err = registry.Deregister(txn.Txn.Sender, effective)
+			if err != nil {
+				node.log.Warnf("recordKeyRegistrations: unable to deregister participation keys of %v: %v", txn.Txn.Sender, err)
+			}
+		}
+	}
+}
+
 var txPoolGuage = metrics.MakeGauge(metrics.MetricName{Name: "algod_tx_pool_count", Description: "current number of available transactions in pool"})
 
 func (node *AlgorandFullNode) txPoolGaugeThread() {
@@ -835,6 +1132,113 @@ func (node *AlgorandFullNode) txPoolGaugeThread() {
 	}
 }
 
+// ListParticipationKeys returns the records of all participation keys installed on the node.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) ListParticipationKeys() ([]account.ParticipationRecord, error) {
+	return node.accountManager.Registry().GetAll(), nil
+}
+
+// GetParticipationKey returns the record of the participation key with the given ID.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) GetParticipationKey(id account.ParticipationID) (account.ParticipationRecord, error) {
+	return node.accountManager.Registry().Get(id)
+}
+
+// ErrInvalidParticipationKey is returned by InstallParticipationKey when the
+// given bytes are not a participation key database.
+var ErrInvalidParticipationKey = errors.New("invalid participation key database")
+
+// InstallParticipationKey installs the participation key database given as raw bytes into
+// the genesis directory, and starts using it.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
+	genesisDir := filepath.Join(node.rootDir, node.genesisID)
+
+	// write the uploaded database to a temporary file which loadParticipationKeys would ignore.
+	tmpFile, err := ioutil.TempFile(genesisDir, "partkey-install-*.tmp")
+	if err != nil {
+		return account.ParticipationID{}, err
+	}
+	tmpFilename := tmpFile.Name()
+	defer os.Remove(tmpFilename)
+
+	_, err = tmpFile.Write(partKeyBinary)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return account.ParticipationID{}, err
+	}
+
+	handle, err := db.MakeErasableAccessor(tmpFilename)
+	if err != nil {
+		return account.ParticipationID{}, fmt.Errorf("%w: unable to open it: %v", ErrInvalidParticipationKey, err)
+	}
+	part, err := account.RestoreParticipation(handle)
+	handle.Close()
+	if err != nil {
+		return account.ParticipationID{}, fmt.Errorf("%w: unable to read the participation key: %v", ErrInvalidParticipationKey, err)
+	}
+
+	id := part.ID()
+	if _, err = node.accountManager.Registry().Get(id); err == nil {
+		return id, account.ErrAlreadyInserted
+	}
+
+	filename := config.PartKeyFilename(part.Parent.String(), uint64(part.FirstValid), uint64(part.LastValid))
+	fullname := filepath.Join(genesisDir, filename)
+	if _, err = os.Stat(fullname); err == nil {
+		return id, fmt.Errorf("%w: a different participation key is installed as %s", account.ErrAlreadyInserted, filename)
+	}
+	err = os.Rename(tmpFilename, fullname)
+	if err != nil {
+		return id, err
+	}
+
+	handle, err = node.getExistingPartHandle(filename)
+	if err != nil {
+		return id, err
+	}
+	part, err = account.RestoreParticipation(handle)
+	if err != nil {
+		handle.Close()
+		return id, err
+	}
+	if !node.accountManager.AddParticipation(part, filename) {
+		part.Close()
+	}
+	node.registerOnlineParticipation(part.Participation)
+	node.log.Infof("Installed participation key %s for %s (%d-%d)", id, part.Address(), part.FirstValid, part.LastValid)
+	return id, nil
+}
+
+// RemoveParticipationKey stops using the participation key with the given ID, deletes
+// its database from the genesis directory and removes it from the registry.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) RemoveParticipationKey(id account.ParticipationID) error {
+	registry := node.accountManager.Registry()
+	record, err := registry.Get(id)
+	if err != nil {
+		return err
+	}
+
+	if record.Filename != "" {
+		fullname := filepath.Join(node.rootDir, node.genesisID, record.Filename)
+		err = os.Remove(fullname)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	node.accountManager.RemoveParticipation(id)
+
+	err = registry.Delete(id)
+	if err != nil {
+		return err
+	}
+	node.log.Infof("Removed participation key %s for %s (%d-%d)", id, record.Account, record.FirstValid, record.LastValid)
+	return nil
+}
+
 // IsArchival returns true the node is an archival node, false otherwise
 func (node *AlgorandFullNode) IsArchival() bool {
 	return node.config.Archival
@@ -850,6 +1254,8 @@ func (node *AlgorandFullNode) OnNewBlock(block bookkeeping.Block, delta ledgerco
 	node.hasSyncedSinceStartup = true
 	node.syncStatusMu.Unlock()
 
+	node.recordKeyRegistrations(block)
+
 	// Wake up oldKeyDeletionThread(), non-blocking.
 	select {
 	case node.oldKeyDeletionNotify <- struct{}{}:
@@ -913,6 +1319,9 @@ func (node *AlgorandFullNode) oldKeyDeletionThread() {
 		node.mu.Lock()
 		node.accountManager.DeleteOldKeys(latestHdr, ccSigs, agreementProto)
 		node.mu.Unlock()
+
+		node.submitScheduledKeyregs(r)
+		node.checkParticipationKeyExpiry(r)
 	}
 }
 
@@ -1156,3 +1565,8 @@ func (node *AlgorandFullNode) VotingKeys(votingRound, keysRound basics.Round) []
 	}
 	return participations
 }
+
+// Record forwards the participation action to the participation registry.
+func (node *AlgorandFullNode) Record(addr basics.Address, round basics.Round, participationType account.ParticipationAction) {
+	node.accountManager.Record(addr, round, participationType)
+}
@@ -469,6 +469,37 @@ func TestStatusReport_TimeSinceLastRound(t *testing.T) {
 	}
 }
 
+func TestStatusReport_ReadinessError(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tests := []struct {
+		name   string
+		status StatusReport
+		ready  bool
+	}{
+		{name: "synced", status: StatusReport{LastRound: 10, LastRoundTimestamp: time.Now()}, ready: true},
+		{name: "never saw a round", status: StatusReport{}, ready: true},
+		{name: "catching up", status: StatusReport{LastRoundTimestamp: time.Now(), CatchupTime: time.Second}},
+		{name: "catchpoint catchup", status: StatusReport{Catchpoint: "5000#ABCD"}},
+		{name: "unsupported round", status: StatusReport{LastRoundTimestamp: time.Now(), StoppedAtUnsupportedRound: true}},
+		{name: "stale", status: StatusReport{LastRound: 10, LastRoundTimestamp: time.Now().Add(-time.Minute)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.status.ReadinessError(30 * time.Second)
+			if tt.ready {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// a zero maximal round age disables the staleness check.
+	stale := StatusReport{LastRound: 10, LastRoundTimestamp: time.Now().Add(-time.Minute)}
+	require.NoError(t, stale.ReadinessError(0))
+}
+
 type mismatchingDirectroyPermissionsLog struct {
 	logging.Logger
 	t *testing.T
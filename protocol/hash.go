@@ -45,6 +45,7 @@ const (
 	OneTimeSigKey1    HashID = "OT1"
 	OneTimeSigKey2    HashID = "OT2"
 	PaysetFlat        HashID = "PF"
+	ParticipationKeys HashID = "PK"
 	Payload           HashID = "PL"
 	Program           HashID = "Program"
 	ProgramData       HashID = "ProgData"
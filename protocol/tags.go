@@ -27,13 +27,18 @@ type Tag string
 const (
 	UnknownMsgTag      Tag = "??"
 	AgreementVoteTag   Tag = "AV"
+	CompressedMsgTag   Tag = "CM"
 	CompactCertSigTag  Tag = "CS"
 	MsgOfInterestTag   Tag = "MI"
 	MsgDigestSkipTag   Tag = "MS"
+	NodeIdentityTag    Tag = "NI"
 	NetPrioResponseTag Tag = "NP"
 	PingTag            Tag = "pi"
 	PingReplyTag       Tag = "pj"
 	ProposalPayloadTag Tag = "PP"
+	PeerExchangeTag    Tag = "PX"
+	TxnAnnounceTag     Tag = "TA"
+	TxnRequestTag      Tag = "TQ"
 	TopicMsgRespTag    Tag = "TS"
 	TxnTag             Tag = "TX"
 	UniCatchupReqTag   Tag = "UC" //Replaced by UniEnsBlockReqTag. Only for backward compatibility.
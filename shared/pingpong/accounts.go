@@ -29,7 +29,8 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
-	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	algodAcct "github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -146,7 +147,7 @@ func throttleTransactionRate(startTime time.Time, cfg PpConfig, totalSent uint64
 // Step 1) Create X assets for each of the participant accounts
 // Step 2) For each participant account, opt-in to assets of all other participant accounts
 // Step 3) Evenly distribute the assets across all participant accounts
-func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, client libgoal.Client) (resultAssetMaps map[uint64]v1.AssetParams, optIns map[uint64][]string, err error) {
+func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, client libgoal.Client) (resultAssetMaps map[uint64]generatedV2.AssetParams, optIns map[uint64][]string, err error) {
 	proto, err := getProto(client)
 	if err != nil {
 		return
@@ -154,7 +155,7 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 
 	var startTime = time.Now()
 	var totalSent uint64 = 0
-	resultAssetMaps = make(map[uint64]v1.AssetParams)
+	resultAssetMaps = make(map[uint64]generatedV2.AssetParams)
 
 	// optIns contains own and explicitly opted-in assets
 	optIns = make(map[uint64][]string)
@@ -171,11 +172,12 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 			return
 		}
 
-		toCreate := int(pps.cfg.NumAsset) - len(addrAccount.AssetParams)
+		addrAssetParams := createdAssets(addrAccount)
+		toCreate := int(pps.cfg.NumAsset) - len(addrAssetParams)
 		numCreatedAssetsByAddr[addr] = toCreate
 
 		fmt.Printf("Creating %v create asset transaction for account %v \n", toCreate, addr)
-		fmt.Printf("cfg.NumAsset %v, addrAccount.AssetParams %v\n", pps.cfg.NumAsset, addrAccount.AssetParams)
+		fmt.Printf("cfg.NumAsset %v, addrAccount.AssetParams %v\n", pps.cfg.NumAsset, addrAssetParams)
 
 		totalSupply := pps.cfg.MinAccountAsset * uint64(pps.cfg.NumPartAccounts) * 9 * uint64(pps.cfg.GroupSize) * uint64(pps.cfg.RefreshTime.Seconds()) / pps.cfg.TxnPerSec
 		// create assets in participant account
@@ -221,7 +223,7 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 		if addr == pps.cfg.SrcAccount {
 			continue
 		}
-		var account v1.Account
+		var account generatedV2.Account
 		deadline := time.Now().Add(3 * time.Minute)
 		for {
 			account, err = client.AccountInformation(addr)
@@ -230,7 +232,7 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 				time.Sleep(1 * time.Second)
 				continue
 			}
-			if len(account.AssetParams) >= numCreatedAssetsByAddr[addr] {
+			if len(createdAssets(account)) >= numCreatedAssetsByAddr[addr] {
 				break
 			}
 			if time.Now().After(deadline) {
@@ -240,12 +242,12 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 			}
 			waitForNextRoundOrSleep(client, 500*time.Millisecond)
 		}
-		assetParams := account.AssetParams
+		assetParams := createdAssets(account)
 		if !pps.cfg.Quiet {
 			fmt.Printf("Configured %d assets %+v\n", len(assetParams), assetParams)
 		}
 		// add own asset to opt-ins since asset creators are auto-opted in
-		for k := range account.AssetParams {
+		for k := range assetParams {
 			optIns[k] = append(optIns[k], addr)
 			allAssets[k] = addr
 		}
@@ -273,7 +275,7 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 			err = addrErr
 			return
 		}
-		numSlots := proto.MaxAssetsPerAccount - len(acct.Assets)
+		numSlots := proto.MaxAssetsPerAccount - heldAssetsCount(acct)
 		optInsByAddr[addr] = make(map[uint64]bool)
 		for k, creator := range allAssets {
 			if creator == addr {
@@ -321,7 +323,7 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 			continue
 		}
 		expectedAssets := numCreatedAssetsByAddr[addr] + len(optInsByAddr[addr])
-		var account v1.Account
+		var account generatedV2.Account
 		deadline := time.Now().Add(3 * time.Minute)
 		for {
 			account, err = client.AccountInformation(addr)
@@ -330,10 +332,10 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 				time.Sleep(1 * time.Second)
 				continue
 			}
-			if len(account.Assets) == expectedAssets {
+			if heldAssetsCount(account) == expectedAssets {
 				break
-			} else if len(account.Assets) > expectedAssets {
-				err = fmt.Errorf("account %v has too many assets %d > %d ", addr, len(account.Assets), expectedAssets)
+			} else if heldAssetsCount(account) > expectedAssets {
+				err = fmt.Errorf("account %v has too many assets %d > %d ", addr, heldAssetsCount(account), expectedAssets)
 				return
 			}
 
@@ -361,7 +363,7 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 			err = creatorErr
 			return
 		}
-		assetParams := creatorAccount.AssetParams
+		assetParams := createdAssets(creatorAccount)
 
 		for _, addr := range optIns[k] {
 			assetAmt := assetParams[k].Total / uint64(len(optIns[k]))
@@ -411,7 +413,7 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 	// wait for all transfers acceptance
 	waitForNextRoundOrSleep(client, 500*time.Millisecond)
 	deadline := time.Now().Add(3 * time.Minute)
-	var pending v1.PendingTransactions
+	var pending algodclient.PendingTransactions
 	for {
 		pending, err = client.GetPendingTransactions(100)
 		if err != nil {
@@ -419,7 +421,7 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 			time.Sleep(1 * time.Second)
 			continue
 		}
-		if pending.TotalTxns == 0 {
+		if pending.TotalTransactions == 0 {
 			break
 		}
 		if time.Now().After(deadline) {
@@ -431,6 +433,25 @@ func (pps *WorkerState) prepareAssets(accounts map[string]*pingPongAccount, clie
 	return
 }
 
+// createdAssets returns the parameters of the assets created by the given account, by asset index
+func createdAssets(account generatedV2.Account) map[uint64]generatedV2.AssetParams {
+	assets := make(map[uint64]generatedV2.AssetParams)
+	if account.CreatedAssets != nil {
+		for _, asset := range *account.CreatedAssets {
+			assets[asset.Index] = asset.Params
+		}
+	}
+	return assets
+}
+
+// heldAssetsCount returns the number of assets the given account holds, including the ones it created
+func heldAssetsCount(account generatedV2.Account) int {
+	if account.Assets == nil {
+		return 0
+	}
+	return len(*account.Assets)
+}
+
 func signAndBroadcastTransaction(senderAccount *pingPongAccount, tx transactions.Transaction, client libgoal.Client) (txID string, err error) {
 	signedTx := tx.Sign(senderAccount.sk)
 	txID, err = client.BroadcastTransaction(signedTx)
@@ -659,7 +680,7 @@ func getProto(client libgoal.Client) (config.ConsensusParams, error) {
 	return *proto, nil
 }
 
-func (pps *WorkerState) prepareApps(accounts map[string]*pingPongAccount, client libgoal.Client, cfg PpConfig) (appParams map[uint64]v1.AppParams, optIns map[uint64][]string, err error) {
+func (pps *WorkerState) prepareApps(accounts map[string]*pingPongAccount, client libgoal.Client, cfg PpConfig) (appParams map[uint64]generatedV2.ApplicationParams, optIns map[uint64][]string, err error) {
 	proto, err := getProto(client)
 	if err != nil {
 		return
@@ -687,7 +708,7 @@ func (pps *WorkerState) prepareApps(accounts map[string]*pingPongAccount, client
 		return
 	}
 
-	appAccounts := make([]v1.Account, len(accounts))
+	appAccounts := make([]generatedV2.Account, len(accounts))
 	accountsCount := 0
 	for acctAddr := range accounts {
 		if acctAddr == cfg.SrcAccount {
@@ -776,24 +797,24 @@ func (pps *WorkerState) prepareApps(accounts map[string]*pingPongAccount, client
 
 	// get these apps
 	var aidxs []uint64
-	appParams = make(map[uint64]v1.AppParams)
+	appParams = make(map[uint64]generatedV2.ApplicationParams)
 	for _, appAccount := range appAccounts {
-		var account v1.Account
+		var account generatedV2.Account
 		for {
 			account, err = client.AccountInformation(appAccount.Address)
 			if err != nil {
 				fmt.Printf("Warning, cannot lookup source account")
 				return
 			}
-			if len(account.AppParams) >= accountsApplicationCount[appAccount.Address] {
+			if account.CreatedApps != nil && len(*account.CreatedApps) >= accountsApplicationCount[appAccount.Address] {
 				break
 			}
 			waitForNextRoundOrSleep(client, 500*time.Millisecond)
 			// TODO : if we fail here for too long, we should re-create new accounts, etc.
 		}
-		for idx, v := range account.AppParams {
-			appParams[idx] = v
-			aidxs = append(aidxs, idx)
+		for _, app := range *account.CreatedApps {
+			appParams[app.Id] = app.Params
+			aidxs = append(aidxs, app.Id)
 		}
 	}
 	if len(aidxs) != len(appParams) {
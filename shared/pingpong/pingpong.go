@@ -30,7 +30,8 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
-	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -40,8 +41,8 @@ import (
 
 // CreatablesInfo has information about created assets, apps and opting in
 type CreatablesInfo struct {
-	AssetParams map[uint64]v1.AssetParams
-	AppParams   map[uint64]v1.AppParams
+	AssetParams map[uint64]generatedV2.AssetParams
+	AppParams   map[uint64]generatedV2.ApplicationParams
 	OptIns      map[uint64][]string
 }
 
@@ -86,8 +87,8 @@ type WorkerState struct {
 	incTransactionSalt uint64
 
 	muSuggestedParams deadlock.Mutex
-	suggestedParams   v1.TransactionParams
-	pendingTxns       v1.PendingTransactions
+	suggestedParams   generatedV2.TransactionParametersResponse
+	pendingTxns       algodclient.PendingTransactions
 }
 
 // PrepareAccounts to set up accounts and asset accounts required for Ping Pong run
@@ -366,8 +367,8 @@ func waitPendingTransactions(accounts map[string]*pingPongAccount, client libgoa
 			fmt.Printf("failed to check pending transaction pool status : %v\n", err)
 			return err
 		}
-		for _, txn := range pendingTxns.TruncatedTxns.Transactions {
-			if txn.From != from {
+		for _, txn := range pendingTxns.TopTransactions {
+			if txn.Txn.Sender.String() != from {
 				// we found a transaction where the receiver was the given account. We don't
 				// care about these.
 				continue
@@ -673,10 +674,10 @@ func (pps *WorkerState) sendFromTo(
 	}
 	belowMinBalanceAccounts := make(map[string] /*basics.Address*/ bool)
 
-	assetsByCreator := make(map[string][]*v1.AssetParams)
+	assetsByCreator := make(map[string][]*generatedV2.AssetParams)
 	for _, p := range cinfo.AssetParams {
 		c := p.Creator
-		ap := &v1.AssetParams{}
+		ap := &generatedV2.AssetParams{}
 		*ap = p
 		assetsByCreator[c] = append(assetsByCreator[c], ap)
 	}
@@ -945,7 +946,7 @@ func (pps *WorkerState) roundMonitor(client libgoal.Client) {
 	}
 }
 
-func (pps *WorkerState) getSuggestedParams() v1.TransactionParams {
+func (pps *WorkerState) getSuggestedParams() generatedV2.TransactionParametersResponse {
 	pps.muSuggestedParams.Lock()
 	defer pps.muSuggestedParams.Unlock()
 	return pps.suggestedParams
@@ -1092,7 +1093,7 @@ func (pps *WorkerState) constructPayment(from, to string, fee, amount uint64, no
 	}
 
 	// Get current round, protocol, genesis ID
-	var params v1.TransactionParams
+	var params generatedV2.TransactionParametersResponse
 	for params.LastRound == 0 {
 		params = pps.getSuggestedParams()
 	}
@@ -1132,7 +1133,7 @@ func (pps *WorkerState) constructPayment(from, to string, fee, amount uint64, no
 		tx.PaymentTxnFields.CloseRemainderTo = closeToAddr
 	}
 
-	tx.Header.GenesisID = params.GenesisID
+	tx.Header.GenesisID = params.GenesisId
 
 	// Check if the protocol supports genesis hash
 	if cp.SupportGenesisHash {
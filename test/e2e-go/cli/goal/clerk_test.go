@@ -23,6 +23,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/framework/fixtures"
 )
 
@@ -68,16 +70,20 @@ func TestClerkSendNoteEncoding(t *testing.T) {
 			tx1, err := fixture.WaitForConfirmedTxn(status.LastRound+i, account, txID)
 			if err == nil {
 				foundTx1 = true
-				a.Equal(noteText, string(tx1.Note))
+				var stxn1 transactions.SignedTxn
+				a.NoError(protocol.DecodeJSON(protocol.EncodeJSON(tx1.Txn), &stxn1))
+				a.Equal(noteText, string(stxn1.Txn.Note))
 			}
 		}
 		if !foundTx2 {
 			tx2, err := fixture.WaitForConfirmedTxn(status.LastRound+i, account, txID2)
 			if err == nil {
 				foundTx2 = true
+				var stxn2 transactions.SignedTxn
+				a.NoError(protocol.DecodeJSON(protocol.EncodeJSON(tx2.Txn), &stxn2))
 				// If the note matches our original text, then goal is still expecting strings encoded
 				// with StdEncoding.EncodeToString() when using --noteb64 parameter
-				a.Equal(originalNoteb64Text, string(tx2.Note), "goal should decode noteb64 with base64.StdEncoding")
+				a.Equal(originalNoteb64Text, string(stxn2.Txn.Note), "goal should decode noteb64 with base64.StdEncoding")
 			}
 		}
 	}
@@ -26,11 +26,9 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/compactcert"
-	"github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	"github.com/algorand/go-algorand/data/basics"
-	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/protocol"
-	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/test/framework/fixtures"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -58,9 +56,6 @@ func TestCompactCerts(t *testing.T) {
 	fixture.Setup(t, filepath.Join("nettemplates", "CompactCert.json"))
 	defer fixture.Shutdown()
 
-	restClient, err := fixture.NC.AlgodClient()
-	r.NoError(err)
-
 	node0Client := fixture.GetLibGoalClientForNamedNode("Node0")
 	node0Wallet, err := node0Client.GetUnencryptedWalletHandle()
 	r.NoError(err)
@@ -75,7 +70,7 @@ func TestCompactCerts(t *testing.T) {
 	r.NoError(err)
 	node1Account := node1AccountList[0]
 
-	var lastCertBlock v1.Block
+	var lastCertBlock bookkeeping.Block
 	libgoal := fixture.LibGoalClient
 	for rnd := uint64(1); rnd <= consensusParams.CompactCertRounds*4; rnd++ {
 		// send a dummy payment transaction.
@@ -95,56 +90,50 @@ func TestCompactCerts(t *testing.T) {
 
 		if (rnd % consensusParams.CompactCertRounds) == 0 {
 			// Must have a merkle commitment for participants
-			r.True(len(blk.CompactCertVoters) > 0)
-			r.True(blk.CompactCertVotersTotal != 0)
+			r.False(blk.CompactCert[protocol.CompactCertBasic].CompactCertVoters.IsZero())
+			r.False(blk.CompactCert[protocol.CompactCertBasic].CompactCertVotersTotal.IsZero())
 
 			// Special case: bootstrap validation with the first block
 			// that has a merkle root.
-			if lastCertBlock.Round == 0 {
+			if lastCertBlock.Round() == 0 {
 				lastCertBlock = blk
 			}
 		}
 
-		for lastCertBlock.Round != 0 && lastCertBlock.Round+consensusParams.CompactCertRounds < blk.CompactCertNextRound {
-			nextCertRound := lastCertBlock.Round + consensusParams.CompactCertRounds
-
-			// Find the cert transaction
-			res, err := restClient.TransactionsByAddr(transactions.CompactCertSender.String(), 0, rnd, 4)
-			r.NoError(err)
+		for lastCertBlock.Round() != 0 && lastCertBlock.Round()+basics.Round(consensusParams.CompactCertRounds) < blk.CompactCert[protocol.CompactCertBasic].CompactCertNextRound {
+			nextCertRound := lastCertBlock.Round() + basics.Round(consensusParams.CompactCertRounds)
 
+			// Find the cert transaction in the blocks that followed the last certificate
 			var compactCert compactcert.Cert
 			compactCertFound := false
-			for _, txn := range res.Transactions {
-				r.Equal(txn.Type, string(protocol.CompactCertTx))
-				r.True(txn.CompactCert != nil)
-				if txn.CompactCert.CertRound == nextCertRound {
-					err = protocol.Decode(txn.CompactCert.Cert, &compactCert)
-					r.NoError(err)
-					compactCertFound = true
+			for certRnd := nextCertRound; certRnd <= basics.Round(rnd); certRnd++ {
+				certBlk, err := libgoal.Block(uint64(certRnd))
+				r.NoError(err)
+				for _, stxn := range certBlk.Payset {
+					if stxn.Txn.Type != protocol.CompactCertTx {
+						continue
+					}
+					if stxn.Txn.CertRound == nextCertRound {
+						compactCert = stxn.Txn.Cert
+						compactCertFound = true
+					}
 				}
 			}
 			r.True(compactCertFound)
 
-			nextCertBlock, err := libgoal.Block(nextCertRound)
-			r.NoError(err)
-
-			nextCertBlockRaw, err := libgoal.RawBlock(nextCertRound)
-			r.NoError(err)
-
-			var nextCertBlockDecoded rpcs.EncodedBlockCert
-			err = protocol.Decode(nextCertBlockRaw, &nextCertBlockDecoded)
+			nextCertBlock, err := libgoal.Block(uint64(nextCertRound))
 			r.NoError(err)
 
-			var votersRoot crypto.Digest
-			copy(votersRoot[:], lastCertBlock.CompactCertVoters)
+			votersRoot := crypto.Digest(lastCertBlock.CompactCert[protocol.CompactCertBasic].CompactCertVoters)
+			votersTotal := lastCertBlock.CompactCert[protocol.CompactCertBasic].CompactCertVotersTotal
 
-			provenWeight, overflowed := basics.Muldiv(lastCertBlock.CompactCertVotersTotal, uint64(consensusParams.CompactCertWeightThreshold), 1<<32)
+			provenWeight, overflowed := basics.Muldiv(votersTotal.ToUint64(), uint64(consensusParams.CompactCertWeightThreshold), 1<<32)
 			r.False(overflowed)
 
 			ccparams := compactcert.Params{
-				Msg:          nextCertBlockDecoded.Block.BlockHeader,
+				Msg:          nextCertBlock.BlockHeader,
 				ProvenWeight: provenWeight,
-				SigRound:     basics.Round(nextCertBlock.Round + 1),
+				SigRound:     nextCertBlock.Round() + 1,
 				SecKQ:        consensusParams.CompactCertSecKQ,
 			}
 			verif := compactcert.MkVerifier(ccparams, votersRoot)
@@ -155,5 +144,5 @@ func TestCompactCerts(t *testing.T) {
 		}
 	}
 
-	r.Equalf(consensusParams.CompactCertRounds*3, lastCertBlock.Round, "the expected last certificate block wasn't the one that was observed")
+	r.Equalf(basics.Round(consensusParams.CompactCertRounds*3), lastCertBlock.Round(), "the expected last certificate block wasn't the one that was observed")
 }
@@ -45,7 +45,7 @@ func checkEvalDelta(t *testing.T, client *libgoal.Client, startRnd, endRnd uint6
 		if r > lastRound {
 			break
 		}
-		b, err := client.BookkeepingBlock(r)
+		b, err := client.Block(r)
 		a.NoError(err)
 		for _, ps := range b.Payset {
 			ed, ok := ps.ApplyData.EvalDelta.GlobalDelta["counter"]
@@ -75,7 +75,7 @@ func checkEvalDelta(t *testing.T, client *libgoal.Client, startRnd, endRnd uint6
 	a.True(foundLocal, fmt.Sprintf("local delta not found in rounds %d-%d", startRnd, endRnd))
 }
 
-func TestAccountInformationV2(t *testing.T) {
+func TestAccountInformation(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	defer fixtures.ShutdownSynchronizedTest(t)
 
@@ -223,10 +223,10 @@ int 1
 	// Ensure the txn committed
 	resp, err := client.GetPendingTransactions(2)
 	a.NoError(err)
-	a.Equal(uint64(0), resp.TotalTxns)
-	txinfo, err := client.TransactionInformation(signedTxn.Txn.Sender.String(), txid)
+	a.Equal(uint64(0), resp.TotalTransactions)
+	txinfo, err := client.ConfirmedTransactionInformation(txid)
 	a.NoError(err)
-	a.True(txinfo.ConfirmedRound != 0)
+	a.True(txinfo.ConfirmedRound != nil && *txinfo.ConfirmedRound != 0)
 
 	// check creator's balance record for the app entry and the state changes
 	ad, err = client.AccountData(creator)
@@ -294,7 +294,7 @@ int 1
 	// Ensure the txn committed
 	resp, err = client.GetPendingTransactions(2)
 	a.NoError(err)
-	a.Equal(uint64(0), resp.TotalTxns)
+	a.Equal(uint64(0), resp.TotalTransactions)
 
 	ad, err = client.AccountData(creator)
 	a.NoError(err)
@@ -121,7 +121,7 @@ log
 	logs[30] = "b"
 	logs[31] = "c"
 
-	b, err := client.BookkeepingBlock(round)
+	b, err := client.Block(round)
 	for _, ps := range b.Payset {
 		ed := ps.ApplyData.EvalDelta
 		ok = checkEqual(logs, ed.Logs)
@@ -25,7 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/config"
-	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/libgoal"
@@ -36,7 +36,7 @@ import (
 
 type assetIDParams struct {
 	idx    uint64
-	params v1.AssetParams
+	params generatedV2.AssetParams
 }
 
 func helperFillSignBroadcast(client libgoal.Client, wh []byte, sender string, tx transactions.Transaction, err error) (string, error) {
@@ -230,7 +230,7 @@ func TestAssetConfig(t *testing.T) {
 	// There should be no assets to start with
 	info, err := client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 0)
+	a.Equal(len(createdAssets(info)), 0)
 
 	// Create max number of assets
 	txids := make(map[string]string)
@@ -273,18 +273,18 @@ func TestAssetConfig(t *testing.T) {
 	// Check that assets are visible
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), config.Consensus[protocol.ConsensusFuture].MaxAssetsPerAccount)
+	a.Equal(len(createdAssets(info)), config.Consensus[protocol.ConsensusFuture].MaxAssetsPerAccount)
 	var assets []assetIDParams
-	for idx, cp := range info.AssetParams {
+	for idx, cp := range createdAssets(info) {
 		assets = append(assets, assetIDParams{idx, cp})
-		a.Equal(cp.UnitName, fmt.Sprintf("test%d", cp.Total-1))
-		a.Equal(cp.AssetName, fmt.Sprintf("testname%d", cp.Total-1))
-		a.Equal(cp.ManagerAddr, manager)
-		a.Equal(cp.ReserveAddr, reserve)
-		a.Equal(cp.FreezeAddr, freeze)
-		a.Equal(cp.ClawbackAddr, clawback)
-		a.Equal(cp.MetadataHash, assetMetadataHash)
-		a.Equal(cp.URL, assetURL)
+		a.Equal(strOrEmpty(cp.UnitName), fmt.Sprintf("test%d", cp.Total-1))
+		a.Equal(strOrEmpty(cp.Name), fmt.Sprintf("testname%d", cp.Total-1))
+		a.Equal(strOrEmpty(cp.Manager), manager)
+		a.Equal(strOrEmpty(cp.Reserve), reserve)
+		a.Equal(strOrEmpty(cp.Freeze), freeze)
+		a.Equal(strOrEmpty(cp.Clawback), clawback)
+		a.Equal(bytesOrNil(cp.MetadataHash), assetMetadataHash)
+		a.Equal(strOrEmpty(cp.Url), assetURL)
 	}
 
 	// re-generate wh, since this test takes a while and sometimes
@@ -337,39 +337,39 @@ func TestAssetConfig(t *testing.T) {
 
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), config.Consensus[protocol.ConsensusFuture].MaxAssetsPerAccount)
-	for idx, cp := range info.AssetParams {
-		a.Equal(cp.UnitName, fmt.Sprintf("test%d", cp.Total-1))
-		a.Equal(cp.AssetName, fmt.Sprintf("testname%d", cp.Total-1))
+	a.Equal(len(createdAssets(info)), config.Consensus[protocol.ConsensusFuture].MaxAssetsPerAccount)
+	for idx, cp := range createdAssets(info) {
+		a.Equal(strOrEmpty(cp.UnitName), fmt.Sprintf("test%d", cp.Total-1))
+		a.Equal(strOrEmpty(cp.Name), fmt.Sprintf("testname%d", cp.Total-1))
 
 		if idx == assets[0].idx {
-			a.Equal(cp.ManagerAddr, account0)
+			a.Equal(strOrEmpty(cp.Manager), account0)
 		} else {
-			a.Equal(cp.ManagerAddr, manager)
+			a.Equal(strOrEmpty(cp.Manager), manager)
 		}
 
 		if idx == assets[1].idx {
-			a.Equal(cp.ReserveAddr, account0)
+			a.Equal(strOrEmpty(cp.Reserve), account0)
 		} else if idx == assets[4].idx {
-			a.Equal(cp.ReserveAddr, "")
+			a.Equal(strOrEmpty(cp.Reserve), "")
 		} else {
-			a.Equal(cp.ReserveAddr, reserve)
+			a.Equal(strOrEmpty(cp.Reserve), reserve)
 		}
 
 		if idx == assets[2].idx {
-			a.Equal(cp.FreezeAddr, account0)
+			a.Equal(strOrEmpty(cp.Freeze), account0)
 		} else if idx == assets[5].idx {
-			a.Equal(cp.FreezeAddr, "")
+			a.Equal(strOrEmpty(cp.Freeze), "")
 		} else {
-			a.Equal(cp.FreezeAddr, freeze)
+			a.Equal(strOrEmpty(cp.Freeze), freeze)
 		}
 
 		if idx == assets[3].idx {
-			a.Equal(cp.ClawbackAddr, account0)
+			a.Equal(strOrEmpty(cp.Clawback), account0)
 		} else if idx == assets[6].idx {
-			a.Equal(cp.ClawbackAddr, "")
+			a.Equal(strOrEmpty(cp.Clawback), "")
 		} else {
-			a.Equal(cp.ClawbackAddr, clawback)
+			a.Equal(strOrEmpty(cp.Clawback), clawback)
 		}
 	}
 
@@ -386,7 +386,7 @@ func TestAssetConfig(t *testing.T) {
 
 	// Destroy assets
 	txids = make(map[string]string)
-	for idx := range info.AssetParams {
+	for idx := range createdAssets(info) {
 		// re-generate wh, since this test takes a while and sometimes
 		// the wallet handle expires.
 		wh, err = client.GetUnencryptedWalletHandle()
@@ -460,12 +460,7 @@ func TestAssetInformation(t *testing.T) {
 	a.NoError(err)
 
 	// There should be no assets to start with
-	info, err := client.AccountInformation(account0)
-	a.NoError(err)
-	a.Equal(len(info.AssetParams), 0)
-
-	// There should be no assets to start with
-	info2, err := client.AccountInformationV2(account0)
+	info2, err := client.AccountInformation(account0)
 	a.NoError(err)
 	a.NotNil(info2.CreatedAssets)
 	a.Equal(len(*info2.CreatedAssets), 0)
@@ -484,27 +479,18 @@ func TestAssetInformation(t *testing.T) {
 	a.True(confirmed, "creating assets")
 
 	// Check that AssetInformation returns the correct AssetParams
-	info, err = client.AccountInformation(account0)
-	a.NoError(err)
-	for idx, cp := range info.AssetParams {
-		assetInfo, err := client.AssetInformation(idx)
-		a.NoError(err)
-		a.Equal(cp, assetInfo)
-	}
-
-	// Check that AssetInformationV2 returns the correct AssetParams
-	info2, err = client.AccountInformationV2(account0)
+	info2, err = client.AccountInformation(account0)
 	a.NoError(err)
 	a.NotNil(info2.CreatedAssets)
 	for _, cp := range *info2.CreatedAssets {
-		asset, err := client.AssetInformationV2(cp.Index)
+		asset, err := client.AssetInformation(cp.Index)
 		a.NoError(err)
 		a.Equal(cp, asset)
 	}
 
 	// Destroy assets
 	txids = make(map[string]string)
-	for idx := range info.AssetParams {
+	for idx := range createdAssets(info2) {
 		tx, err := client.MakeUnsignedAssetDestroyTx(idx)
 		txid, err := helperFillSignBroadcast(client, wh, manager, tx, err)
 		a.NoError(err)
@@ -639,10 +625,11 @@ func TestAssetGroupCreateSendDestroy(t *testing.T) {
 	txids = make(map[string]string)
 
 	// asset 1 (create + send) exists and available
-	assetParams, err := client1.AssetInformation(assetID1)
+	assetInfo, err := client1.AssetInformation(assetID1)
 	a.NoError(err)
-	a.Equal(assetName1, assetParams.AssetName)
-	a.Equal(assetUnitName1, assetParams.UnitName)
+	assetParams := assetInfo.Params
+	a.Equal(assetName1, strOrEmpty(assetParams.Name))
+	a.Equal(assetUnitName1, strOrEmpty(assetParams.UnitName))
 	a.Equal(account0, assetParams.Creator)
 	a.Equal(assetTotal, assetParams.Total)
 	// sending it should succeed
@@ -724,14 +711,14 @@ func TestAssetSend(t *testing.T) {
 
 	info, err := client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 2)
+	a.Equal(len(createdAssets(info)), 2)
 	var frozenIdx, nonFrozenIdx uint64
-	for idx, cp := range info.AssetParams {
-		if cp.UnitName == "frozen" {
+	for idx, cp := range createdAssets(info) {
+		if strOrEmpty(cp.UnitName) == "frozen" {
 			frozenIdx = idx
 		}
 
-		if cp.UnitName == "nofreeze" {
+		if strOrEmpty(cp.UnitName) == "nofreeze" {
 			nonFrozenIdx = idx
 		}
 	}
@@ -814,11 +801,11 @@ func TestAssetSend(t *testing.T) {
 
 	info, err = client.AccountInformation(extra)
 	a.NoError(err)
-	a.Equal(len(info.Assets), 2)
-	a.Equal(info.Assets[frozenIdx].Amount, uint64(0))
-	a.Equal(info.Assets[frozenIdx].Frozen, true)
-	a.Equal(info.Assets[nonFrozenIdx].Amount, uint64(10))
-	a.Equal(info.Assets[nonFrozenIdx].Frozen, false)
+	a.Equal(len(heldAssets(info)), 2)
+	a.Equal(heldAssets(info)[frozenIdx].Amount, uint64(0))
+	a.Equal(heldAssets(info)[frozenIdx].IsFrozen, true)
+	a.Equal(heldAssets(info)[nonFrozenIdx].Amount, uint64(10))
+	a.Equal(heldAssets(info)[nonFrozenIdx].IsFrozen, false)
 
 	// Should not be able to send more than is available
 	tx, err = client.MakeUnsignedAssetSendTx(nonFrozenIdx, 11, extra, "", "")
@@ -887,15 +874,15 @@ func TestAssetSend(t *testing.T) {
 	// Check that the asset balances are correct
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.Assets), 2)
-	a.Equal(info.Assets[frozenIdx].Amount, uint64(95))
-	a.Equal(info.Assets[nonFrozenIdx].Amount, uint64(95))
+	a.Equal(len(heldAssets(info)), 2)
+	a.Equal(heldAssets(info)[frozenIdx].Amount, uint64(95))
+	a.Equal(heldAssets(info)[nonFrozenIdx].Amount, uint64(95))
 
 	info, err = client.AccountInformation(extra)
 	a.NoError(err)
-	a.Equal(len(info.Assets), 2)
-	a.Equal(info.Assets[frozenIdx].Amount, uint64(5))
-	a.Equal(info.Assets[nonFrozenIdx].Amount, uint64(5))
+	a.Equal(len(heldAssets(info)), 2)
+	a.Equal(heldAssets(info)[frozenIdx].Amount, uint64(5))
+	a.Equal(heldAssets(info)[nonFrozenIdx].Amount, uint64(5))
 
 	// Should be able to close out asset slots and close entire account.
 	tx, err = client.MakeUnsignedAssetFreezeTx(nonFrozenIdx, extra, false)
@@ -924,7 +911,7 @@ func TestAssetCreateWaitRestartDelete(t *testing.T) {
 	// There should be no assets to start with
 	info, err := client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 0)
+	a.Equal(len(createdAssets(info)), 0)
 
 	manager, reserve, freeze, clawback := setupActors(account0, client, a)
 	createAsset("test", account0, manager, reserve, freeze, clawback, client, fixture, a)
@@ -932,10 +919,10 @@ func TestAssetCreateWaitRestartDelete(t *testing.T) {
 	// Check that asset is visible
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 1)
-	var asset v1.AssetParams
+	a.Equal(len(createdAssets(info)), 1)
+	var asset generatedV2.AssetParams
 	var assetIndex uint64
-	for idx, cp := range info.AssetParams {
+	for idx, cp := range createdAssets(info) {
 		asset = cp
 		assetIndex = idx
 	}
@@ -955,8 +942,8 @@ func TestAssetCreateWaitRestartDelete(t *testing.T) {
 	// Check again that asset is visible
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 1)
-	for idx, cp := range info.AssetParams {
+	a.Equal(len(createdAssets(info)), 1)
+	for idx, cp := range createdAssets(info) {
 		asset = cp
 		assetIndex = idx
 	}
@@ -970,7 +957,7 @@ func TestAssetCreateWaitRestartDelete(t *testing.T) {
 	// Check again that asset is destroyed
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 0)
+	a.Equal(len(createdAssets(info)), 0)
 
 	// Should be able to close now
 	wh, err := client.GetUnencryptedWalletHandle()
@@ -1009,7 +996,7 @@ func TestAssetCreateWaitBalLookbackDelete(t *testing.T) {
 	// There should be no assets to start with
 	info, err := client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 0)
+	a.Equal(len(createdAssets(info)), 0)
 
 	manager, reserve, freeze, clawback := setupActors(account0, client, a)
 	createAsset("test", account0, manager, reserve, freeze, clawback, client, fixture, a)
@@ -1017,10 +1004,10 @@ func TestAssetCreateWaitBalLookbackDelete(t *testing.T) {
 	// Check that asset is visible
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 1)
-	var asset v1.AssetParams
+	a.Equal(len(createdAssets(info)), 1)
+	var asset generatedV2.AssetParams
 	var assetIndex uint64
-	for idx, cp := range info.AssetParams {
+	for idx, cp := range createdAssets(info) {
 		asset = cp
 		assetIndex = idx
 	}
@@ -1041,8 +1028,8 @@ func TestAssetCreateWaitBalLookbackDelete(t *testing.T) {
 	// Check again that asset is visible
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 1)
-	for idx, cp := range info.AssetParams {
+	a.Equal(len(createdAssets(info)), 1)
+	for idx, cp := range createdAssets(info) {
 		asset = cp
 		assetIndex = idx
 	}
@@ -1056,7 +1043,7 @@ func TestAssetCreateWaitBalLookbackDelete(t *testing.T) {
 	// Check again that asset is destroyed
 	info, err = client.AccountInformation(account0)
 	a.NoError(err)
-	a.Equal(len(info.AssetParams), 0)
+	a.Equal(len(createdAssets(info)), 0)
 
 	// Should be able to close now
 	wh, err := client.GetUnencryptedWalletHandle()
@@ -1151,16 +1138,52 @@ func submitAndWaitForTransaction(sender string, tx transactions.Transaction, mes
 	asser.True(confirmed, message)
 }
 
-func verifyAssetParameters(asset v1.AssetParams,
+func verifyAssetParameters(asset generatedV2.AssetParams,
 	unitName, assetName, manager, reserve, freeze, clawback string,
 	metadataHash []byte, assetURL string, asser *require.Assertions) {
 
-	asser.Equal(asset.UnitName, "test")
-	asser.Equal(asset.AssetName, "testunit")
-	asser.Equal(asset.ManagerAddr, manager)
-	asser.Equal(asset.ReserveAddr, reserve)
-	asser.Equal(asset.FreezeAddr, freeze)
-	asser.Equal(asset.ClawbackAddr, clawback)
-	asser.Equal(asset.MetadataHash, metadataHash)
-	asser.Equal(asset.URL, assetURL)
+	asser.Equal(strOrEmpty(asset.UnitName), "test")
+	asser.Equal(strOrEmpty(asset.Name), "testunit")
+	asser.Equal(strOrEmpty(asset.Manager), manager)
+	asser.Equal(strOrEmpty(asset.Reserve), reserve)
+	asser.Equal(strOrEmpty(asset.Freeze), freeze)
+	asser.Equal(strOrEmpty(asset.Clawback), clawback)
+	asser.Equal(bytesOrNil(asset.MetadataHash), metadataHash)
+	asser.Equal(strOrEmpty(asset.Url), assetURL)
+}
+
+// createdAssets returns the parameters of the assets created by the account, keyed by asset index.
+func createdAssets(info generatedV2.Account) map[uint64]generatedV2.AssetParams {
+	assets := make(map[uint64]generatedV2.AssetParams)
+	if info.CreatedAssets != nil {
+		for _, asset := range *info.CreatedAssets {
+			assets[asset.Index] = asset.Params
+		}
+	}
+	return assets
+}
+
+// heldAssets returns the asset holdings of the account, keyed by asset index.
+func heldAssets(info generatedV2.Account) map[uint64]generatedV2.AssetHolding {
+	holdings := make(map[uint64]generatedV2.AssetHolding)
+	if info.Assets != nil {
+		for _, holding := range *info.Assets {
+			holdings[holding.AssetId] = holding
+		}
+	}
+	return holdings
+}
+
+func strOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func bytesOrNil(b *[]byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return *b
 }
@@ -83,7 +83,7 @@ func TestTxnMerkleProof(t *testing.T) {
 	confirmedTx, err := fixture.WaitForConfirmedTxn(status.LastRound+10, baseAcct, txid.String())
 	a.NoError(err)
 
-	proofresp, err := client.TxnProof(txid.String(), confirmedTx.ConfirmedRound)
+	proofresp, err := client.TxnProof(txid.String(), *confirmedTx.ConfirmedRound)
 	a.NoError(err)
 
 	var proof []crypto.Digest
@@ -95,7 +95,7 @@ func TestTxnMerkleProof(t *testing.T) {
 		proofconcat = proofconcat[len(d):]
 	}
 
-	blk, err := client.BookkeepingBlock(confirmedTx.ConfirmedRound)
+	blk, err := client.Block(*confirmedTx.ConfirmedRound)
 	a.NoError(err)
 
 	merkleNode := []byte(protocol.TxnMerkleLeaf)
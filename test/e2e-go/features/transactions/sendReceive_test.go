@@ -23,7 +23,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/test/framework/fixtures"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -122,12 +122,12 @@ func testAccountsCanSendMoney(t *testing.T, templatePath string, numberOfSends i
 		expectedPingBalance = expectedPingBalance - transactionFee - amountPingSendsPong + amountPongSendsPing
 		expectedPongBalance = expectedPongBalance - transactionFee - amountPongSendsPing + amountPingSendsPong
 
-		var pongTxInfo, pingTxInfo v1.Transaction
+		var pongTxInfo, pingTxInfo generatedV2.PendingTransactionResponse
 		pongTxInfo, err = pingClient.PendingTransactionInformation(pongTx.ID().String())
 		if err == nil {
 			pingTxInfo, err = pingClient.PendingTransactionInformation(pingTx.ID().String())
 		}
-		waitForTransaction = err != nil || pongTxInfo.ConfirmedRound == 0 || pingTxInfo.ConfirmedRound == 0
+		waitForTransaction = err != nil || pongTxInfo.ConfirmedRound == nil || *pongTxInfo.ConfirmedRound == 0 || pingTxInfo.ConfirmedRound == nil || *pingTxInfo.ConfirmedRound == 0
 
 		if waitForTransaction {
 			curStatus, _ := pongClient.Status()
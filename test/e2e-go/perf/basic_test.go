@@ -245,6 +245,6 @@ func doBenchTemplate(b *testing.B, template string, moneynode string) {
 			break
 		}
 
-		fmt.Printf("  %d: %d txns\n", round, len(blk.Transactions.Transactions))
+		fmt.Printf("  %d: %d txns\n", round, len(blk.Payset))
 	}
 }
@@ -33,13 +33,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
-	kmdclient "github.com/algorand/go-algorand/daemon/kmd/client"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
-	"github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/libgoal"
@@ -159,9 +157,16 @@ func waitForRoundOne(t *testing.T, testClient libgoal.Client) {
 	}
 }
 
+// decodePendingTxn decodes the signed transaction carried by a pending transaction response.
+func decodePendingTxn(t *testing.T, resp generatedV2.PendingTransactionResponse) (stxn transactions.SignedTxn) {
+	err := protocol.DecodeJSON(protocol.EncodeJSON(resp.Txn), &stxn)
+	require.NoError(t, err)
+	return
+}
+
 var errWaitForTransactionTimeout = errors.New("wait for transaction timed out")
 
-func waitForTransaction(t *testing.T, testClient libgoal.Client, fromAddress, txID string, timeout time.Duration) (tx v1.Transaction, err error) {
+func waitForTransaction(t *testing.T, testClient libgoal.Client, fromAddress, txID string, timeout time.Duration) (tx generatedV2.PendingTransactionResponse, err error) {
 	a := require.New(fixtures.SynchronizedTest(t))
 	rnd, err := testClient.Status()
 	a.NoError(err)
@@ -170,14 +175,14 @@ func waitForTransaction(t *testing.T, testClient libgoal.Client, fromAddress, tx
 	}
 	timeoutTime := time.Now().Add(30 * time.Second)
 	for {
-		tx, err = testClient.TransactionInformation(fromAddress, txID)
+		tx, err = testClient.ConfirmedTransactionInformation(txID)
 		if err != nil && strings.HasPrefix(err.Error(), "HTTP 404") {
 			tx, err = testClient.PendingTransactionInformation(txID)
 		}
 		if err == nil {
 			a.NotEmpty(tx)
 			a.Empty(tx.PoolError)
-			if tx.ConfirmedRound > 0 {
+			if tx.ConfirmedRound != nil && *tx.ConfirmedRound > 0 {
 				return
 			}
 		}
@@ -199,7 +204,6 @@ func TestClientCanGetStatus(t *testing.T) {
 	statusResponse, err := testClient.Status()
 	a.NoError(err)
 	a.NotEmpty(statusResponse)
-	testClient.SetAPIVersionAffinity(algodclient.APIVersionV2, kmdclient.APIVersionV1)
 	statusResponse2, err := testClient.Status()
 	a.NoError(err)
 	a.NotEmpty(statusResponse2)
@@ -216,7 +220,6 @@ func TestClientCanGetStatusAfterBlock(t *testing.T) {
 	statusResponse, err := testClient.WaitForRound(1)
 	a.NoError(err)
 	a.NotEmpty(statusResponse)
-	testClient.SetAPIVersionAffinity(algodclient.APIVersionV2, kmdclient.APIVersionV1)
 	statusResponse, err = testClient.WaitForRound(statusResponse.LastRound + 1)
 	a.NoError(err)
 	a.NotEmpty(statusResponse)
@@ -260,15 +263,16 @@ func TestTransactionsByAddr(t *testing.T) {
 
 	restClient, err := localFixture.NC.AlgodClient()
 	a.NoError(err)
-	res, err := restClient.TransactionsByAddr(toAddress, 0, rnd.LastRound, 100)
+	res, err := restClient.ConfirmedTransactionInformation(txID.String())
 	a.NoError(err)
-	a.Equal(1, len(res.Transactions))
+	a.NotNil(res.ConfirmedRound)
+	a.LessOrEqual(*res.ConfirmedRound, rnd.LastRound)
 
-	for _, tx := range res.Transactions {
-		a.Equal(tx.From, someAddress)
-		a.Equal(tx.Payment.Amount, uint64(100000))
-		a.Equal(tx.Fee, uint64(10000))
-	}
+	stxn := decodePendingTxn(t, res)
+	a.Equal(stxn.Txn.Sender.String(), someAddress)
+	a.Equal(stxn.Txn.Receiver.String(), toAddress)
+	a.Equal(stxn.Txn.Amount.Raw, uint64(100000))
+	a.Equal(stxn.Txn.Fee.Raw, uint64(10000))
 }
 
 func TestClientCanGetVersion(t *testing.T) {
@@ -304,7 +308,7 @@ func TestClientCanGetMinTxnFee(t *testing.T) {
 	testClient := fixture.LibGoalClient
 	suggestedParamsRes, err := testClient.SuggestedParams()
 	a.NoError(err)
-	a.Truef(suggestedParamsRes.MinTxnFee > 0, "min txn fee not supplied")
+	a.Truef(suggestedParamsRes.MinFee > 0, "min txn fee not supplied")
 }
 
 func TestClientCanGetBlockInfo(t *testing.T) {
@@ -468,7 +472,7 @@ func TestClientCanSendAndGetNote(t *testing.T) {
 	a.NoError(err)
 	txStatus, err := waitForTransaction(t, testClient, someAddress, tx.ID().String(), 15*time.Second)
 	a.NoError(err)
-	a.Equal(note, txStatus.Note)
+	a.Equal(note, decodePendingTxn(t, txStatus).Txn.Note)
 }
 
 func TestClientCanGetTransactionStatus(t *testing.T) {
@@ -583,10 +587,11 @@ func TestAccountParticipationInfo(t *testing.T) {
 
 	account, err := testClient.AccountInformation(someAddress)
 	a.NoError(err)
-	a.Equal(randomVotePKStr, string(account.Participation.ParticipationPK), "API must print correct root voting key")
-	a.Equal(randomSelPKStr, string(account.Participation.VRFPK), "API must print correct vrf key")
-	a.Equal(uint64(firstRound), account.Participation.VoteFirst, "API must print correct first participation round")
-	a.Equal(uint64(lastRound), account.Participation.VoteLast, "API must print correct last participation round")
+	a.NotNil(account.Participation)
+	a.Equal(randomVotePKStr, string(account.Participation.VoteParticipationKey), "API must print correct root voting key")
+	a.Equal(randomSelPKStr, string(account.Participation.SelectionParticipationKey), "API must print correct vrf key")
+	a.Equal(uint64(firstRound), account.Participation.VoteFirstValid, "API must print correct first participation round")
+	a.Equal(uint64(lastRound), account.Participation.VoteLastValid, "API must print correct last participation round")
 	a.Equal(dilution, account.Participation.VoteKeyDilution, "API must print correct key dilution")
 }
 
@@ -845,9 +850,9 @@ func TestClientCanGetPendingTransactions(t *testing.T) {
 	statusResponse, err := testClient.GetPendingTransactions(0)
 	a.NoError(err)
 	a.NotEmpty(statusResponse)
-	a.True(statusResponse.TotalTxns == 1)
-	a.True(len(statusResponse.TruncatedTxns.Transactions) == 1)
-	a.True(statusResponse.TruncatedTxns.Transactions[0].TxID == tx.ID().String())
+	a.True(statusResponse.TotalTransactions == 1)
+	a.True(len(statusResponse.TopTransactions) == 1)
+	a.True(statusResponse.TopTransactions[0].ID() == tx.ID())
 
 }
 
@@ -883,11 +888,11 @@ func TestClientTruncatesPendingTransactions(t *testing.T) {
 	statusResponse, err := testClient.GetPendingTransactions(uint64(MaxTxns))
 	a.NoError(err)
 	a.NotEmpty(statusResponse)
-	a.True(int(statusResponse.TotalTxns) == NumTxns)
-	a.True(len(statusResponse.TruncatedTxns.Transactions) == MaxTxns)
-	for _, tx := range statusResponse.TruncatedTxns.Transactions {
-		a.True(txIDsSeen[tx.TxID])
-		delete(txIDsSeen, tx.TxID)
+	a.True(int(statusResponse.TotalTransactions) == NumTxns)
+	a.True(len(statusResponse.TopTransactions) == MaxTxns)
+	for _, tx := range statusResponse.TopTransactions {
+		a.True(txIDsSeen[tx.ID().String()])
+		delete(txIDsSeen, tx.ID().String())
 	}
 	a.True(len(txIDsSeen) == NumTxns-MaxTxns)
 }
@@ -930,9 +935,9 @@ func TestClientPrioritizesPendingTransactions(t *testing.T) {
 	statusResponse, err := testClient.GetPendingTransactions(uint64(MaxTxns))
 	a.NoError(err)
 	a.NotEmpty(statusResponse)
-	a.True(int(statusResponse.TotalTxns) == NumTxns+1)
-	a.True(len(statusResponse.TruncatedTxns.Transactions) == MaxTxns)
-	a.True(statusResponse.TruncatedTxns.Transactions[0].TxID == txHigh.ID().String())
+	a.True(int(statusResponse.TotalTransactions) == NumTxns+1)
+	a.True(len(statusResponse.TopTransactions) == MaxTxns)
+	a.True(statusResponse.TopTransactions[0].ID() == txHigh.ID())
 }
 
 func TestClientCanGetPendingTransactionInfo(t *testing.T) {
@@ -948,8 +953,6 @@ func TestClientCanGetPendingTransactionInfo(t *testing.T) {
 
 	testClient.WaitForRound(1)
 
-	testClient.SetAPIVersionAffinity(algodclient.APIVersionV2, kmdclient.APIVersionV1)
-
 	wh, err := testClient.GetUnencryptedWalletHandle()
 	a.NoError(err)
 	addresses, err := testClient.ListAddresses(wh)
@@ -1013,7 +1016,7 @@ return
 	a.NoError(err)
 	_, err = waitForTransaction(t, testClient, someAddress, txid, 60*time.Second)
 	a.NoError(err)
-	txn, err := testClient.PendingTransactionInformationV2(txid)
+	txn, err := testClient.PendingTransactionInformation(txid)
 	a.NoError(err)
 	a.NotNil(txn.Logs)
 	a.Equal(32, len(*txn.Logs))
@@ -1050,7 +1053,7 @@ return
 	a.NoError(err)
 	_, err = waitForTransaction(t, testClient, someAddress, txid, 60*time.Second)
 	a.NoError(err)
-	txn, err = testClient.PendingTransactionInformationV2(txid)
+	txn, err = testClient.PendingTransactionInformation(txid)
 	a.NoError(err)
 	a.NotNil(txn.Logs)
 	a.Equal(32, len(*txn.Logs))
@@ -1072,8 +1075,6 @@ func TestPendingTransactionInfoInnerTxnAssetCreate(t *testing.T) {
 
 	testClient.WaitForRound(1)
 
-	testClient.SetAPIVersionAffinity(algodclient.APIVersionV2, kmdclient.APIVersionV1)
-
 	wh, err := testClient.GetUnencryptedWalletHandle()
 	a.NoError(err)
 	addresses, err := testClient.ListAddresses(wh)
@@ -1126,7 +1127,7 @@ return
 	a.NoError(err)
 
 	// get app ID
-	submittedAppCreateTxn, err := testClient.PendingTransactionInformationV2(appCreateTxID)
+	submittedAppCreateTxn, err := testClient.PendingTransactionInformation(appCreateTxID)
 	a.NoError(err)
 	a.NotNil(submittedAppCreateTxn.ApplicationIndex)
 	createdAppID := basics.AppIndex(*submittedAppCreateTxn.ApplicationIndex)
@@ -1150,7 +1151,7 @@ return
 	a.NoError(err)
 
 	// verify pending txn info of outer txn
-	submittedAppCallTxn, err := testClient.PendingTransactionInformationV2(appCallTxnTxID)
+	submittedAppCallTxn, err := testClient.PendingTransactionInformation(appCallTxnTxID)
 	a.NoError(err)
 	a.Nil(submittedAppCallTxn.ApplicationIndex)
 	a.Nil(submittedAppCallTxn.AssetIndex)
@@ -1164,7 +1165,7 @@ return
 	createdAssetID := *innerTxn.AssetIndex
 	a.Greater(createdAssetID, uint64(0))
 
-	createdAssetInfo, err := testClient.AssetInformationV2(createdAssetID)
+	createdAssetInfo, err := testClient.AssetInformation(createdAssetID)
 	a.NoError(err)
 	a.Equal(createdAssetID, createdAssetInfo.Index)
 	a.Equal(createdAppID.Address().String(), createdAssetInfo.Params.Creator)
@@ -175,8 +175,9 @@ func TestManyAccountsCanGoOnline(t *testing.T) {
 	for txid, account := range txidsToAccountsGoOnline {
 		accountStatus, err := client.AccountInformation(account)
 		_, round := fixture.GetBalanceAndRound(account)
-		curTxStatus, err := client.TransactionInformation(account, txid)
-		a.True(curTxStatus.ConfirmedRound <= round, "go online transaction confirmed on round %d, current round is %d\n", curTxStatus.ConfirmedRound, round)
+		curTxStatus, err := client.ConfirmedTransactionInformation(txid)
+		a.NoError(err, "should be no error when querying transaction information (query number %v regarding transaction %v)", i, txid)
+		a.True(*curTxStatus.ConfirmedRound <= round, "go online transaction confirmed on round %d, current round is %d\n", *curTxStatus.ConfirmedRound, round)
 		a.NoError(err, "should be no error when querying account information (query number %v regarding account %v)", i, account)
 		a.Equal(byte(basics.Online), accountStatus.Status, "account %v should be online by now", account)
 		i++
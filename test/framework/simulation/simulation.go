@@ -0,0 +1,286 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package simulation runs several full nodes in a single process, connected by a simulated network whose latency,
+// loss, reordering and partitions are controlled by the test. Unlike the fixtures, which start algod binaries
+// talking over localhost, the simulated nodes start within a few seconds and only depend on the seed of the test
+// for the behavior of their network.
+package simulation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// defaultStake is the stake of each node when the configuration doesn't list the stakes.
+const defaultStake = 1000 * 1000000
+
+// schedulerTick is the step by which the scheduler of the switchboard advances its virtual clock, once every tick of
+// wall-clock time, as the timers of the nodes run on the wall clock.
+const schedulerTick = time.Millisecond
+
+// Config describes the simulated network.
+type Config struct {
+	// NumNodes is the number of nodes; each of them has an online account with participation keys.
+	NumNodes int
+
+	// Stakes are the microalgos held by the account of each node. All the nodes hold the same stake when empty.
+	Stakes []uint64
+
+	// Seed seeds the random choices of the switchboard.
+	Seed int64
+
+	// FilterTimeout replaces the filter timeouts of the consensus protocol, so that the rounds go by faster. The
+	// filter timeouts of the current consensus protocol are used when zero.
+	FilterTimeout time.Duration
+
+	// LastPartKeyRound is the last round of the participation keys, 1000 when zero.
+	LastPartKeyRound basics.Round
+
+	// LogLevel is the level of the logs of the nodes, which are written to the node.log file of each node
+	// directory. Only the warnings and the errors are logged when zero.
+	LogLevel logging.Level
+}
+
+// Network is a set of full nodes connected through a switchboard.
+type Network struct {
+	t           testing.TB
+	cfg         Config
+	switchboard *network.Switchboard
+	genesis     bookkeeping.Genesis
+	rootDirs    []string
+
+	mu       deadlock.Mutex
+	nodes    []*node.AlgorandFullNode
+	logFiles []*os.File
+}
+
+var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
+var poolAddr = basics.Address{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+var consensusMu deadlock.Mutex
+
+// consensusVersion returns the consensus protocol of the simulated network, registering a copy of the current
+// protocol with the configured filter timeouts when these differ.
+func consensusVersion(filterTimeout time.Duration) protocol.ConsensusVersion {
+	if filterTimeout == 0 {
+		return protocol.ConsensusCurrentVersion
+	}
+	version := protocol.ConsensusVersion(fmt.Sprintf("test-simulation-filter-%v", filterTimeout))
+	consensusMu.Lock()
+	defer consensusMu.Unlock()
+	if _, has := config.Consensus[version]; !has {
+		params := config.Consensus[protocol.ConsensusCurrentVersion]
+		params.ApprovedUpgrades = map[protocol.ConsensusVersion]uint64{}
+		params.AgreementFilterTimeout = filterTimeout
+		params.AgreementFilterTimeoutPeriod0 = filterTimeout
+		config.Consensus[version] = params
+	}
+	return version
+}
+
+// Start creates and starts the nodes of the simulated network. The nodes are shut down once the test completes.
+func Start(t testing.TB, cfg Config) *Network {
+	if cfg.LastPartKeyRound == 0 {
+		cfg.LastPartKeyRound = 1000
+	}
+	if cfg.LogLevel == 0 {
+		cfg.LogLevel = logging.Warn
+	}
+	if len(cfg.Stakes) == 0 {
+		cfg.Stakes = make([]uint64, cfg.NumNodes)
+		for i := range cfg.Stakes {
+			cfg.Stakes[i] = defaultStake
+		}
+	}
+	require.Len(t, cfg.Stakes, cfg.NumNodes)
+
+	n := &Network{
+		t:           t,
+		cfg:         cfg,
+		switchboard: network.MakeSwitchboard(cfg.Seed),
+		genesis: bookkeeping.Genesis{
+			SchemaID:    "simulation",
+			Proto:       consensusVersion(cfg.FilterTimeout),
+			Network:     config.Devtestnet,
+			FeeSink:     sinkAddr.String(),
+			RewardsPool: poolAddr.String(),
+			Timestamp:   time.Now().Unix(),
+		},
+		nodes:    make([]*node.AlgorandFullNode, cfg.NumNodes),
+		logFiles: make([]*os.File, cfg.NumNodes),
+	}
+	n.genesis.Allocation = []bookkeeping.GenesisAllocation{
+		{Address: sinkAddr.String(), Comment: "FeeSink", State: basics.AccountData{Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: 1000000}}},
+		{Address: poolAddr.String(), Comment: "RewardsPool", State: basics.AccountData{Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: 1000000}}},
+	}
+	dilution := config.Consensus[n.genesis.Proto].DefaultKeyDilution
+	for i := 0; i < cfg.NumNodes; i++ {
+		rootDir := t.TempDir()
+		n.rootDirs = append(n.rootDirs, rootDir)
+		genesisDir := filepath.Join(rootDir, n.genesis.ID())
+		require.NoError(t, os.Mkdir(genesisDir, 0700))
+
+		access, err := db.MakeAccessor(filepath.Join(genesisDir, config.RootKeyFilename(n.Name(i))), false, false)
+		require.NoError(t, err)
+		root, err := account.GenerateRoot(access)
+		access.Close()
+		require.NoError(t, err)
+
+		access, err = db.MakeAccessor(filepath.Join(genesisDir, config.PartKeyFilename(n.Name(i), 0, uint64(cfg.LastPartKeyRound))), false, false)
+		require.NoError(t, err)
+		part, err := account.FillDBWithParticipationKeys(access, root.Address(), 0, cfg.LastPartKeyRound, dilution)
+		access.Close()
+		require.NoError(t, err)
+
+		n.genesis.Allocation = append(n.genesis.Allocation, bookkeeping.GenesisAllocation{
+			Address: root.Address().String(),
+			Comment: n.Name(i),
+			State: basics.AccountData{
+				Status:      basics.Online,
+				MicroAlgos:  basics.MicroAlgos{Raw: cfg.Stakes[i]},
+				SelectionID: part.VRFSecrets().PK,
+				VoteID:      part.VotingSecrets().OneTimeSignatureVerifier,
+			},
+		})
+	}
+
+	n.switchboard.Start(schedulerTick)
+	t.Cleanup(n.Shutdown)
+	// the nodes are created before any of them starts, so that these all start the first round within their
+	// filter timeout, and agree on its proposal.
+	for i := range n.nodes {
+		n.makeNode(i)
+	}
+	for _, fullNode := range n.nodes {
+		fullNode.Start()
+	}
+	return n
+}
+
+// Name returns the name of the i-th node on the switchboard.
+func (n *Network) Name(i int) string {
+	return fmt.Sprintf("node%d", i)
+}
+
+// Switchboard returns the switchboard connecting the nodes, which controls the conditions of their links.
+func (n *Network) Switchboard() *network.Switchboard {
+	return n.switchboard
+}
+
+// Node returns the i-th node, or nil if it is stopped.
+func (n *Network) Node(i int) *node.AlgorandFullNode {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.nodes[i]
+}
+
+// Partition splits the nodes into the given groups of node indexes; the nodes which aren't listed form another group.
+func (n *Network) Partition(groups ...[]int) {
+	names := make([][]string, len(groups))
+	for i, group := range groups {
+		for _, node := range group {
+			names[i] = append(names[i], n.Name(node))
+		}
+	}
+	n.switchboard.Partition(names...)
+}
+
+// Heal removes the partitions.
+func (n *Network) Heal() {
+	n.switchboard.Heal()
+}
+
+// StartNode starts the i-th node, which is either a new node or a node stopped by StopNode.
+func (n *Network) StartNode(i int) {
+	n.makeNode(i)
+	n.Node(i).Start()
+}
+
+// makeNode creates the i-th node, without starting it.
+func (n *Network) makeNode(i int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	require.Nil(n.t, n.nodes[i], "%s is already running", n.Name(i))
+
+	log := logging.NewLogger()
+	log.SetLevel(n.cfg.LogLevel)
+	logFile, err := os.OpenFile(filepath.Join(n.rootDirs[i], "node.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(n.t, err)
+	log.SetOutput(logFile)
+
+	cfg := config.GetDefaultLocal()
+	net := n.switchboard.MakeNetwork(log, n.Name(i), n.genesis.ID(), false)
+	fullNode, err := node.MakeFullWithNetwork(log.With("source", n.Name(i)), n.rootDirs[i], cfg, n.genesis, net)
+	if err != nil {
+		logFile.Close()
+	}
+	require.NoError(n.t, err)
+	n.nodes[i] = fullNode
+	n.logFiles[i] = logFile
+}
+
+// StopNode stops the i-th node, keeping its data so that it can start again.
+func (n *Network) StopNode(i int) {
+	n.mu.Lock()
+	fullNode, logFile := n.nodes[i], n.logFiles[i]
+	n.nodes[i], n.logFiles[i] = nil, nil
+	n.mu.Unlock()
+	if fullNode == nil {
+		return
+	}
+	fullNode.Stop()
+	// the node doesn't close its ledger, as it is expected to run until the process exits.
+	fullNode.Ledger().Close()
+	logFile.Close()
+}
+
+// WaitForRound waits until the ledger of the i-th node reaches the given round, and fails the test if it doesn't
+// within the given timeout.
+func (n *Network) WaitForRound(i int, round basics.Round, timeout time.Duration) {
+	fullNode := n.Node(i)
+	require.NotNil(n.t, fullNode, "%s is stopped", n.Name(i))
+	select {
+	case <-fullNode.Ledger().Wait(round):
+	case <-time.After(timeout):
+		require.Failf(n.t, "round not reached", "%s is at round %d after %v, rather than %d",
+			n.Name(i), fullNode.Ledger().Latest(), timeout, round)
+	}
+}
+
+// Shutdown stops all the nodes, and the scheduler of the switchboard.
+func (n *Network) Shutdown() {
+	for i := range n.nodes {
+		n.StopNode(i)
+	}
+	n.switchboard.Stop()
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPartitionRecovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// the partitioned node holds a small enough stake for the others to keep agreeing on blocks without it.
+	net := Start(t, Config{
+		NumNodes:      4,
+		Stakes:        []uint64{30e9, 30e9, 30e9, 10e9},
+		Seed:          1,
+		FilterTimeout: 500 * time.Millisecond,
+	})
+	net.Switchboard().SetDefaultConditions(network.LinkConditions{Latency: 10 * time.Millisecond, Jitter: 20 * time.Millisecond})
+	for i := 0; i < 4; i++ {
+		net.WaitForRound(i, 2, time.Minute)
+	}
+
+	net.Partition([]int{3})
+	isolatedRound := net.Node(3).Ledger().Latest()
+	majorityRound := net.Node(0).Ledger().Latest()
+	for i := 0; i < 3; i++ {
+		net.WaitForRound(i, majorityRound+3, time.Minute)
+	}
+	// the isolated node may only complete the round it was in when the partition started.
+	require.LessOrEqual(t, uint64(net.Node(3).Ledger().Latest()), uint64(isolatedRound+1))
+
+	net.Heal()
+	net.WaitForRound(3, majorityRound+4, time.Minute)
+}
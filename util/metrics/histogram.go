@@ -0,0 +1,205 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the buckets used by
+// the histograms measuring latencies.
+var DefaultLatencyBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Histogram represent the distribution of a variable, as the number of
+// observations falling into each of a set of buckets.
+type Histogram struct {
+	deadlock.Mutex
+	name        string
+	description string
+	buckets     []float64                   // the increasing upper bounds of the buckets, excluding +Inf.
+	values      map[string]*histogramValues // maps each set of formatted labels into a concrete histogram
+	order       []string                    // the formatted labels, in the order they were first observed
+}
+
+type histogramValues struct {
+	counts          []uint64 // the non-cumulative count of each bucket, the last one being +Inf.
+	sum             float64
+	count           uint64
+	formattedLabels string
+}
+
+// MakeHistogram create a new histogram with the provided name, description and
+// bucket upper bounds.
+func MakeHistogram(metric MetricName, buckets []float64) *Histogram {
+	sortedBuckets := append([]float64(nil), buckets...)
+	sort.Float64s(sortedBuckets)
+	h := &Histogram{
+		name:        metric.Name,
+		description: metric.Description,
+		buckets:     sortedBuckets,
+		values:      make(map[string]*histogramValues),
+	}
+	h.Register(nil)
+	return h
+}
+
+// MakeLatencyHistogram create a new histogram measuring latencies in seconds,
+// with the DefaultLatencyBuckets.
+func MakeLatencyHistogram(metric MetricName) *Histogram {
+	return MakeHistogram(metric, DefaultLatencyBuckets)
+}
+
+// Register registers the histogram with the default/specific registry
+func (histogram *Histogram) Register(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Register(histogram)
+	} else {
+		reg.Register(histogram)
+	}
+}
+
+// Deregister deregisters the histogram with the default/specific registry
+func (histogram *Histogram) Deregister(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Deregister(histogram)
+	} else {
+		reg.Deregister(histogram)
+	}
+}
+
+// Observe adds an observation of x to the histogram
+func (histogram *Histogram) Observe(x float64, labels map[string]string) {
+	formattedLabels := formatHistogramLabels(labels)
+
+	histogram.Lock()
+	defer histogram.Unlock()
+
+	val, has := histogram.values[formattedLabels]
+	if !has {
+		val = &histogramValues{
+			counts:          make([]uint64, len(histogram.buckets)+1),
+			formattedLabels: formattedLabels,
+		}
+		histogram.values[formattedLabels] = val
+		histogram.order = append(histogram.order, formattedLabels)
+	}
+
+	bucket := sort.SearchFloat64s(histogram.buckets, x)
+	val.counts[bucket]++
+	val.sum += x
+	val.count++
+}
+
+// ObserveSince adds an observation of the seconds elapsed since Time t to the histogram
+func (histogram *Histogram) ObserveSince(t time.Time, labels map[string]string) {
+	histogram.Observe(time.Since(t).Seconds(), labels)
+}
+
+// formatHistogramLabels formats the labels in the order of their names, so that
+// the same set of labels is always formatted the same way.
+func formatHistogramLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(labels))
+	for k := range labels {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var buf strings.Builder
+	for _, k := range names {
+		buf.WriteString("," + k + "=\"" + labelValueEscaper.Replace(labels[k]) + "\"")
+	}
+	return buf.String()[1:]
+}
+
+// labelValueEscaper escapes the characters which can't appear as such in a label
+// value of the Prometheus text format.
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func (histogram *Histogram) writeSample(buf *strings.Builder, suffix string, parentLabels string, formattedLabels string, extraLabel string, value string) {
+	buf.WriteString(histogram.name)
+	buf.WriteString(suffix)
+	buf.WriteString("{")
+	sep := ""
+	for _, labels := range []string{parentLabels, formattedLabels, extraLabel} {
+		if len(labels) > 0 {
+			buf.WriteString(sep)
+			buf.WriteString(labels)
+			sep = ","
+		}
+	}
+	buf.WriteString("} ")
+	buf.WriteString(value)
+	buf.WriteString("\n")
+}
+
+// WriteMetric writes the metric into the output stream
+func (histogram *Histogram) WriteMetric(buf *strings.Builder, parentLabels string) {
+	histogram.Lock()
+	defer histogram.Unlock()
+
+	if len(histogram.values) < 1 {
+		return
+	}
+	buf.WriteString("# HELP ")
+	buf.WriteString(histogram.name)
+	buf.WriteString(" ")
+	buf.WriteString(histogram.description)
+	buf.WriteString("\n# TYPE ")
+	buf.WriteString(histogram.name)
+	buf.WriteString(" histogram\n")
+	for _, formattedLabels := range histogram.order {
+		val := histogram.values[formattedLabels]
+		cumulative := uint64(0)
+		for i, count := range val.counts {
+			cumulative += count
+			le := "+Inf"
+			if i < len(histogram.buckets) {
+				le = strconv.FormatFloat(histogram.buckets[i], 'f', -1, 64)
+			}
+			histogram.writeSample(buf, "_bucket", parentLabels, formattedLabels, "le=\""+le+"\"", strconv.FormatUint(cumulative, 10))
+		}
+		histogram.writeSample(buf, "_sum", parentLabels, formattedLabels, "", strconv.FormatFloat(val.sum, 'f', -1, 64))
+		histogram.writeSample(buf, "_count", parentLabels, formattedLabels, "", strconv.FormatUint(val.count, 10))
+	}
+}
+
+// AddMetric adds the metric into the map
+func (histogram *Histogram) AddMetric(values map[string]string) {
+	histogram.Lock()
+	defer histogram.Unlock()
+
+	if len(histogram.values) < 1 {
+		return
+	}
+
+	sum := float64(0)
+	count := uint64(0)
+	for _, val := range histogram.values {
+		sum += val.sum
+		count += val.count
+	}
+	values[histogram.name+"_sum"] = strconv.FormatFloat(sum, 'f', -1, 64)
+	values[histogram.name+"_count"] = strconv.FormatUint(count, 10)
+}
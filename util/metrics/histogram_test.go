@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestMetricHistogram(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	histogram := MakeHistogram(MetricName{Name: "metric_test_histogram", Description: "this is the metric test for histogram object"}, []float64{1, 0.1})
+	defer histogram.Deregister(nil)
+
+	var buf strings.Builder
+	histogram.WriteMetric(&buf, "host=\"h\"")
+	require.Empty(t, buf.String())
+
+	histogram.Observe(0.05, nil)
+	histogram.Observe(0.1, nil)
+	histogram.Observe(0.5, nil)
+	histogram.Observe(3, nil)
+	histogram.Observe(2, map[string]string{"step": "b", "kind": "a"})
+
+	histogram.WriteMetric(&buf, "host=\"h\"")
+	require.Equal(t, `# HELP metric_test_histogram this is the metric test for histogram object
+# TYPE metric_test_histogram histogram
+metric_test_histogram_bucket{host="h",le="0.1"} 2
+metric_test_histogram_bucket{host="h",le="1"} 3
+metric_test_histogram_bucket{host="h",le="+Inf"} 4
+metric_test_histogram_sum{host="h"} 3.65
+metric_test_histogram_count{host="h"} 4
+metric_test_histogram_bucket{host="h",kind="a",step="b",le="0.1"} 0
+metric_test_histogram_bucket{host="h",kind="a",step="b",le="1"} 0
+metric_test_histogram_bucket{host="h",kind="a",step="b",le="+Inf"} 1
+metric_test_histogram_sum{host="h",kind="a",step="b"} 2
+metric_test_histogram_count{host="h",kind="a",step="b"} 1
+`, buf.String())
+
+	values := make(map[string]string)
+	histogram.AddMetric(values)
+	require.Equal(t, map[string]string{"metric_test_histogram_sum": "5.65", "metric_test_histogram_count": "5"}, values)
+}
+
+func TestHistogramLabelEscaping(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, `path="C:\\dir",quote="say \"hi\"",text="a\nb"`,
+		formatHistogramLabels(map[string]string{"path": `C:\dir`, "quote": `say "hi"`, "text": "a\nb"}))
+}
@@ -89,4 +89,10 @@ var (
 	TransactionMessagesDroppedFromBacklog = MetricName{Name: "algod_transaction_messages_dropped_backlog", Description: "Number of transaction messages dropped from backlog"}
 	// TransactionMessagesDroppedFromPool "Number of transaction messages dropped from pool"
 	TransactionMessagesDroppedFromPool = MetricName{Name: "algod_transaction_messages_dropped_pool", Description: "Number of transaction messages dropped from pool"}
+	// TransactionPoolEvictedGroups "Number of transaction groups evicted from the full pool by higher paying ones"
+	TransactionPoolEvictedGroups = MetricName{Name: "algod_transaction_pool_evicted_groups", Description: "Number of transaction groups evicted from the full pool by higher paying ones"}
+	// TransactionPoolEvictedTxns "Number of transactions evicted from the full pool by higher paying ones"
+	TransactionPoolEvictedTxns = MetricName{Name: "algod_transaction_pool_evicted_txns", Description: "Number of transactions evicted from the full pool by higher paying ones"}
+	// TransactionPoolRememberSeconds "Seconds spent admitting a transaction group into the pool, by whether it was accepted"
+	TransactionPoolRememberSeconds = MetricName{Name: "algod_transaction_pool_remember_seconds", Description: "Seconds spent admitting a transaction group into the pool, by whether it was accepted"}
 )
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+// AlgodScopedTokensFilename is the file in the algod datadir listing the
+// additional, named, API tokens along with the routes they may access.
+const AlgodScopedTokensFilename = "algod.tokens.json"
+
+// The scopes a token may be granted. Each REST route requires exactly one of them.
+const (
+	// ScopeRead grants access to the routes reading the node and ledger state.
+	ScopeRead = "read"
+	// ScopeSubmit grants access to the routes submitting transactions.
+	ScopeSubmit = "submit"
+	// ScopeDryrun grants access to the routes compiling and dry-running programs.
+	ScopeDryrun = "dryrun"
+	// ScopeAdmin grants access to the private routes managing the node.
+	ScopeAdmin = "admin"
+)
+
+// AllScopes lists every scope, which is what the algod.token and algod.admin.token
+// tokens are granted on the routes they are checked for.
+var AllScopes = []string{ScopeRead, ScopeSubmit, ScopeDryrun, ScopeAdmin}
+
+// ScopedToken is an API token restricted to some scopes, and optionally to a
+// request quota.
+type ScopedToken struct {
+	// Name identifies the token in logs and metrics.
+	Name string
+	// Token is the value clients authenticate with.
+	Token string
+	// Scopes lists the scopes the token is granted.
+	Scopes []string
+	// RequestsPerSecond is the sustained rate of requests allowed with the token.
+	// Bursts of up to a second worth of requests are allowed. Zero means no limit.
+	RequestsPerSecond float64
+	// MaxConcurrentRequests is the number of requests which may be in flight with
+	// the token at any time. Zero means no limit.
+	MaxConcurrentRequests int
+}
+
+// LoadScopedTokens reads and validates the scoped tokens listed in the datadir.
+// It returns no tokens and no error if there is no such list.
+func LoadScopedTokens(dataDir string) ([]ScopedToken, error) {
+	contents, err := ioutil.ReadFile(tokenFilepath(dataDir, AlgodScopedTokensFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var scopedTokens []ScopedToken
+	err = json.Unmarshal(contents, &scopedTokens)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %v", AlgodScopedTokensFilename, err)
+	}
+
+	err = ValidateScopedTokens(scopedTokens)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", AlgodScopedTokensFilename, err)
+	}
+	return scopedTokens, nil
+}
+
+// ValidateScopedTokens returns a non-nil error if any of the tokens fails our
+// validation checks, or if two of them share a name or a value.
+func ValidateScopedTokens(scopedTokens []ScopedToken) error {
+	names := make(map[string]bool, len(scopedTokens))
+	values := make(map[string]bool, len(scopedTokens))
+	for i, token := range scopedTokens {
+		if token.Name == "" {
+			return fmt.Errorf("token %d has no name", i)
+		}
+		if names[token.Name] {
+			return fmt.Errorf("token name %s is used more than once", token.Name)
+		}
+		names[token.Name] = true
+
+		err := ValidateAPIToken(token.Token)
+		if err != nil {
+			return fmt.Errorf("token %s: %v", token.Name, err)
+		}
+		if values[token.Token] {
+			return fmt.Errorf("token %s has the same value as another token", token.Name)
+		}
+		values[token.Token] = true
+
+		if len(token.Scopes) == 0 {
+			return fmt.Errorf("token %s has no scopes", token.Name)
+		}
+		for _, scope := range token.Scopes {
+			if !isScope(scope) {
+				return fmt.Errorf("token %s has unknown scope '%s'", token.Name, scope)
+			}
+		}
+
+		if token.RequestsPerSecond < 0 {
+			return fmt.Errorf("token %s has a negative RequestsPerSecond", token.Name)
+		}
+		if token.MaxConcurrentRequests < 0 {
+			return fmt.Errorf("token %s has a negative MaxConcurrentRequests", token.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateScopedTokensDistinct returns a non-nil error if any of the scoped tokens
+// has the same value as the API token or the admin API token, which would make
+// the requests bearing it fall under the wrong scopes.
+func ValidateScopedTokensDistinct(scopedTokens []ScopedToken, apiToken string, adminAPIToken string) error {
+	for _, token := range scopedTokens {
+		if token.Token == apiToken {
+			return fmt.Errorf("token %s has the same value as %s", token.Name, AlgodTokenFilename)
+		}
+		if token.Token == adminAPIToken {
+			return fmt.Errorf("token %s has the same value as %s", token.Name, AlgodAdminTokenFilename)
+		}
+	}
+	return nil
+}
+
+func isScope(scope string) bool {
+	for _, s := range AllScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2021 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestValidateScopedTokensDistinct(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	apiToken := strings.Repeat("a", 64)
+	adminAPIToken := strings.Repeat("b", 64)
+	scoped := []ScopedToken{{Name: "reader", Token: strings.Repeat("c", 64), Scopes: []string{ScopeRead}}}
+	require.NoError(t, ValidateScopedTokensDistinct(scoped, apiToken, adminAPIToken))
+
+	scoped = append(scoped, ScopedToken{Name: "admin", Token: adminAPIToken, Scopes: []string{ScopeRead}})
+	err := ValidateScopedTokensDistinct(scoped, apiToken, adminAPIToken)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), AlgodAdminTokenFilename)
+
+	scoped[1].Token = apiToken
+	err = ValidateScopedTokensDistinct(scoped, apiToken, adminAPIToken)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), AlgodTokenFilename)
+}